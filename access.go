@@ -0,0 +1,131 @@
+package main
+
+// Per-group access control. A group with a "senders" list can only be seen and
+// written to by the people on it; to everyone else it doesn't exist.
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// A SenderList is the people who may use a group. A nil *SenderList lets
+// anyone use it.
+type SenderList struct {
+	// Lowercase email addresses.
+	Emails []string
+	// Lowercase domains; everyone with an address in one may use the group.
+	Domains []string
+}
+
+// ParseSenders builds a SenderList from entries that are each an email
+// address ("name@example.com"), a domain ("example.com") or the name of a
+// role in roles ("staff"). Roles list addresses and domains.
+func ParseSenders(entries []string, roles map[string][]string) (*SenderList, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	s := new(SenderList)
+	var add func(entry string, inRole string) error
+	add = func(entry string, inRole string) error {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case strings.Contains(entry, "@") && !strings.HasPrefix(entry, "@"):
+			if _, err := mail.ParseAddress(entry); err != nil {
+				return fmt.Errorf("invalid sender address %q: %v", entry, err)
+			}
+			s.Emails = append(s.Emails, entry)
+		case strings.Contains(entry, "."):
+			domain := strings.TrimPrefix(entry, "@")
+			if strings.ContainsAny(domain, "@/: ") {
+				return fmt.Errorf("invalid sender domain %q", entry)
+			}
+			s.Domains = append(s.Domains, domain)
+		case inRole != "":
+			return fmt.Errorf("role %q can only list addresses and domains, not %q", inRole, entry)
+		default:
+			members, ok := roles[entry]
+			if !ok {
+				return fmt.Errorf("unknown role %q; roles should be defined in the top level \"roles\" setting", entry)
+			}
+			for _, member := range members {
+				if err := add(member, entry); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, entry := range entries {
+		if err := add(entry, ""); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Allows reports whether addr may use the group. Nobody who isn't signed in
+// may use a group with a SenderList.
+func (s *SenderList) Allows(addr *mail.Address) bool {
+	if s == nil {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	address := strings.ToLower(addr.Address)
+	for _, email := range s.Emails {
+		if email == address {
+			return true
+		}
+	}
+	domain := emailDomain(address)
+	for _, d := range s.Domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// VisibleTo reports whether the group can be seen and sent to by addr, which
+// is nil for visitors who aren't signed in.
+func (g *Group) VisibleTo(addr *mail.Address) bool {
+	return g.Senders.Allows(addr)
+}
+
+// signedInEmail returns the address in the Google or Microsoft
+// authentication cookie on r, or nil if there isn't a valid one. It doesn't
+// check the account with the provider, so only use it to decide what to show,
+// on pages that don't require signing in. Pages that send letters go through
+// Authenticator.Handle.
+func signedInEmail(r *http.Request, secrets *Secrets) *mail.Address {
+	var b []byte
+	if cookie, err := r.Cookie(authCookieName); err == nil {
+		b, _ = secrets.Auth.open(cookie.Value)
+	}
+	if cookie, err := r.Cookie(microsoftCookieName); b == nil && err == nil {
+		b, _ = secrets.MicrosoftAuth.open(cookie.Value)
+	}
+	if b == nil {
+		return nil
+	}
+	var t struct {
+		Email  *mail.Address
+		Expiry time.Time
+		// Only set by Microsoft sign in; see microsoft.go.
+		Verified *bool
+	}
+	if err := json.Unmarshal(b, &t); err != nil || t.Expiry.Before(time.Now()) {
+		return nil
+	}
+	// Anyone can claim any address with a Microsoft account of their own,
+	// so it can't be used to decide who can see a group.
+	if t.Verified != nil && !*t.Verified {
+		return nil
+	}
+	return t.Email
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func TestParseSenders(t *testing.T) {
+	t.Parallel()
+	roles := map[string][]string{"staff": {"Aide@Board.example.org", "clerks.example.org"}}
+	s, err := ParseSenders([]string{"staff", "@union.example.org", "member@gmail.com"}, roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		addr string
+		want bool
+	}{
+		{"aide@board.example.org", true},
+		{"someone@clerks.example.org", true},
+		{"Someone@Union.example.org", true},
+		{"member@gmail.com", true},
+		{"other@board.example.org", false},
+	} {
+		if got := s.Allows(&mail.Address{Address: tt.addr}); got != tt.want {
+			t.Errorf("Allows(%q) = %t, want %t", tt.addr, got, tt.want)
+		}
+	}
+	if s.Allows(nil) {
+		t.Errorf("Allows(nil): restricted group should be hidden from visitors who aren't signed in")
+	}
+	if s, err := ParseSenders(nil, roles); err != nil || s != nil || !s.Allows(nil) {
+		t.Errorf("ParseSenders(nil): want a nil list that allows anyone, got %v, %v", s, err)
+	}
+	if _, err := ParseSenders([]string{"board"}, roles); err == nil || !strings.Contains(err.Error(), `unknown role "board"`) {
+		t.Errorf("ParseSenders: want unknown role error, got %v", err)
+	}
+	if _, err := ParseSenders([]string{"admins"}, map[string][]string{"admins": {"staff"}}); err == nil {
+		t.Errorf("ParseSenders: want error for role listing another role")
+	}
+}
+
+func accessMailer() *Mailer {
+	m := embedMailer()
+	addr, _ := mail.ParseAddress("Chief of Staff <chief@board.example.org>")
+	m.Groups["staff"] = &Group{
+		ID:         "staff",
+		Name:       "Board Staff",
+		Recipients: []*Recipient{{Address: *addr, OpeningLine: "Dear Chief"}},
+		Senders:    &SenderList{Domains: []string{"union.example.org"}},
+	}
+	return m
+}
+
+var groupAccessTests = []struct {
+	path   string
+	viewer string
+	code   int
+	see    string
+	hidden string
+}{
+	{"/", "", 200, "Board of Supervisors", "Board Staff"},
+	{"/", "outsider@gmail.com", 200, "Board of Supervisors", "Board Staff"},
+	{"/", "member@union.example.org", 200, "Board Staff", ""},
+	{"/staff", "", 200, "", "Board Staff"},
+	{"/staff", "outsider@gmail.com", 404, "", "Board Staff"},
+	{"/staff", "member@union.example.org", 200, "Board Staff", ""},
+	{"/staff/recipients.json", "", 404, "", "chief@board.example.org"},
+	{"/staff/recipients.json", "member@union.example.org", 200, "chief@board.example.org", ""},
+	{"/v1/groups", "", 200, "Board of Supervisors", "Board Staff"},
+	{"/v1/groups", "member@union.example.org", 200, "Board Staff", ""},
+	{"/v1/groups/staff", "outsider@gmail.com", 404, "", "Board Staff"},
+}
+
+func TestGroupAccess(t *testing.T) {
+	t.Parallel()
+	mailer := accessMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	for _, tt := range groupAccessTests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		if tt.viewer != "" {
+			req.AddCookie(authCookie(t, key, tt.viewer))
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != tt.code {
+			t.Errorf("GET %s as %q: got code %d, want %d", tt.path, tt.viewer, w.Code, tt.code)
+			continue
+		}
+		b := w.Body.String()
+		if tt.see != "" && !strings.Contains(b, tt.see) {
+			t.Errorf("GET %s as %q: should see %q", tt.path, tt.viewer, tt.see)
+		}
+		if tt.hidden != "" && strings.Contains(b, tt.hidden) {
+			t.Errorf("GET %s as %q: should not see %q", tt.path, tt.viewer, tt.hidden)
+		}
+	}
+}
+
+func TestSendToRestrictedGroup(t *testing.T) {
+	t.Parallel()
+	m := accessMailer()
+	if _, err := m.validateSend("en", "Subject", "Body", "staff", &mail.Address{Address: "outsider@gmail.com"}); err == nil || err.ID != "unknown_group" {
+		t.Errorf("validateSend: want outsider to get unknown_group, got %v", err)
+	}
+	if _, err := m.validateSend("en", "Subject", "Body", "staff", &mail.Address{Address: "member@union.example.org"}); err != nil {
+		t.Errorf("validateSend: want member to be able to send, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+// Gmail send-as aliases. Users can add other addresses they own to Gmail,
+// like a club address, and pick which one letters come from. Listing them
+// needs the gmail.settings.basic scope, so it's only requested if
+// send_as_aliases is set.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+var errNotAlias = errors.New("not a verified send-as address")
+
+// listAliases returns the addresses auth can send from in Gmail, primary
+// address first. Aliases that haven't been verified are left out.
+func listAliases(ctx context.Context, auth *Account) ([]*mail.Address, error) {
+	srv, err := gmail.New(auth.Client)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := srv.Users.Settings.SendAs.List("me").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	aliases := make([]*mail.Address, 0, len(resp.SendAs))
+	for _, sa := range resp.SendAs {
+		if !sa.IsPrimary && sa.VerificationStatus != "accepted" {
+			continue
+		}
+		addr := &mail.Address{Name: sa.DisplayName, Address: sa.SendAsEmail}
+		if sa.IsPrimary {
+			aliases = append([]*mail.Address{addr}, aliases...)
+		} else {
+			aliases = append(aliases, addr)
+		}
+	}
+	return aliases, nil
+}
+
+// aliasCacheTTL is how long a user's send-as addresses are remembered, so
+// showing them the form doesn't call Gmail every time.
+const aliasCacheTTL = 5 * time.Minute
+
+// aliasCache remembers the send-as addresses of recent users, by address.
+type aliasCache struct {
+	mu      sync.Mutex
+	entries map[string]*aliasEntry
+}
+
+type aliasEntry struct {
+	aliases []*mail.Address
+	expires time.Time
+}
+
+func newAliasCache() *aliasCache {
+	return &aliasCache{entries: make(map[string]*aliasEntry)}
+}
+
+func (c *aliasCache) get(addr string) ([]*mail.Address, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[strings.ToLower(addr)]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.aliases, true
+}
+
+func (c *aliasCache) set(addr string, aliases []*mail.Address) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for key, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[strings.ToLower(addr)] = &aliasEntry{aliases: aliases, expires: now.Add(aliasCacheTTL)}
+}
+
+// sendAs returns the addresses auth can send from, from the cache if they
+// were looked up recently, unless fresh is true.
+func (m *Mailer) sendAs(ctx context.Context, auth *Account, fresh bool) ([]*mail.Address, error) {
+	if !fresh {
+		if aliases, ok := m.aliases.get(auth.Email.Address); ok {
+			return aliases, nil
+		}
+	}
+	aliases, err := listAliases(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+	m.aliases.set(auth.Email.Address, aliases)
+	return aliases, nil
+}
+
+// aliasChoices returns the addresses to offer in the form, or nil if there's
+// nothing to pick from.
+func (m *Mailer) aliasChoices(r *http.Request, auth *Account) []*mail.Address {
+	if !m.sendAsAliases || auth.Provider != providerGoogle {
+		return nil
+	}
+	aliases, err := m.sendAs(r.Context(), auth, false)
+	if err != nil {
+		m.Logger.Warn("Could not list send-as addresses", "email", auth.Email.Address, "err", err)
+		return nil
+	}
+	if len(aliases) < 2 {
+		return nil
+	}
+	return aliases
+}
+
+// alias returns the send-as address for addr, checking with Gmail that auth
+// can use it. It returns errNotAlias if they can't. An address that isn't in
+// the cache is looked up again, in case it was added since.
+func (m *Mailer) alias(ctx context.Context, auth *Account, addr string) (*mail.Address, error) {
+	if !m.sendAsAliases || auth.Provider != providerGoogle {
+		return nil, errNotAlias
+	}
+	for _, fresh := range []bool{false, true} {
+		aliases, err := m.sendAs(ctx, auth, fresh)
+		if err != nil {
+			return nil, err
+		}
+		for _, alias := range aliases {
+			if strings.EqualFold(alias.Address, addr) {
+				return alias, nil
+			}
+		}
+	}
+	return nil, errNotAlias
+}
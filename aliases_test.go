@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+// redirectTransport sends every request to a test server instead.
+type redirectTransport struct {
+	url *url.URL
+}
+
+func (t redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r2 := r.Clone(r.Context())
+	r2.URL.Scheme = t.url.Scheme
+	r2.URL.Host = t.url.Host
+	r2.Host = ""
+	return http.DefaultTransport.RoundTrip(r2)
+}
+
+func aliasStandIn(t *testing.T) (*httptest.Server, *http.Client) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gmail/v1/users/me/settings/sendAs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"sendAs": [
+			{"sendAsEmail": "secretary@club.example.org", "displayName": "Club Secretary", "verificationStatus": "accepted"},
+			{"sendAsEmail": "unverified@example.org", "verificationStatus": "pending"},
+			{"sendAsEmail": "volunteer@gmail.com", "displayName": "Volunteer", "isPrimary": true}
+		]}`)
+	}))
+	u, _ := url.Parse(server.URL)
+	return server, &http.Client{Transport: redirectTransport{u}}
+}
+
+func TestListAliases(t *testing.T) {
+	t.Parallel()
+	server, client := aliasStandIn(t)
+	defer server.Close()
+	auth := &Account{Email: &mail.Address{Address: "volunteer@gmail.com"}, Client: client, Provider: providerGoogle}
+	aliases, err := listAliases(context.Background(), auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aliases) != 2 || aliases[0].Address != "volunteer@gmail.com" || aliases[1].Address != "secretary@club.example.org" {
+		t.Errorf("listAliases: got %v, want primary address then the verified alias", aliases)
+	}
+
+	m := &Mailer{sendAsAliases: true}
+	alias, err := m.alias(context.Background(), auth, "Secretary@club.example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alias.Name != "Club Secretary" {
+		t.Errorf("alias: got %v, want the alias's display name", alias)
+	}
+	auth.alias = alias
+	if from := sendFrom(auth, &Group{ID: "board"}); from != alias {
+		t.Errorf("sendFrom: got %v, want the picked alias", from)
+	}
+	for _, addr := range []string{"unverified@example.org", "someone@example.com"} {
+		if _, err := m.alias(context.Background(), auth, addr); err != errNotAlias {
+			t.Errorf("alias(%s): got %v, want errNotAlias", addr, err)
+		}
+	}
+	m.sendAsAliases = false
+	if _, err := m.alias(context.Background(), auth, "secretary@club.example.org"); err != errNotAlias {
+		t.Errorf("alias with send_as_aliases off: got %v, want errNotAlias", err)
+	}
+}
+
+// countingTransport counts the requests made through it.
+type countingTransport struct {
+	http.RoundTripper
+	n *int32
+}
+
+func (t countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(t.n, 1)
+	return t.RoundTripper.RoundTrip(r)
+}
+
+func TestAliasCache(t *testing.T) {
+	t.Parallel()
+	server, client := aliasStandIn(t)
+	defer server.Close()
+	var calls int32
+	client.Transport = countingTransport{client.Transport, &calls}
+	auth := &Account{Email: &mail.Address{Address: "volunteer@gmail.com"}, Client: client, Provider: providerGoogle}
+	m, _ := drainMailer()
+	m.sendAsAliases = true
+	m.aliases = newAliasCache()
+	req := httptest.NewRequest("GET", "/", nil)
+	for i := 0; i < 3; i++ {
+		if aliases := m.aliasChoices(req, auth); len(aliases) != 2 {
+			t.Fatalf("aliasChoices: got %v, want two addresses", aliases)
+		}
+	}
+	if _, err := m.alias(context.Background(), auth, "secretary@club.example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("got %d calls to Gmail, want the send-as addresses listed once", n)
+	}
+	// An address that isn't in the cache is checked with Gmail again.
+	if _, err := m.alias(context.Background(), auth, "someone@example.com"); err != errNotAlias {
+		t.Errorf("alias(someone@example.com): got %v, want errNotAlias", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("got %d calls to Gmail, want a fresh lookup for an unknown address", n)
+	}
+}
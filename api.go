@@ -0,0 +1,403 @@
+package main
+
+// A JSON API for listing groups, previewing and sending letters. It shares
+// validation and delivery with the HTML form in form.go; see
+// static/openapi.json for a description of every endpoint.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kevinburke/rest"
+)
+
+// GET /v1/groups/<id>
+var apiGroupRx = regexp.MustCompile(fmt.Sprintf(`^/v1/groups/(%s)$`, idRxPart))
+
+// GET /v1/jobs/<id>
+var apiJobRx = regexp.MustCompile(`^/v1/jobs/([0-9a-f]+)$`)
+
+// isAPIRequest reports whether r is a request for a JSON API endpoint, as
+// opposed to an HTML page or the form post to /v1/send.
+func isAPIRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/v1/") && r.URL.Path != "/v1/send"
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+func writeAPIError(w http.ResponseWriter, code int, err *rest.Error) {
+	err.Status = code
+	writeJSON(w, code, err)
+}
+
+type apiAddress struct {
+	Name string `json:"name,omitempty"`
+	// Empty if the group hides recipient addresses.
+	Email string `json:"email,omitempty"`
+}
+
+func newAPIAddress(addr mail.Address) apiAddress {
+	return apiAddress{Name: addr.Name, Email: addr.Address}
+}
+
+type apiRecipient struct {
+	apiAddress
+	CC          []apiAddress `json:"cc"`
+	OpeningLine string       `json:"opening_line"`
+}
+
+type apiGroup struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Category    string          `json:"category,omitempty"`
+	Order       int             `json:"order"`
+	OpensAt     *time.Time      `json:"opens_at,omitempty"`
+	ClosesAt    *time.Time      `json:"closes_at,omitempty"`
+	Closed      bool            `json:"closed"`
+	Recipients  []*apiRecipient `json:"recipients"`
+}
+
+func newAPIGroup(g *Group) *apiGroup {
+	ag := &apiGroup{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: string(g.Description),
+		Category:    g.Category,
+		Order:       g.Order,
+		Closed:      g.Closed(),
+		Recipients:  newAPIRecipients(g.PublicRecipients()),
+	}
+	if !g.OpensAt.IsZero() {
+		t := g.OpensAt
+		ag.OpensAt = &t
+	}
+	if !g.ClosesAt.IsZero() {
+		t := g.ClosesAt
+		ag.ClosesAt = &t
+	}
+	return ag
+}
+
+func newAPIRecipients(recs []*Recipient) []*apiRecipient {
+	ars := make([]*apiRecipient, len(recs))
+	for i, r := range recs {
+		ar := &apiRecipient{
+			apiAddress:  newAPIAddress(r.Address),
+			CC:          make([]apiAddress, len(r.CC)),
+			OpeningLine: r.OpeningLine,
+		}
+		for j := range r.CC {
+			ar.CC[j] = newAPIAddress(r.CC[j])
+		}
+		ars[i] = ar
+	}
+	return ars
+}
+
+// apiSendRequest is the body of POST /v1/preview and POST /v1/messages.
+type apiSendRequest struct {
+	GroupID string `json:"group_id"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	// Required if the site asks senders for their details; see identity.go.
+	Sender *Identity `json:"sender,omitempty"`
+	// If false, POST /v1/messages returns as soon as the job is created,
+	// instead of waiting for it to finish. Defaults to true.
+	Wait *bool `json:"wait,omitempty"`
+}
+
+// decodeSendRequest parses a JSON request body. We require a JSON content
+// type, which browsers can't send cross-origin without a CORS preflight, so
+// together with the Origin check this protects the cookie-authenticated API
+// against cross-site request forgery.
+func decodeSendRequest(w http.ResponseWriter, r *http.Request) (*apiSendRequest, bool) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		writeAPIError(w, http.StatusUnsupportedMediaType, &rest.Error{
+			Title: "Please send a JSON request body with Content-Type: application/json",
+			ID:    "unsupported_media_type",
+		})
+		return nil, false
+	}
+	if !sameOrigin(r) {
+		writeAPIError(w, http.StatusForbidden, &rest.Error{
+			Title: "Cross-origin requests are not allowed",
+			ID:    "forbidden",
+		})
+		return nil, false
+	}
+	req := new(apiSendRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		if bodyTooLarge(err) {
+			writeBodyTooLarge(w)
+			return nil, false
+		}
+		writeAPIError(w, http.StatusBadRequest, &rest.Error{
+			Title:  "Could not parse request body as JSON",
+			ID:     "invalid_json",
+			Detail: err.Error(),
+		})
+		return nil, false
+	}
+	req.Subject = strings.TrimSpace(req.Subject)
+	req.Body = strings.TrimSpace(req.Body)
+	return req, true
+}
+
+type apiMessage struct {
+	To      apiAddress   `json:"to"`
+	CC      []apiAddress `json:"cc"`
+	Subject string       `json:"subject"`
+	Text    string       `json:"text"`
+	HTML    string       `json:"html"`
+}
+
+// Job status values.
+const (
+	jobPending   = "pending"
+	jobCompleted = "completed"
+)
+
+// Result status values.
+const (
+	resultPending = "pending"
+	resultSent    = "sent"
+	resultFailed  = "failed"
+)
+
+type apiResult struct {
+	To     apiAddress `json:"to"`
+	Status string     `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// A Job tracks a letter being sent to every recipient in a group.
+type Job struct {
+	ID          string       `json:"id"`
+	GroupID     string       `json:"group_id"`
+	Status      string       `json:"status"`
+	CreatedAt   time.Time    `json:"created_at"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+	Sent        int          `json:"sent"`
+	Failed      int          `json:"failed"`
+	Results     []*apiResult `json:"results"`
+
+	owner string
+	done  chan struct{}
+}
+
+// How long finished jobs are kept around for GET /v1/jobs/<id>.
+var jobRetention = 24 * time.Hour
+
+// jobStore keeps jobs in memory, so job status is only available from the
+// server that accepted the job, and is lost on restart.
+type jobStore struct {
+	mu   sync.Mutex
+	jobs map[string]*Job
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{jobs: make(map[string]*Job)}
+}
+
+func (s *jobStore) add(j *Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for id, old := range s.jobs {
+		if old.CompletedAt != nil && now.Sub(*old.CompletedAt) > jobRetention {
+			delete(s.jobs, id)
+		}
+	}
+	s.jobs[j.ID] = j
+}
+
+// get returns a copy of the job with the given ID, if it exists and belongs to
+// owner.
+func (s *jobStore) get(id, owner string) (*Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok || j.owner != owner {
+		return nil, false
+	}
+	cp := *j
+	cp.Results = make([]*apiResult, len(j.Results))
+	for i := range j.Results {
+		r := *j.Results[i]
+		cp.Results[i] = &r
+	}
+	return &cp, true
+}
+
+func (s *jobStore) finish(j *Job, results []*SendResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, result := range results {
+		if result.Err == nil {
+			j.Results[i].Status = resultSent
+			j.Sent++
+		} else {
+			j.Results[i].Status = resultFailed
+			j.Results[i].Error = result.Err.Error()
+			j.Failed++
+		}
+	}
+	now := time.Now().UTC()
+	j.CompletedAt = &now
+	j.Status = jobCompleted
+	close(j.done)
+}
+
+func (m *Mailer) apiListGroups(w http.ResponseWriter, r *http.Request) {
+	listed := listedGroups(m.Groups, signedInEmail(r, m.secrets))
+	groups := make([]*apiGroup, len(listed))
+	for i := range listed {
+		groups[i] = newAPIGroup(listed[i])
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"groups": groups})
+}
+
+func (m *Mailer) apiGetGroup(w http.ResponseWriter, r *http.Request) {
+	match := apiGroupRx.FindStringSubmatch(r.URL.Path)
+	group, ok := m.Groups[match[1]]
+	if !ok || !group.VisibleTo(signedInEmail(r, m.secrets)) {
+		writeAPIError(w, http.StatusNotFound, &rest.Error{Title: "Group not found", ID: "not_found", Instance: r.URL.Path})
+		return
+	}
+	writeJSON(w, http.StatusOK, newAPIGroup(group))
+}
+
+// apiIdentity checks the sender details in req, if the site asks for any, and
+// signs auth's letters with them. If they're invalid it writes an error and
+// returns false.
+func (m *Mailer) apiIdentity(w http.ResponseWriter, r *http.Request, auth *Account, req *apiSendRequest) bool {
+	if len(m.identityFields) == 0 {
+		return true
+	}
+	identity := req.Sender
+	if identity == nil {
+		identity = new(Identity)
+	}
+	if verr := m.validateIdentity(requestLocale(r, ""), identity); verr != nil {
+		writeAPIError(w, http.StatusBadRequest, verr)
+		return false
+	}
+	auth.identity = identity
+	return true
+}
+
+func (m *Mailer) apiPreview(w http.ResponseWriter, r *http.Request, auth *Account) {
+	req, ok := decodeSendRequest(w, r)
+	if !ok {
+		return
+	}
+	group, verr := m.validateSend(requestLocale(r, ""), req.Subject, req.Body, req.GroupID, auth.Email)
+	if verr != nil {
+		writeAPIError(w, http.StatusBadRequest, verr)
+		return
+	}
+	if !m.apiIdentity(w, r, auth, req) {
+		return
+	}
+	// Addresses are shown the same way as on the group's public pages.
+	public := group.PublicRecipients()
+	messages := make([]*apiMessage, len(group.Recipients))
+	for i, recipient := range group.Recipients {
+		msg := newMessage(sendFrom(auth, group), recipient, req.Subject, req.Body, auth.identity)
+		am := &apiMessage{
+			To:      newAPIAddress(public[i].Address),
+			CC:      make([]apiAddress, len(public[i].CC)),
+			Subject: msg.Subject,
+			Text:    msg.Body,
+			HTML:    msg.HTMLBody,
+		}
+		for j := range public[i].CC {
+			am.CC[j] = newAPIAddress(public[i].CC[j])
+		}
+		messages[i] = am
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"messages": messages})
+}
+
+func (m *Mailer) apiSend(w http.ResponseWriter, r *http.Request, auth *Account) {
+	req, ok := decodeSendRequest(w, r)
+	if !ok {
+		return
+	}
+	group, verr := m.validateSend(requestLocale(r, ""), req.Subject, req.Body, req.GroupID, auth.Email)
+	if verr != nil {
+		writeAPIError(w, http.StatusBadRequest, verr)
+		return
+	}
+	if !m.apiIdentity(w, r, auth, req) {
+		return
+	}
+	job := &Job{
+		ID:        randomHex(16),
+		GroupID:   group.ID,
+		Status:    jobPending,
+		CreatedAt: time.Now().UTC(),
+		Results:   make([]*apiResult, len(group.Recipients)),
+		owner:     auth.Email.Address,
+		done:      make(chan struct{}),
+	}
+	for i, recipient := range group.PublicRecipients() {
+		job.Results[i] = &apiResult{To: newAPIAddress(recipient.Address), Status: resultPending}
+	}
+	m.jobs.add(job)
+	// Sending continues even if the client goes away, so the job can be
+	// checked on later.
+	acct := auth.detached()
+	go func() {
+		results := m.send(context.Background(), acct, group, req.Subject, req.Body)
+		m.jobs.finish(job, results)
+	}()
+	code := http.StatusAccepted
+	if req.Wait == nil || *req.Wait {
+		select {
+		case <-job.done:
+			code = http.StatusOK
+		case <-r.Context().Done():
+			return
+		}
+	}
+	w.Header().Set("Location", "/v1/jobs/"+job.ID)
+	cp, _ := m.jobs.get(job.ID, job.owner)
+	writeJSON(w, code, cp)
+}
+
+func (m *Mailer) apiGetJob(w http.ResponseWriter, r *http.Request, auth *Account) {
+	match := apiJobRx.FindStringSubmatch(r.URL.Path)
+	job, ok := m.jobs.get(match[1], auth.Email.Address)
+	if !ok {
+		writeAPIError(w, http.StatusNotFound, &rest.Error{Title: "Job not found", ID: "not_found", Instance: r.URL.Path})
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+// apiUnauthorized is served in place of the login page for API requests
+// without a valid session.
+func apiUnauthorized(w http.ResponseWriter, r *http.Request) {
+	writeAPIError(w, http.StatusUnauthorized, &rest.Error{
+		Title:    "Please log in with Google before using the API",
+		ID:       "unauthorized",
+		Instance: r.URL.Path,
+	})
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	google "github.com/kevinburke/google-oauth-handler"
+	"golang.org/x/oauth2"
+)
+
+func apiMux(t *testing.T) (http.Handler, *[32]byte) {
+	t.Helper()
+	secrets := NewSecrets(Keys{NewRandomKey()})
+	key := secrets.Auth[0]
+	mailer := &Mailer{Groups: map[string]*Group{
+		"test-group-slug": group,
+	}, secrets: secrets}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	return mux, key
+}
+
+func TestAPIListGroups(t *testing.T) {
+	t.Parallel()
+	mux, _ := apiMux(t)
+	req := httptest.NewRequest("GET", "/v1/groups", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("GET /v1/groups: got code %d, want 200", w.Code)
+	}
+	var resp struct {
+		Groups []*apiGroup `json:"groups"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Groups) != 1 || resp.Groups[0].ID != "test-group-slug" {
+		t.Fatalf("GET /v1/groups: got %s", w.Body.String())
+	}
+	if r := resp.Groups[0].Recipients[0]; r.Email != "recipient@example.com" || len(r.CC) != 1 {
+		t.Errorf("GET /v1/groups: bad recipient %#v", r)
+	}
+
+	req = httptest.NewRequest("GET", "/v1/groups/unknown", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 404 {
+		t.Errorf("GET /v1/groups/unknown: got code %d, want 404", w.Code)
+	}
+}
+
+func TestAPIRequiresLogin(t *testing.T) {
+	t.Parallel()
+	mux, _ := apiMux(t)
+	req := httptest.NewRequest("POST", "/v1/preview", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 401 {
+		t.Errorf("POST /v1/preview: got code %d, want 401", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("POST /v1/preview: got Content-Type %q, want JSON", ct)
+	}
+}
+
+func TestAPIPreview(t *testing.T) {
+	t.Parallel()
+	mux, key := apiMux(t)
+	body := `{"group_id": "test-group-slug", "subject": "Bike lanes", "body": "Please *support* the bike lanes."}`
+	req := httptest.NewRequest("POST", "/v1/preview", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.AddCookie(authCookie(t, key, "Sender <sender@example.com>"))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("POST /v1/preview: got code %d, want 200: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Messages []*apiMessage `json:"messages"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Messages) != 1 {
+		t.Fatalf("POST /v1/preview: got %d messages, want 1", len(resp.Messages))
+	}
+	msg := resp.Messages[0]
+	if want := "Dear Test Group,\n\nPlease *support* the bike lanes."; msg.Text != want {
+		t.Errorf("preview text: got %q, want %q", msg.Text, want)
+	}
+	if !strings.Contains(msg.HTML, "<em>support</em>") {
+		t.Errorf("preview html: should render Markdown, got %q", msg.HTML)
+	}
+
+	// Same validation as the form.
+	req = httptest.NewRequest("POST", "/v1/preview", strings.NewReader(`{"group_id": "test-group-slug", "body": "hi"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.AddCookie(authCookie(t, key, "sender@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 400 || !strings.Contains(w.Body.String(), "missing_subject") {
+		t.Errorf("POST /v1/preview without subject: got %d %s, want 400", w.Code, w.Body.String())
+	}
+
+	// Form-encoded bodies could be sent cross-site by a browser.
+	req = httptest.NewRequest("POST", "/v1/preview", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/plain")
+	req.AddCookie(authCookie(t, key, "sender@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("POST /v1/preview as text/plain: got code %d, want 415", w.Code)
+	}
+}
+
+func TestAPIRecipientVisibility(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(&gmailStandIn{labeled: make(map[string][]string)})
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	m, _ := drainMailer()
+	m.jobs = newJobStore()
+	m.secrets = NewSecrets(Keys{NewRandomKey()})
+	for _, visibility := range []string{addressesObfuscate, addressesHide} {
+		g := *group
+		g.AddressVisibility = visibility
+		m.Groups = map[string]*Group{g.ID: &g}
+		acct := &Account{
+			Email:    &mail.Address{Address: "volunteer@gmail.com"},
+			Client:   &http.Client{Transport: redirectTransport{u}},
+			Provider: providerGoogle,
+		}
+		body := `{"group_id": "test-group-slug", "subject": "Bike lanes", "body": "Please build bike lanes."}`
+		for _, path := range []string{"/v1/preview", "/v1/messages"} {
+			req := httptest.NewRequest("POST", path, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			if path == "/v1/preview" {
+				m.apiPreview(w, req, acct)
+			} else {
+				m.apiSend(w, req, acct)
+			}
+			if w.Code != 200 {
+				t.Fatalf("POST %s: got %d %s, want 200", path, w.Code, w.Body.String())
+			}
+			b := w.Body.String()
+			if strings.Contains(b, "recipient@example.com") || strings.Contains(b, "cc@example.com") {
+				t.Errorf("POST %s with recipient_addresses %s: response shows an address: %s", path, visibility, b)
+			}
+			if want := "r***@example.com"; visibility == addressesObfuscate && !strings.Contains(b, want) {
+				t.Errorf("POST %s with recipient_addresses obfuscate: should see %q, got %s", path, want, b)
+			}
+		}
+	}
+}
+
+// jobAccount returns a Google account whose token has expired, like one in a
+// request made an hour after signing in, and the Gmail stand-in it sends to.
+// The token endpoint waits for release to be closed before refreshing it.
+// Callers should close the returned server.
+func jobAccount(t *testing.T, reqCtx context.Context, release chan struct{}) (*httptest.Server, *Account, *gmailStandIn) {
+	t.Helper()
+	gmailServer := &gmailStandIn{labeled: make(map[string][]string)}
+	mux := http.NewServeMux()
+	mux.Handle("/", gmailServer)
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"access_token":"fresh","token_type":"Bearer","expires_in":3600}`)
+	})
+	server := httptest.NewServer(mux)
+	u, _ := url.Parse(server.URL)
+	base := &http.Client{Transport: redirectTransport{u}}
+	conf := &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}}
+	tok := &oauth2.Token{AccessToken: "stale", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Minute)}
+	return server, &Account{
+		Email:    &mail.Address{Address: "volunteer@gmail.com"},
+		Client:   conf.Client(context.WithValue(reqCtx, oauth2.HTTPClient, base), tok),
+		Provider: providerGoogle,
+		newClient: func(ctx context.Context) *http.Client {
+			return conf.Client(context.WithValue(ctx, oauth2.HTTPClient, base), tok)
+		},
+	}, gmailServer
+}
+
+func TestAPISendWait(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	close(release)
+	server, acct, gmailServer := jobAccount(t, context.Background(), release)
+	defer server.Close()
+	m, _ := drainMailer()
+	m.jobs = newJobStore()
+	m.Groups = map[string]*Group{group.ID: group}
+	req := httptest.NewRequest("POST", "/v1/messages", strings.NewReader(`{"group_id": "test-group-slug", "subject": "Bike lanes", "body": "Please build bike lanes."}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	m.apiSend(w, req, acct)
+	if w.Code != 200 {
+		t.Fatalf("POST /v1/messages: got %d %s, want 200", w.Code, w.Body.String())
+	}
+	job := new(Job)
+	if err := json.Unmarshal(w.Body.Bytes(), job); err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != jobCompleted || job.Sent != 1 || job.Failed != 0 || job.Results[0].Status != resultSent {
+		t.Errorf("POST /v1/messages: got %s, want one message sent", w.Body.String())
+	}
+	if loc := w.Header().Get("Location"); loc != "/v1/jobs/"+job.ID {
+		t.Errorf("POST /v1/messages: got Location %q", loc)
+	}
+	if gmailServer.sent != 1 {
+		t.Errorf("got %d messages sent, want 1", gmailServer.sent)
+	}
+}
+
+func TestAPISendInBackground(t *testing.T) {
+	t.Parallel()
+	reqCtx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	server, acct, gmailServer := jobAccount(t, reqCtx, release)
+	defer server.Close()
+	m, _ := drainMailer()
+	m.jobs = newJobStore()
+	m.Groups = map[string]*Group{group.ID: group}
+	req := httptest.NewRequest("POST", "/v1/messages", strings.NewReader(`{"group_id": "test-group-slug", "subject": "Bike lanes", "body": "Please build bike lanes.", "wait": false}`))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(reqCtx)
+	w := httptest.NewRecorder()
+	m.apiSend(w, req, acct)
+	// The request is over before the job refreshes the token.
+	cancel()
+	close(release)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("POST /v1/messages with wait false: got %d %s, want 202", w.Code, w.Body.String())
+	}
+	job := new(Job)
+	if err := json.Unmarshal(w.Body.Bytes(), job); err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != jobPending || job.Results[0].Status != resultPending {
+		t.Errorf("POST /v1/messages with wait false: got %s, want a pending job", w.Body.String())
+	}
+
+	loc := w.Header().Get("Location")
+	deadline := time.Now().Add(5 * time.Second)
+	for job.Status != jobCompleted {
+		if time.Now().After(deadline) {
+			t.Fatalf("GET %s: job still %s", loc, job.Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+		w = httptest.NewRecorder()
+		m.apiGetJob(w, httptest.NewRequest("GET", loc, nil), acct)
+		if w.Code != 200 {
+			t.Fatalf("GET %s: got %d %s, want 200", loc, w.Code, w.Body.String())
+		}
+		job = new(Job)
+		if err := json.Unmarshal(w.Body.Bytes(), job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if job.Sent != 1 || job.Failed != 0 || job.Results[0].Status != resultSent || job.CompletedAt == nil {
+		t.Errorf("GET %s: got %s, want one message sent", loc, w.Body.String())
+	}
+	gmailServer.mu.Lock()
+	defer gmailServer.mu.Unlock()
+	if gmailServer.sent != 1 {
+		t.Errorf("got %d messages sent, want 1", gmailServer.sent)
+	}
+
+	// Other users can't see the job.
+	w = httptest.NewRecorder()
+	m.apiGetJob(w, httptest.NewRequest("GET", loc, nil), &Account{Email: &mail.Address{Address: "someone@example.com"}})
+	if w.Code != 404 {
+		t.Errorf("GET %s as someone else: got %d, want 404", loc, w.Code)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	t.Parallel()
+	mux, _ := apiMux(t)
+	req := httptest.NewRequest("GET", "/v1/openapi.json", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("GET /v1/openapi.json: got code %d, want 200", w.Code)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("GET /v1/openapi.json: invalid JSON: %v", err)
+	}
+}
@@ -1,10 +1,19 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// templates/index.html (7.759kB)
+// templates/embed.html (5.841kB)
+// templates/index.html (11.996kB)
+// templates/layout.html (1.074kB)
+// templates/not-authorized.html (1.390kB)
+// templates/page.html (950B)
+// templates/signed-in.html (887B)
 // static/bootstrap.min.css (121.201kB)
+// static/embed.js (1.862kB)
 // static/license.txt (1.605kB)
-// static/privacy.html (1.469kB)
-// static/style.css (348B)
+// static/openapi.json (8.538kB)
+// static/privacy.html (1.734kB)
+// static/style.css (716B)
+// locales/es.yml (8.307kB)
+// locales/zh.yml (7.810kB)
 
 package assets
 
@@ -73,7 +82,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\xff\x8f\xdb\x36\xb2\xff\x3d\x7f\xc5\x3c\xf6\x21\xb6\xb1\x6b\xa9\x8b\xf6\x3d\x14\x59\xc9\x45\xb3\x09\x92\xc5\x25\x4d\x91\x4d\xbf\x00\x87\x43\x41\x89\x63\x8b\xb7\x14\xa9\x92\x94\xbd\xbe\x85\xff\xf7\xc3\x50\xb2\x2d\x59\xf2\xf6\xd2\xde\x01\xf7\x4b\xa2\x25\x39\xc3\xf9\x3e\x9f\xa1\x93\xff\x11\x26\xf7\xdb\x0a\xa1\xf0\xa5\x5a\x3c\x4b\x9a\xff\x00\x92\x02\xb9\xa0\x0f\x80\xa4\x44\xcf\x21\x2f\xb8\x75\xe8\x53\x56\xfb\xe5\xfc\x1b\xd6\xdd\x2a\xbc\xaf\xe6\xf8\x5b\x2d\xd7\x29\xfb\x65\xfe\xe3\x77\xf3\x1b\x53\x56\xdc\xcb\x4c\x21\x83\xdc\x68\x8f\xda\xa7\xec\xf6\x75\x8a\x62\x85\x3d\x4a\xcd\x4b\x4c\xd9\x5a\xe2\xa6\x32\xd6\x77\x0e\x6f\xa4\xf0\x45\x2a\x70\x2d\x73\x9c\x87\x3f\x2e\x41\x6a\xe9\x25\x57\x73\x97\x73\x85\xe9\x15\x5b\x3c\x6b\x38\x79\xe9\x15\x2e\xde\xd7\xca\x4b\x78\x5d\x72\xa9\xd0\x26\x71\xb3\xd8\x1c\x50\x52\xdf\x83\x45\x95\x32\xe7\xb7\x0a\x5d\x81\xe8\x19\x14\x16\x97\x29\x8b\x9d\xe7\x5e\xe6\x71\x66\x8c\x77\xde\xf2\x2a\x2a\xa5\x8e\x72\xe7\xd8\x67\x10\x87\x9d\x03\x51\x12\xef\x6d\x97\x64\x46\x6c\x5b\x3e\x42\xae\x21\x57\xdc\xb9\x94\x91\x96\x5c\x6a\xb4\xf3\xa5\xaa\xa5\x68\x6f\xea\x9f\xb1\x66\x73\x58\x3f\xa5\x56\xf3\x52\xcc\xff\xbf\xb3\x0d\xf0\xf8\x08\x72\x09\xd1\x27\xd2\x1a\x76\xbb\xce\x4e\x52\x5c\x2d\x1e\x1f\x8f\x5b\x49\x5c\x5c\x9d\x50\xa2\x72\x23\x44\x25\x19\x74\x8e\x7b\x83\x0e\xa9\xb4\xe8\x12\x25\xb1\x90\xeb\x27\x25\xbe\x82\xf6\xc3\x2c\x97\x0e\xfd\xfc\xff\xc6\x34\x08\x0e\x3c\x11\x66\x69\x6c\x09\x52\xa4\x4c\x99\x95\xa9\xfd\x9c\xfe\x66\x50\xa2\x2f\x8c\x48\xd9\x0f\x1f\xee\x3e\x31\xe0\xb9\x97\x46\xa7\x2c\x6e\xce\xf4\x58\x03\x24\xd5\x5e\x96\xd1\x6d\x80\x84\x77\x2f\x20\x9f\x33\x30\x3a\x57\x32\xbf\x4f\x19\xdb\x13\x6b\xbe\x6e\xf7\x5a\xff\xb3\xc5\x3b\xb3\x02\xb3\x5c\x26\x31\x3f\xb9\x31\xae\xba\x0b\x49\x4c\x42\xf7\x56\x5c\x6e\x65\xe5\xfb\x54\xc2\xe4\x75\x89\xda\x47\x2b\xf4\xaf\x15\xd2\xe7\xcb\xed\xad\x98\x4e\x3a\x82\x4d\x66\x51\x2b\x19\xa4\xb0\xac\x75\x50\x7c\x8a\xeb\x19\x3c\xf6\x78\xe1\x3a\xaa\x2c\xae\x51\xfb\x57\xb8\xe4\xb5\xf2\xd3\xd9\xf5\x67\x5d\x46\x12\x4f\x66\x91\xab\xb3\x52\x0e\x88\x77\x9f\xc7\xeb\x20\x78\x69\x6a\x87\xc2\x6c\xf4\x7f\x8d\xf0\x49\x3c\xf4\xc4\xef\x84\x77\xef\x8f\x7d\xe0\x5a\x6b\xec\x91\xe2\x4f\xe4\x72\xf7\x00\x57\x68\x3d\x84\x7f\xe7\x82\xeb\x15\x5a\x06\xd6\x28\x6c\x77\x58\x48\xed\xfd\xd5\xa7\x29\xf8\x94\xc8\x3d\xf5\x5a\x15\xee\xea\x3c\x47\xe7\xfe\xa3\x4a\xb8\xe6\x8e\x11\x2d\x8e\xb7\xff\x61\x3d\x9e\x90\x37\xd4\x90\x33\x25\x63\x7d\x15\x3b\xd4\x82\x41\x7c\x4e\x83\x51\x15\x9f\xaa\x59\x54\x72\xfa\x67\xef\x50\x0b\xa9\x57\x50\xa2\x73\x7c\x85\x0e\x96\xd6\x94\x90\x64\x8d\x07\x5b\x0e\x49\x9c\x2d\x22\xf8\x54\xe0\xf1\xd8\x46\x2a\x05\xbc\xaa\x90\xdb\x1e\x3f\x25\xef\x11\x2a\xb4\xce\x68\xae\xe4\x3f\x50\x40\x28\xd5\x2d\xdf\xad\xa9\x2d\xbc\x79\x4f\x5c\x79\x9e\x9b\x5a\xfb\xe8\xa9\xf2\x34\x14\x37\xc9\x16\x77\x9e\x5b\xdf\x70\x52\xe8\x3d\x5a\xc8\xb6\x20\x90\xb2\x25\x23\x4d\x36\x05\x5a\xa4\x7d\x50\x72\x8d\x97\x24\x3b\x18\xdb\x10\xe4\x46\x6b\x0c\xf6\x05\x6f\xc0\x17\x28\x2d\x08\xe9\xbc\x95\xb9\x8f\xe0\xd6\x43\xc9\xef\xd1\x35\x67\x5b\x55\xa1\x34\x16\xa1\x32\x1b\xb4\xcb\x5a\xfd\x9e\xb8\x1d\xe7\x90\x6b\xe7\x2b\x6b\xea\x6a\x58\xd6\x15\xcf\x50\xc1\xd2\xd8\x94\xb9\x3a\xfb\x3b\xe6\x9e\x2d\xee\x9a\x8f\x24\x0e\x9b\x03\x12\xa9\xab\xda\x87\x6e\xb0\xa7\xe8\x5d\x44\xcd\xdb\x1a\xc5\xc0\x12\xe2\xb1\x28\x52\xe6\x6d\x8d\x0c\x08\x45\xa5\xcc\xe3\x83\x67\x2d\xae\x39\xd0\xaf\xb9\xaa\x31\x65\x4d\x94\x87\x35\xd8\xed\x18\x54\x8a\xe7\x58\x18\x25\xd0\xa6\xec\x6e\x7f\x38\x3e\x6d\x24\xbd\x64\xf8\x83\xca\x13\x10\x09\x69\x46\xf1\xfa\xa1\x42\x2d\xf5\xea\x9d\xd4\xd4\xf6\x49\xaa\xfe\xca\xe5\x11\x13\xbc\xe7\x5b\x63\xe3\x1b\x53\xeb\x5c\xaa\x12\xcb\x0c\x6d\x7c\x57\x57\x68\xd7\xd2\x19\x0b\x89\x5c\x4c\x49\x57\x07\xbc\xf6\x66\xde\x0b\xc7\x6c\x0b\xde\x18\x05\x46\x03\x65\xd7\x2c\x89\xe5\xe2\xf2\x90\xb1\xe7\xac\x4f\xf6\xe3\x16\x9b\x76\x1c\xa4\x1e\xb7\x7e\x63\xe1\xe6\xc0\xa9\x27\xac\xd9\xb8\x94\x5d\x7d\x39\xb0\x08\x65\xec\x3c\x98\xe0\xa5\x11\x5b\x98\x9f\xa4\xec\xf1\xc8\xf9\x7d\xda\x0d\xb6\x19\xdb\xbc\x0d\x99\x00\x52\xc3\x73\xe5\xaf\xa5\x76\x54\xf7\xf6\x61\x0f\x94\x2e\xcf\x57\xfe\x1a\xb8\x16\x70\x0b\x53\x8b\xda\xc7\x05\x5f\x23\xdc\x4b\xe1\x62\x2b\x05\x42\xb9\x85\x4c\xde\x63\xec\x91\xe7\x05\x38\x5f\x0b\xd4\xde\xcd\x22\xb8\x9d\x94\x94\x54\x39\x5a\x8d\x02\x78\x66\x6a\x1f\x45\x51\xcb\x6a\x63\x6a\x25\x9a\x8a\x40\xe9\xe8\x0d\x4c\x5d\x5d\x11\xa4\x8e\x4d\x55\x19\x87\x33\x88\xa2\x68\x5c\x17\x2d\x46\x54\x49\xe2\xbd\x1f\x06\x0e\x3a\x20\xa9\x02\x55\x35\xcf\x94\xc9\xef\xd9\x82\xbd\x42\x6e\x83\xd2\xbf\x90\x86\x97\xac\x29\x5b\x19\x86\xb8\x28\x09\x24\x73\xa5\xb6\xd0\x98\x04\x05\x05\x85\x2f\x10\x96\xd2\x3a\x0f\x8a\xc2\x6e\x23\x7d\x11\xd6\x9a\x20\x9a\xb8\xe0\xe2\x28\xc9\x2c\xc4\x8b\xbb\x46\x1b\x47\x38\xad\x81\x5e\x34\x73\xbc\x88\xe3\xdc\x94\xa5\xd1\x25\xb7\xf7\x91\xb1\xab\x98\x64\x8a\xd9\xe2\x3d\xb7\xf7\x84\x31\x08\x95\x81\xdb\x6a\xcf\x1f\xa2\x61\x09\x79\x3a\xb1\xfa\xfd\x63\xb8\xdf\xb6\x84\xaf\x47\x82\x2c\xc9\x6a\xef\x8d\xde\x9f\xcc\xbc\x86\xcc\xeb\x79\x65\x65\xc9\xed\x76\x5f\x29\x1a\x68\xc2\x16\xd4\x18\x92\xb8\x21\x19\x5c\x38\x94\x71\x54\x8a\x6f\xc6\xa4\xa0\x63\x61\x32\x69\xaa\xd2\x9c\x2b\xb9\xd2\x2f\xc0\xca\x55\xe1\xaf\x47\x08\x02\x0a\x0e\x73\x53\xca\x6e\x02\xbe\xf4\x06\x72\x53\x1d\x53\x30\x57\xb2\xca\x0c\xb7\x62\x0f\x80\xbf\x60\x8b\x37\xe8\x81\x83\x2b\x28\x58\x32\x85\x10\xe6\xa5\xa5\xb1\xe0\x0b\xe9\x9a\x9e\x04\xcf\xbf\x78\xb8\x5a\x7e\x9d\x67\xd7\x03\x98\xdc\xde\xdb\xd4\xdc\x83\x4e\xd5\x76\xee\xb9\x5d\xd1\xa0\xd5\xad\xaa\x6d\x19\x1d\x94\xc8\xf3\x96\x1a\x71\xf1\x70\x69\x74\x02\xa2\x19\xe8\xab\xc5\x77\xb5\x2f\x50\x53\xf4\xfa\x36\x42\xdf\x18\xb3\x52\x98\xc4\xc5\x57\x4f\xf7\xcf\x4f\xa4\x7d\xa8\x7f\x4d\xa7\x93\x1e\x90\xbb\x6d\x63\x52\xed\x79\xde\x76\xd6\xaa\xce\x94\xcc\x69\x84\x90\xb9\xe4\xca\x5d\x42\x56\x7b\xd8\x60\x8f\x99\x46\x14\xed\x71\xb4\xa5\x74\xae\xed\xab\x54\x57\xf7\x6d\xdf\xe8\xe6\x44\x86\x05\x57\xcb\xcf\xec\xf6\x3f\x23\x18\xad\xb6\xcd\x45\x94\x84\xec\xc8\x9a\x75\x2e\xbd\x86\x0d\x52\xd1\xcf\xb9\xd6\xc6\x53\x45\x07\x8b\x5c\xf4\x78\x05\x29\xa4\xce\xcc\x03\xa1\x01\x87\x78\x40\x04\xa4\xb5\x8b\xe8\x32\x61\x40\x1b\x0f\xce\x53\xcb\xa7\xfb\xda\xc1\xdf\xf5\x38\x99\xe5\x5e\x39\xaa\x6a\x41\x22\x6f\x1a\x6e\xa8\x30\x0f\x75\x64\x6f\xb7\xcf\x85\x37\xfb\x3a\x42\xdd\x8f\xbc\xfc\xe3\xc7\x77\xa1\x27\x9f\xe4\xec\x01\xae\xf6\x73\xf6\x7c\x5c\x3c\x3d\x06\x8e\x4d\x16\x23\x31\xf9\x67\xa1\x67\xf1\xd5\xe2\xe7\xc2\x80\x2b\x42\x5f\xd8\xe0\xde\x76\xdf\x0e\xe3\xf6\x89\xe0\x7f\x43\xa8\x22\xd8\x7e\xd2\xd6\x72\xca\xef\x93\xb0\xf3\xe6\xc5\x38\xd3\x53\x25\x89\xa7\xfd\x0c\x1d\xba\xa5\x98\x0b\x69\xce\xc0\x9b\x91\x4a\xd0\x14\x93\xc6\x61\x0d\x69\x8b\x16\x02\x4c\xfa\x55\x8a\x21\x62\x20\xb0\xe1\xd1\x1d\x6b\x4c\xf8\x63\xc8\x9b\x6a\x35\x70\xa0\xdd\x03\x6c\x6d\x43\xd2\xa1\x5a\x0e\xca\xcf\x88\x88\x67\x0a\xd0\xd0\x5c\x8f\x8f\x60\x69\xe2\x83\xa8\x75\xc4\xbf\xcd\x42\x8d\xdd\xff\x77\xdc\xf0\x7f\xc6\x84\x94\x4c\xb7\xaf\x42\x1e\x75\x20\x6f\xb3\x32\x2a\xc6\x88\xda\xed\x4e\xf4\x3d\x2f\x29\x2a\x5b\x61\x57\x1e\xa6\x0a\x35\x44\x1f\x31\x97\x95\x0c\xa0\x08\xae\x60\xb7\x9b\x3e\x3e\xc2\xc9\x06\x51\xd9\xe3\xb1\x63\x84\x4f\xaf\x8e\xeb\x0d\xdb\xa9\xd4\x02\x1f\x7a\xc4\x5f\xce\xa2\x9b\x1b\x02\xc1\xd0\x72\x7e\xe2\x0c\xe4\xf9\x44\x1c\xd4\x98\xb5\x5f\xe7\x60\x63\xb0\xf9\xad\x7b\x6b\x4a\xac\x28\x70\xc6\x0c\xbf\x2f\x4b\x71\xc7\x70\xd4\x51\x47\x1b\xe7\x01\x8b\xfe\x6b\x9c\xe2\xa3\x51\x18\x34\xfd\x35\x65\xbf\x66\x8a\xeb\x7b\xb6\x70\x85\xd9\x00\x17\xc2\xba\xf3\x57\x9d\x81\x8a\x7f\x3c\xc8\x07\x83\x7e\xf7\x91\xac\x3f\xf6\x2f\x8d\xf1\x78\xa8\x1f\xdd\x72\x7e\x63\x91\xfb\x66\xde\xe8\xa1\x43\xf7\x22\x8e\xb3\xda\xde\x63\xe4\x68\x5c\xc9\xd1\xb1\xc5\x5f\x70\x2d\xf5\x81\xf2\x25\xed\x92\xba\xed\xc8\xdd\x7d\xf0\x04\xe9\x68\x06\x0e\x5d\x2e\x5b\xc0\xd2\xe2\xb1\x25\x3b\xb3\xf4\x1b\x6e\x31\x82\x97\x5b\x58\x4b\xdc\xd0\x34\x1c\xe0\x0e\xf9\xf5\x32\x74\x2b\xbe\xb2\x88\xed\xec\xfb\x6c\xe8\x14\x8f\xb6\x74\x73\xb3\x9c\xb7\xb2\xb1\x45\x58\xa1\x96\x57\xbb\x56\xa6\x8f\xc8\x05\x98\xda\x8e\x90\x57\x56\xae\x79\xbe\x65\x8b\xf6\x03\x2a\xa3\x64\xbe\x0d\x74\x07\xf3\x55\x43\x6b\xdd\x98\xb2\x92\x0a\x05\xd4\x8e\x64\xa6\xd0\xf8\x09\x6d\x80\x13\xbb\x5d\x34\xb4\xdf\x4a\xfa\xa2\xce\xa2\xdc\x94\xf1\x3d\x99\x2e\xd8\x33\xee\x19\x8a\x2d\x7e\x92\xb8\x09\x4d\xdc\x99\xda\xe6\xd4\xcb\x05\x86\xc1\xc4\x22\x81\x76\x40\x6b\x4d\x2f\xac\xba\xa2\xc5\x27\x8e\xed\xbf\xc5\xad\xb9\x85\xf0\xb0\xdf\xbe\xeb\x43\x0a\x8f\x87\xc7\xbb\xee\x46\xf4\x43\xc0\x51\x6f\x8d\xf3\x90\x42\x28\x3b\x9d\x95\xdd\x8e\x8d\x12\xe1\xfa\x2d\xd7\xa2\xe1\x7b\x78\x8c\x3c\x80\xdc\xd7\x0a\xcb\xee\xbb\xa4\x45\x5f\x5b\x7d\x3c\xd8\x7f\xb3\x24\x51\x2b\x7d\x63\xaa\x2d\xa4\xd0\xe3\x11\x55\x9c\x46\xbd\xef\x8d\xc0\xe8\xb7\x1a\xed\xf6\x2e\xa0\x17\x63\xa7\x93\xa8\x83\x75\x27\xbd\x47\x4a\x2a\x4e\x7b\x76\x69\x0a\xba\x56\xea\xf4\x8d\xb4\x91\xa7\x4b\xb4\x3b\x91\xa7\x7d\x80\x80\xf4\xfc\x73\x69\x7b\x64\x32\x8b\x42\xc5\xbe\x3e\xe1\x40\x03\xf6\x53\xe4\xb4\x3f\x46\xdb\x88\xde\xac\x43\x7a\xce\x55\x17\x07\xbe\xca\xe4\x9c\x8c\x1a\x55\xdc\x17\xd4\x6a\x2e\x26\xdf\xb6\xa2\xa5\x93\x0b\xd4\x14\x53\x3f\x7e\xbc\xa5\xf8\x35\x1a\xb5\x9f\xb6\x9b\xb3\x8b\xc9\x73\x92\x21\x9d\xc0\x05\x8c\x1c\xa3\xbd\xd9\x88\x60\x2e\xb8\xa0\xff\x2e\xec\xed\x76\x60\x61\x57\xab\x9e\xf9\xf0\x01\xf3\x1b\x53\x96\x5c\x8b\xe9\x84\x9c\x37\x39\x79\x5a\x26\xbf\xed\xc9\xd2\x14\x96\x5c\xd1\xf4\xfd\x78\x52\x34\x7d\x61\xcd\x06\x34\x6e\x20\xbc\xdd\x4e\xd9\x4d\x40\x6a\x84\x89\x89\x69\xd3\x3d\x5f\x00\x83\x8b\x9e\x25\x4f\x9f\xb1\xbb\xae\x87\x9c\xfb\xbc\x80\xe9\xe0\xb6\xdc\x68\x67\x14\x46\x21\x0d\xa7\xa7\x3c\xc2\x03\x6c\x7b\xbf\x9e\xb4\xd7\xd3\xb8\x75\x09\xce\x58\xbb\x8d\xe0\x2d\x5a\xa4\xf1\x45\xba\xdf\x11\xa8\x2b\x4e\xc3\x76\x72\x63\x2a\xb9\x1f\x5d\xc2\x88\x08\x84\xb3\x9b\xaa\x78\x4c\x92\xc9\x98\x87\x32\x55\xdb\xae\x7f\x0e\x69\xbf\xbb\x7e\xd6\x7e\x4d\x47\x53\x91\xc2\xf6\xc0\xda\x75\x9d\xd7\x4b\xbe\xef\x94\xa2\xfc\x1b\x93\x81\xa6\xd7\x29\xb1\x91\x90\xc2\x97\xd7\x20\x21\xe9\x70\x8c\x14\xea\x95\x2f\xae\x41\x5e\x5c\x0c\x4b\xc0\xe1\x5c\xb7\x0a\xb8\xbf\xca\xbf\x75\x55\x8c\x63\xf8\xf4\xe1\xd5\x07\xc8\x0b\xcc\xef\x01\x1f\xa4\xf3\x54\x8e\xc3\xef\x1d\xa0\xa4\xf3\xa8\xd1\x3a\x98\x83\x30\xe4\x93\x0d\xd7\x9e\x8c\x66\x71\x45\x7b\x96\x0e\xda\x2d\x78\x59\x76\xc7\xc4\xc3\x6d\x11\x17\xe2\x35\xb1\x7a\xd7\x72\x9a\x4e\xc2\xcf\x45\x93\xcb\x33\xd5\xef\x58\xf2\x66\x5d\x83\xef\xed\x3d\x3b\xba\xa1\xff\x5b\x49\x12\xef\x7f\xdf\x4c\xe2\xe6\x77\xe3\x7f\x06\x00\x00\xff\xff\xbc\x8a\x23\x4b\x4f\x1e\x00\x00")
+var _templatesEmbedHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x58\x6d\x6f\xdb\x46\x12\xfe\xee\x5f\x31\x47\x24\x90\x1c\x88\xa4\x83\xa2\x87\x22\x91\x04\x38\x89\x9b\x1a\x70\xda\xa2\x76\xee\x0e\x38\x1c\x82\x15\x39\x12\xb7\x22\xb9\xec\xee\x52\x3a\x9d\xab\xff\xde\x99\x5d\x52\x24\x65\x39\xb1\xef\x43\x62\x6a\x77\x66\x76\x5e\x9e\x99\x9d\xd9\xfb\xfb\x10\xe2\x57\x70\x09\x89\x2a\x2a\x91\x58\xd8\xa0\x36\x52\x95\xa0\x96\x60\x33\x84\x4a\xac\x10\x96\x4a\x83\x00\x23\xcb\x55\x8e\xb0\xd2\xaa\xae\x26\x6e\xad\x12\xda\x96\xa8\x69\xc7\xa2\x01\xab\xce\x4c\xa6\xb6\x20\x4b\x10\x25\xc8\xa5\x16\x05\xbe\x05\x83\x08\x58\x2c\x30\x8d\x56\x2a\x82\x57\x31\x84\xfb\xfd\xd9\xf4\x6f\xa9\x4a\xec\xae\x42\xc8\x6c\x91\xcf\xcf\xa6\xfc\x07\x72\x51\xae\x66\xc1\xfd\x3d\x44\x37\x2a\x11\x74\xd4\x7e\x1f\xcc\xcf\x00\xa6\x19\x8a\x94\x3f\xe8\xb3\x40\x2b\x20\xc9\x84\x36\x68\x67\x41\x6d\x97\xe1\x0f\x41\x7f\x2b\xb3\xb6\x0a\xf1\x8f\x5a\x6e\x66\xc1\xbf\xc2\xcf\x97\xe1\x7b\xb6\xcb\xca\x45\x8e\x01\xd9\x58\x5a\x2c\x89\xef\xfa\x6a\x86\xe9\x0a\x07\x9c\x25\xa9\x3b\x0b\x36\x12\xb7\x95\xd2\xb6\x47\xbc\x95\xa9\xcd\x66\x29\x6e\x64\x82\xa1\xfb\x31\x21\x1b\xa5\x95\x22\x0f\x0d\xeb\x39\x7b\x4d\x82\xbc\x24\x2b\x6d\x8e\x73\x36\xe1\x23\xbb\x29\xfa\x99\x84\x92\x19\xd3\xd8\x6f\x78\xa2\x85\x30\x08\x56\xe8\x15\x9b\xf0\x65\x41\x66\xaf\x5b\x4d\x72\x59\xae\x41\x63\x3e\x0b\x8c\xdd\xe5\x68\x32\x44\x52\x25\xd3\xb8\x74\x8e\x79\x11\xbd\x63\xde\xfd\x3e\x36\x96\x8c\x4a\xe2\x85\x52\xd6\x58\x2d\xaa\xa8\x90\x65\x94\x18\xf3\xff\x0a\x72\x54\x3d\x01\xf7\x84\x0b\x8b\x45\x95\x0b\x8b\x10\x70\x00\x02\x88\x88\x9e\xc3\x11\xb7\xf1\x98\x2e\x54\xba\x83\x24\x17\xc6\xcc\x02\x17\xe4\xf6\xf8\x54\x6e\xda\x75\xf6\xa3\x90\x04\x93\x70\x99\xd7\xb2\xa5\xe0\x13\x08\x23\x10\x5d\x69\x4d\x48\x72\x82\x8f\x39\xc9\xb5\xda\x82\xfb\x3f\x4c\x09\x1b\xa8\x03\xd0\x8a\xfc\xed\x77\x02\xe7\xe7\x96\x7f\x1a\x13\x67\x4f\x36\x96\x69\x27\xb5\x39\xeb\xb6\x4e\x12\x34\xe6\xdb\xa7\x19\x4f\x78\xe2\xb8\x4e\xc4\x57\x0f\x9c\x66\xdf\x9d\x42\x01\xad\x0e\x35\xf2\xfb\x1f\xd0\x24\x5a\x56\x96\xf3\xee\xa4\x6e\x19\xe6\x55\xb8\xc8\x55\xb2\x0e\x7a\x62\x87\x6c\x4f\x71\x80\xe7\x7b\x9f\x2b\x83\x7d\x65\xab\xb9\x83\xc4\x1d\x04\x2f\x0d\x18\xab\xaa\x8a\xb6\x05\x19\x4a\xb2\xcb\x15\xe4\x68\x2d\x55\x05\xa0\x63\x5e\x92\x4f\xfa\x46\x8d\x5f\x90\x16\x22\x25\xb4\xe1\x40\xba\xb9\xb4\xe7\x4e\xa7\x6a\xfe\xb8\x06\x9f\xc8\x91\x5c\x5e\x9e\x61\xf2\x31\xe3\xb7\x8c\xc6\x9c\x50\xde\x9d\xfb\xb9\xa2\x32\xc7\x26\x7d\x13\x01\xb2\x5c\x2a\x2a\x01\x75\x69\x53\xb5\x2d\x5b\x20\x70\xb2\xd4\xe6\x00\x61\x77\x44\xeb\x37\x55\x61\x69\x5c\x59\x7c\x9e\xbf\x7e\x61\x3e\xef\xae\x56\xa7\x63\xa3\x5a\x23\xae\x0a\x21\xf3\x1e\x1d\x1d\x56\x00\x15\xaf\x4c\xa5\xb3\xe0\xd7\x5f\x6e\xef\x02\x8a\x1a\xc3\xe1\x28\xc7\x37\xaf\x63\x43\x9e\x09\xba\xa2\x63\x30\x5f\xf6\xcc\x98\xca\xb2\xaa\x2d\x70\x45\x26\xcf\xcb\x34\x45\x32\xd9\x17\xc4\xc4\xe8\xe5\x17\xab\xd6\xbc\xb2\x11\x79\x8d\xbe\x42\xbf\xbf\xfd\xed\xc7\x3b\x5e\xe5\x22\x0d\xf1\x93\x44\xb9\x9b\xe3\x0b\x95\x80\xbe\x20\xef\x84\xeb\x0f\xcf\x90\xe3\x2b\x4d\x2b\xe4\xf5\x90\xad\x3a\x0e\xce\x4f\x10\xdc\x92\xf1\x1c\xf5\xc2\xe3\x86\xa2\xa4\x55\x41\xd5\x6b\xfe\xd2\x4c\xe3\xc5\x3c\x82\x3b\xba\xe9\x0e\x9b\x5b\x99\xe7\x20\x28\x07\x04\x85\x52\xae\xe9\x0e\xa4\x60\xaa\x52\xe4\xf2\x7f\x94\x16\xc8\x31\x68\x24\xec\x54\xad\xe1\xe3\x27\x0e\x0a\x65\x0b\x83\x25\x0a\x1e\x44\x89\xe3\x39\x54\x8a\x43\x79\x99\x4b\x0a\x8e\x19\x90\xf5\xa0\xc8\x91\x0d\x9d\xbf\x7a\x51\xe2\xb2\x2e\x16\x98\x33\xc8\x88\x84\x34\x08\x0e\x89\xcb\x26\x3a\xa5\x02\x97\x15\x8e\x6e\xc0\x49\x11\x47\xba\xde\x65\xda\x70\x0e\x4e\xe2\x1a\x4d\x08\x6f\x1d\xec\x45\xf7\xb8\x9d\xda\x9a\x4b\xf0\x49\xcd\xdd\x01\xca\x17\xa2\x5e\x68\x2f\xd3\x54\xfb\x5a\x19\x78\xab\xf1\x8f\x6e\xf1\x45\xf4\x23\xbb\x70\xbf\x07\xaf\x19\xa6\x87\xf4\xf5\x85\xd6\x6a\x9f\xa8\xd3\xd8\x4b\x7e\xa0\xcf\x20\xd7\xbd\x9f\xbd\xa8\x1e\x1a\xfa\x99\x74\x8a\xe9\x99\x3e\x37\xf5\xe2\x77\x3a\xa0\xe7\xf6\x66\xe1\x11\xa7\x7b\x0c\xb3\xcf\x5b\xce\xd3\x6e\xd7\xdc\xb1\x68\x24\x3a\xab\x6b\x6a\x54\x3c\xea\x2d\xfe\xd7\xb6\x21\x39\xf0\xf7\xfc\xdb\x1c\xee\x32\x87\x2e\xea\x04\x33\x95\xa7\xa8\x9b\xe4\x1f\x6a\x37\x4c\x92\xa1\x5b\x9e\xe9\x04\xbe\xf4\x9d\x07\x18\xc7\x5c\xbd\x28\x4e\x37\x5c\xd0\xf6\x7b\xd6\x6a\xb8\x32\x69\xeb\x97\xdb\xf4\xe9\xf8\x49\xec\x94\x8e\xdf\x53\xbe\x24\x32\x2f\x38\x9b\x75\x7c\x5b\x53\x96\x6d\xa4\xa1\xf2\x39\x95\xf3\x31\x1b\x6d\x40\xd4\x56\x85\x83\xec\x5b\xec\xa8\xcd\x54\x39\x97\x56\xae\x68\xe7\xd3\x58\xce\x83\xf6\x18\x17\xda\x53\x61\x60\x47\x0a\x8d\xc2\x45\xc2\xa9\xff\x35\xf4\x7b\x82\xe3\x90\x68\xb5\x25\xfa\x1f\x1e\xa4\x45\xe8\xdc\xf0\x8e\x1b\xa1\xf0\x28\x25\xfc\xf6\xe9\x3d\xde\x71\x7e\x39\xb5\xe1\x82\x77\x4d\xc5\x67\x83\xdc\x4d\x13\x8c\x0c\xdf\x4c\xa9\xa4\x56\x4f\x52\xc0\x33\xd4\x38\xa7\x26\x3b\x85\x6b\x18\x6b\x6a\x52\xe3\x4c\x10\xe9\x5a\xa6\x26\xd6\x32\xa5\x5a\xb6\x83\x05\x55\xae\xd8\xa2\x48\x32\xba\xd1\x6b\xaa\x9e\xd6\x9c\x47\x70\x3d\x2a\xb8\xb1\x4d\x50\x97\x7c\xc5\x2f\x54\x6d\xa3\x28\x6a\x44\x6d\x55\x9d\xa7\xbe\xe4\x51\x65\x23\x47\xc3\xd8\xd4\x15\x77\xc3\x94\x82\x15\x5d\xbc\xe7\x40\xc4\xc1\x69\x5b\x48\x42\x78\x94\x8d\xad\xdb\xbf\x96\x8f\xec\xbc\x6b\xd6\x4e\xda\xdd\x63\x89\xc9\x91\xa6\xf6\x51\x36\x64\x83\x10\x74\x75\xe9\x94\x94\x27\x61\x7b\x88\x6e\x86\x70\xd3\xaf\x1d\xd2\x3c\xba\x71\xfb\x27\xc1\x35\xc8\xf2\x3e\xf3\x73\x32\x9d\xf9\xee\x78\x1c\x62\x3e\x8f\xc2\x81\xa8\x5e\xd2\xff\x83\x3f\xdd\x22\x67\x07\x4f\x6d\xd4\x6e\xb4\x05\xb7\xb7\x72\x9c\xf3\x0f\x9c\xff\x48\x0d\xad\x1e\x69\xc0\x1c\x26\xa9\x78\x13\x6c\x08\x18\x3c\x1a\x32\x2f\x4d\x89\xee\x12\xf4\x2d\xcf\x04\x8c\xf2\xbf\xd5\x72\x29\x13\x1a\x90\x0c\xac\x4b\x1a\x09\x69\x6d\xa4\x91\x66\x48\xf2\x83\xa1\x20\xd5\x14\xaa\x08\xfe\x89\x23\xba\x69\x35\xfa\x12\xc0\x32\x29\x1f\x52\x9a\xc5\xf8\x82\xa5\x0c\xb7\x99\x34\xe0\xe7\xae\x28\x18\x76\x93\x4f\x29\xed\x8b\xda\x5a\x92\xd2\x98\xb3\xb0\x25\xd0\xbf\xb0\xd2\xb2\x10\x7a\xd7\x7a\x9e\xea\x6a\x21\xed\xf0\x1e\xf5\x67\x79\xf6\xf9\xa1\x27\xe3\x38\x1e\x37\x65\xfd\x16\xfa\x6c\xd8\x11\xde\xb1\xf2\xae\x56\x15\x62\x4d\xb5\x4c\x5a\x40\x61\xb8\x7c\xb9\xc9\x92\x47\x6d\xe7\xa9\xaa\x5e\xe4\x32\xe9\x1c\x36\x01\x3a\x18\xb6\x08\x25\x92\xab\x3d\x09\xea\x42\x1a\x37\x95\x13\x37\x67\x43\xdb\x85\xd0\x8a\xa3\x58\x60\x26\xf2\xa5\x73\xd2\xd9\x83\x9e\xa3\xd3\x6d\xea\x2b\x20\x01\x27\x0b\x2b\x55\x51\x1e\x74\x13\x21\x83\x27\xfb\xfc\xdb\x4d\x1f\xba\xad\xcf\xda\x71\xa8\xc3\x01\xd1\x72\xb6\x25\x3c\x1d\x6e\xa5\xcd\xe0\xa3\x52\x2b\x1e\xb1\xd9\x73\xe2\xa8\xe7\xff\x24\x13\xad\x8c\x5a\xda\xee\x88\xa1\x42\x45\x4b\x10\x9e\x56\xed\x84\x80\x07\x3a\xa6\xb8\x14\x75\xde\x8f\xa4\x5c\x95\x5c\x3d\x9d\x7a\x07\x09\x0f\x35\x1c\xce\x6d\x83\x81\x65\xb8\x55\x0d\x26\xdd\x70\x49\xa3\x37\x4d\xa4\x3d\x44\x8a\x53\xf3\xf5\x71\x8f\x7b\xd0\x8f\xef\x49\xf7\x56\x42\x91\xde\x92\x96\x25\x0d\x1a\x47\xda\x1d\xb4\xe9\x81\x7d\xea\x67\x3e\x28\xb9\x8a\xb7\xbd\xf8\xaf\x3f\xf3\xaf\xf6\xbd\x04\x60\xbc\xa4\xbb\x95\x9b\xa6\xf1\x39\xdc\x37\xc2\xe2\x18\xee\x90\xf2\xed\xf0\xaa\xe3\xcc\x70\x7d\x71\x6d\x80\x1f\x6e\xac\xa0\x6d\x42\x1e\xd5\x6c\x97\xc9\x04\xd9\x44\xd0\x45\x4b\x37\x2f\x73\x75\x72\xfa\x6f\x3b\xcd\xfb\x81\x7f\xe2\xf9\xdd\x44\x0d\xd5\x86\x9b\x67\x61\xec\x4f\x28\x57\x99\x85\x19\x5c\xbc\xed\xed\xd0\x65\xd2\xed\x9c\xd0\xd5\x53\x65\x2d\x45\xaa\x92\xba\xe0\x92\xd1\x7e\x5c\xe5\xe8\x7e\x93\x2f\x54\x9e\x7b\x49\x6f\x0f\xbc\x84\xb9\x71\xcb\x3b\x9b\xf5\xd5\xf8\xf3\xcf\xc6\xd1\x51\x25\xf8\xde\x74\xfb\x7e\xa5\x7f\x38\x50\x51\xb2\xb5\x2e\x3b\x91\x5d\x59\x19\x18\x95\x1d\x9d\x3c\x10\x1e\xb1\x95\xcd\xa8\x3a\xbe\xe7\x72\xf3\x06\x46\x05\x61\x54\x86\x2e\x7d\x51\xbf\xa1\x4e\x98\x9c\x3b\x9a\x34\x82\xde\x34\x7f\xf7\x13\x18\xbd\x1a\x9d\xb7\x72\xf7\xed\x47\x23\x5e\xa4\xe9\xd5\x86\x0e\xb8\xa1\x7e\x00\x4b\xd4\xe3\x51\xae\x44\x4a\x52\x3a\xb7\x9e\x7f\x93\xe5\x70\xf4\x09\x26\x83\xf6\xba\x24\x70\xd3\xb5\x33\xee\xb6\x27\xf0\xfd\xc5\x05\xd1\x74\x38\xf0\x49\xef\xba\x87\x43\x82\x51\x17\x51\x8e\x2c\xb8\x97\x40\x02\x8d\xe4\xd7\x41\x9f\x88\x95\x9b\xae\xfa\xaf\x83\x8c\xb2\x4e\x5a\x4b\xe7\x72\xc2\xd5\x80\x09\x6c\x33\x49\x0d\x8c\x46\x36\xd0\xf8\x0b\xc1\x61\x77\x9b\x71\xf2\xd8\x11\xdd\x0f\xaa\x44\xea\x6b\x96\x43\x84\x3a\x76\x20\x6a\x77\x81\x61\x3a\x71\xb0\x77\x8f\x62\x7e\x4e\x3f\x24\x9e\x15\x0b\xfa\x41\x5e\x11\x69\x8b\xdd\x7f\x8f\xba\x32\x44\x0e\x1a\x9d\x2a\x4f\xa3\xff\x44\x74\x21\x5c\x51\x7b\xd5\xe5\x9a\x4c\x8f\x11\xcc\xf4\x7d\xfc\xd2\xf0\xdd\x40\xf7\xdd\xee\x3a\x65\x86\x21\x6a\x3d\x3d\x61\xb2\xac\xf3\xfc\xa9\x88\x64\xa6\x13\x01\x4e\xe8\x5a\x59\x93\xfa\x07\xf5\x70\x33\x94\xe8\x13\x91\xfd\xd4\xe6\x40\xc4\xbe\x71\x4a\x44\x5c\xc9\x26\x47\x70\x75\xe6\xb3\x43\xfc\x8b\x28\xa1\x61\xe2\xf1\x3a\xfb\xfb\xc5\xc5\xa8\x67\x8a\x37\xc6\xc9\x1e\x1e\x09\x80\x9b\xa8\xd2\xc8\x9a\x7e\xf0\x05\x7b\x3c\xe0\xeb\xac\xda\x77\xf0\x6f\xbe\xf6\xe7\x2d\x2d\x0d\x7a\xae\x08\xba\x37\xc8\x98\xfb\xf5\xf9\xd9\x34\xf6\x4f\xc9\x7f\x01\x06\x29\x87\x02\xd1\x16\x00\x00")
+
+func templatesEmbedHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEmbedHtml,
+		"templates/embed.html",
+	)
+}
+
+func templatesEmbedHtml() (*asset, error) {
+	bytes, err := templatesEmbedHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/embed.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc9, 0x9d, 0xa4, 0xbf, 0xb9, 0xc8, 0x6e, 0xd5, 0x8e, 0x1b, 0xd3, 0xd8, 0x61, 0x84, 0x39, 0x83, 0x39, 0xaa, 0xed, 0xe, 0x19, 0xe8, 0xd4, 0x7c, 0x64, 0x46, 0x2a, 0x2d, 0xd4, 0x15, 0x6c, 0x18}}
+	return a, nil
+}
+
+var _templatesIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x5a\xff\x6f\xdb\xb6\x12\xff\x7d\x7f\x05\x9f\xd6\xd6\x36\x1a\x4b\x09\xd6\x57\x0c\x8d\x9d\xa1\x4b\xbb\x36\x40\xb2\x0e\x4d\xfa\x36\xe0\xf5\x61\xa0\x25\xda\xe2\x22\x89\x1a\x49\xd9\xf1\xcb\xf2\xbf\xbf\x3b\x92\xfa\x6a\xc9\x4d\xda\xbd\x61\x05\xda\x5a\xd4\xf1\x78\x3c\x7e\xee\xc3\xe3\x89\xb3\x7f\x44\x22\xd4\xdb\x9c\x91\x58\xa7\xc9\xc9\x57\x33\xfc\x8f\x24\x34\x5b\xcd\xbd\xdb\x5b\xe2\x9f\x8b\x90\x26\x8c\xdc\xdd\x79\x27\x5f\x11\x32\x8b\x19\x8d\xf0\x07\xfc\x4c\x99\xa6\x24\x8c\xa9\x54\x4c\xcf\xbd\x42\x2f\xa7\xdf\x7a\xcd\x57\xb1\xd6\xf9\x94\xfd\x5e\xf0\xf5\xdc\xfb\x65\xfa\xe1\xe5\xf4\x54\xa4\x39\xd5\x7c\x91\x30\x8f\x84\x22\xd3\x2c\x83\x7e\x67\xaf\xe7\x2c\x5a\xb1\x56\xcf\x8c\xa6\x6c\xee\xad\x39\xdb\xe4\x42\xea\x86\xf0\x86\x47\x3a\x9e\x47\x6c\xcd\x43\x36\x35\x0f\x07\x84\x67\x5c\x73\x9a\x4c\x15\xda\x39\x3f\x02\x45\x56\x93\xe6\x3a\x61\x27\x17\x45\xa2\x39\x79\x9d\x52\x9e\x30\x39\x0b\x6c\xa3\x15\x48\x78\x76\x4d\x24\x4b\xe6\x9e\xd2\xdb\x84\xa9\x98\x31\x18\x2b\x96\x6c\x69\x66\xfe\xc8\xff\x9e\x2a\x9c\x78\xa0\x34\x58\x1d\x06\x0b\x21\xb4\xd2\x92\xe6\x7e\xca\x33\x3f\x54\xca\xfb\x4c\x45\x46\xaa\xa1\xe0\xf6\x76\x4a\x34\x4b\xf3\x84\x6a\x46\x3c\xf4\xb0\x47\x7c\x90\x47\x7f\x07\xa5\xc3\x67\x0b\x11\x6d\xdd\x80\x11\x5f\x93\x30\xa1\x4a\xcd\x3d\x74\x0d\xe5\x19\x93\xd3\x65\x52\xf0\xc8\x69\x6c\xcb\x48\xb1\xa9\xda\xbb\xbd\x93\x69\x1a\x4d\x9f\x37\x5e\xf7\x99\xc3\x64\x65\x90\xd3\x11\x80\x92\xbd\x2a\x8f\x88\xfb\x21\x96\x4b\xc0\xc7\xf4\x9f\x9d\x21\x08\x5f\x12\xdf\x2c\x4b\x53\x2f\xa8\x5a\x0a\x99\x12\x1e\xcd\xbd\x44\xac\x44\xa1\xa7\xf8\xec\x11\x80\x45\x2c\xa0\xf1\xa7\x77\x97\x57\x1e\xa1\xa1\xe6\x22\xeb\x38\xd7\xca\xb7\x86\x01\x75\x3c\xcb\x0b\x4d\x10\xdf\x73\x2f\xe6\x51\xc4\x32\xcf\xc1\x2b\x54\x72\xf9\xab\x16\xd7\xd8\xb2\xa6\x49\xc1\x2c\xde\x4f\x2f\xdf\xff\x70\x85\xad\x08\x79\x12\x74\xd4\xe5\xe5\x34\x7b\x47\x03\x01\xda\xb4\x1d\x81\xe1\x95\x3d\x32\xba\x76\x0d\x3d\xc8\xf0\x4e\xcc\xe3\x15\xf1\xce\xc5\x8a\x80\xcf\x3c\x68\x9d\x05\xb4\x33\x7c\x90\x37\x1b\x66\x01\x3a\xa7\xd5\xa2\x42\xc9\x73\x4d\x32\x91\x85\xd5\x7c\x7e\xfa\x11\x9f\xca\x08\xae\xff\x40\xdc\x17\x29\x84\x95\xbf\x62\xfa\x75\xc2\xf0\xe7\xf7\xdb\xb3\x68\x3c\x6a\x58\x3f\x9a\xf8\xd0\x39\xe1\xe1\x35\x99\x93\x65\x91\x19\xc7\x8f\xd9\x7a\x42\x6e\x5b\xba\xd8\xda\xcf\x25\x5b\x83\x8a\x57\x6c\x49\x21\xe6\xc6\x93\xe3\x07\x0d\x86\x33\x81\xc1\x54\xb1\x48\xf9\x4e\xe7\xbb\xe3\xcf\x33\x3c\x15\x85\x62\x91\xd8\x64\x7f\x1b\xe3\x67\x81\x5d\xa1\x4e\x30\xb0\x2c\x1a\x0c\xaf\xd6\x43\x19\x38\x52\x0a\x59\xf7\xf8\x82\x60\x6f\x0a\x00\x7f\x4a\x4d\xcc\xbf\xd3\x08\x36\x00\x0c\x7b\x29\x80\x54\xed\x1b\x83\xd1\x6a\xe8\x2e\x05\xec\x33\xb9\x35\x3d\x37\x85\xcb\x22\x0c\x99\x52\xff\xd7\x49\x28\x3b\x46\xcf\x2c\xea\xd1\x3f\x7b\x1e\x7b\xec\x35\x1c\x76\x0f\xca\x5a\x1f\x05\x0a\x74\x76\x68\xe6\x4f\xe5\xac\x4f\xfa\x6e\x1f\x19\x23\xe1\xed\xc8\x02\x4b\xbd\x25\xde\x25\xd8\xcd\xb3\x15\xcc\x52\x29\xba\x62\x8a\x2c\xa5\x48\x61\x93\x3a\x79\xac\x66\xc1\xe2\xc4\x27\x57\x31\xab\x5f\x6e\x78\x92\x10\x9a\xe7\x8c\x4a\x92\xf0\x6b\x46\x72\x26\x95\xc8\x68\xc2\xff\xcb\x22\xc2\x70\x64\xa7\x61\x2b\x0a\x49\xde\x5c\xa0\x29\x34\x0c\x45\x01\x41\xe7\x0d\xd9\x16\xe4\xbd\x13\x79\x99\x70\xf0\xaf\xda\x11\x6f\x78\x02\x17\x68\xba\x92\xa2\xc8\x77\x19\x3c\xa1\x0b\x96\x10\x90\x00\x31\xb0\xa8\x66\x66\x9c\xb2\x31\xd2\x72\xb3\x91\xdb\xe9\xad\x58\xc2\x42\x6d\x36\x01\x2b\xda\x1c\x11\x37\x6b\x80\x62\xb9\x9a\x56\x7d\x47\x83\x99\x86\xc4\xe8\x1b\x9c\x89\x19\x48\xe4\x08\xa8\x26\x0a\x5e\x46\x91\xb4\x98\xf6\xac\x27\xd8\xef\x75\xe3\x23\xff\x07\x74\xef\xdd\x1d\xb1\x16\xb2\xa8\xc2\xb3\x0d\x08\x2d\x71\x39\x71\x62\x56\x73\xaf\x5d\x1d\x9e\xaa\x38\xcd\xa8\xec\xee\x55\xad\xb8\x1a\x56\x80\xe9\xc6\x22\x11\xb0\xc3\x78\x1c\xdd\xd3\x49\x35\xf6\xa2\x10\xf0\x76\xa9\x29\xc4\xbb\x81\x4d\xc2\xb4\x66\x92\x2c\xb6\x24\x62\x48\xb3\x0b\x9c\xd1\x26\x66\x92\xe1\x7b\x00\xde\x9a\x1d\x20\x38\x09\x10\x98\xe9\x00\xeb\x91\x31\x13\x98\x44\x0b\xa2\x63\xc6\x25\x89\x38\xe4\x78\x3c\xd4\x3e\x39\xd3\x24\xa5\xd7\xe0\x7e\x23\xeb\xb0\x4c\x52\x01\xea\x72\xb1\x61\x72\x59\x24\xbe\x77\x0f\x50\x4e\xfb\x66\xfd\x19\x68\x84\xed\xe5\x37\xb0\xb6\x01\x48\xd7\xb0\x07\x8e\x96\x4a\x10\x8d\x65\xef\x7e\x40\x4a\xcc\xd3\x25\x03\x39\x2d\x0b\x48\xcf\x2d\xf9\x68\x76\xa3\x4b\xb0\x56\xfd\x1b\x88\x73\x06\x18\xd6\x81\x74\x31\x64\xb1\x48\x20\x59\x74\x2c\xd7\xb6\x70\x37\x97\xda\x05\xc8\x67\x38\x05\xb3\x62\xe3\x11\x8c\xfc\x77\x39\xcb\x60\xcd\xcf\x21\x1f\x86\x11\xd1\xc2\x76\xcb\x01\x22\x30\x51\xee\xa5\x85\xd0\x05\xdd\x0a\x19\x9c\x02\xd3\x84\x3c\x49\x59\xba\x60\x32\xb8\x2c\x80\x9f\xd6\x5c\x01\x4e\x66\xfc\x64\x8c\x0e\x50\x84\x16\x5a\x4c\x5b\xbc\x05\x40\xd3\x42\x24\x04\xe0\x83\x34\x3e\x99\x05\xfc\xc4\x2b\x87\x31\x4b\x3e\xb4\x2c\xe8\x58\x2a\x99\x4d\x16\xcd\x14\xf6\xf1\x84\x15\xe8\x2e\x11\xec\x39\x20\x7f\x74\xd8\xcb\x20\x53\xe3\x8f\xef\xa1\x23\x99\xf6\xb0\x87\x15\x19\x7e\x6f\x40\x8b\x8e\x1a\x7a\x69\x56\xf7\xcc\x84\x14\x1c\xc0\x10\x67\x0a\xb7\xdd\x32\x78\x08\x06\xdd\x09\xa1\xe0\x84\x33\x32\x96\x90\x2f\x05\x31\x05\xd1\x6b\x1e\xa9\x40\xf2\x08\xc2\x68\x4b\x16\xb0\x11\x04\x9a\xd1\x30\x26\x4a\x17\xb0\xcb\x69\x35\x81\xa8\x1b\xa5\x18\x97\x21\x93\x19\xb8\x98\x2e\x20\xbf\xf2\x7d\xdf\xa9\xda\x88\x22\x89\xec\x0e\x82\x11\x0d\x41\x3b\x56\x45\x8e\x87\x44\x60\xad\x5c\x28\x36\x21\x20\xec\x0d\xcf\x09\xb4\x4c\x7b\x08\xac\x5c\x8f\x9d\x85\xaa\xf2\xfd\x98\x25\xf9\xd4\xd0\x54\x19\x7f\x80\x9d\x8f\xde\x2b\xdc\xd1\x9e\x24\xfa\xf8\x97\x27\x2b\x7d\x7c\xf0\xd1\xb3\x5b\xdd\x82\x19\xb8\xa4\x78\xdc\xa3\x49\xb2\x25\xd6\x3f\x30\x21\xa4\x1a\xd8\x19\x97\x5c\x2a\x0d\x13\x01\x58\x6e\xb8\x8e\x4d\x9b\xc5\xd6\x48\x99\x55\xf7\x67\x0b\x09\x11\x73\x69\x67\xa7\xf0\x60\x61\x8e\x0d\x1f\x3d\x3c\x56\xbf\x08\x82\x50\xa4\x29\x64\xb8\x54\x5e\xfb\x42\xae\x02\xb4\x2f\xf8\xe8\x9d\x5c\x40\x03\x66\xbc\x78\x76\x20\x6a\x0b\x87\xc3\x1b\xdf\x12\x44\x7e\x1f\x7e\x46\xd4\x9c\xe1\x52\x70\xbd\xdd\x47\x59\x88\x77\x38\x73\x72\x27\xba\x83\xc1\x7a\x0f\x1b\xd2\x76\xef\x68\x6f\xc7\x3b\x06\xf5\x8f\xe0\x1f\x73\x9c\x71\x34\xe3\x9f\x9b\xf7\x83\xe1\xd6\xe2\xc1\xa6\x82\x87\x70\x21\xf6\xbb\xc2\x32\x09\xf6\xb3\x71\xd9\x52\xd5\xa0\xc5\x7f\xe1\x4f\xd3\x88\x20\x80\x85\xca\x61\x67\x2a\x37\xe9\x46\x4b\x1f\x2b\xf6\x2e\xcc\x9e\xbd\x77\x0f\x40\x89\x07\x9b\x3f\x40\xce\x6e\x6d\xa6\xbf\x58\x36\x77\xca\x03\xa2\x84\x7d\x86\x03\x27\x0f\x39\x85\x24\xec\x3a\x13\x1b\x6c\x1b\xc1\x2e\x47\x31\x10\x15\x2c\x5e\x81\x67\x1f\xf2\x33\x1b\x01\xb4\x25\xb3\x24\x89\x3a\x81\x20\x22\xa6\x4d\xf2\x66\x70\xcd\x15\xb1\xb5\x99\x87\x40\xee\x13\x9b\x63\x3b\xb5\x1e\x4c\x6a\x9f\xf5\xe1\x66\x51\x68\x0d\x86\x39\xc9\x85\xce\x08\xfc\x9d\xe6\x92\x43\xd8\x6c\xcb\x85\xb5\xa7\xb6\x76\x9a\x67\xcd\xb7\xdd\xef\xb5\x3e\x3d\x16\x7d\xdb\x67\x51\x33\x84\x62\x60\x1c\x5b\x11\x38\xe9\x21\x68\x08\x78\x53\xac\xaa\x37\xd3\x53\x73\x0c\x87\xd5\x0c\x45\xbe\xf5\x9a\xf8\x85\x03\x7a\xbe\x10\x54\x46\x65\x6d\xe1\xeb\x7a\x3a\x6f\x18\x9c\x86\x88\x19\x8c\x2e\x12\x46\x4c\xb1\x0a\x00\x6f\x97\xcb\xa4\xde\xa8\x8b\x3c\xf9\xfa\xe6\x68\xf9\x2c\x5c\x1c\xef\x54\x1d\x5a\x21\x54\xcd\x31\xdf\x4e\x81\x32\x57\x58\xe9\x6a\x26\x0b\x2e\x0c\x7a\x70\x3d\xe4\xb9\x9e\x34\xa0\x1f\x27\x76\xe3\x6e\x4b\xc6\xdf\xd4\x60\x2f\x00\x91\x19\x12\xae\x76\xa4\xfa\x46\x88\x15\xd6\x1a\x71\x2d\x41\xf0\x1e\x29\x25\xf1\xae\xd0\x2b\x66\x57\xb7\xb9\x1f\xd7\x84\x51\xb5\xb5\x6e\x07\x36\x0d\x5d\xae\x99\x17\x0b\x58\x8e\x3a\x70\x0e\x08\xa0\x85\x6c\x18\xc9\x18\x84\x9c\x15\x61\x32\xe5\x4a\xb9\xec\x12\x19\xb3\x3c\xe9\x40\x8b\x91\x58\xb0\x98\x26\xcb\x7b\x24\x91\xb3\xfe\x93\x8e\xe1\x3d\x75\xd1\x73\x36\xaa\xb6\xa8\x9f\x21\x8c\x13\x08\x73\xaa\x0c\x74\x52\x9a\x61\x12\x6b\x33\x5a\xe8\x67\x28\x00\xac\x0e\x69\x46\x68\x04\xfb\x2d\xb1\x5c\xeb\x38\xc3\xf2\x84\xc9\x80\xed\x04\x70\x1b\xc6\x07\x94\x5f\x72\x78\x00\xa9\x14\x33\x80\x37\xa8\x0d\x39\x82\x44\xb0\x87\x69\xa0\x09\xea\xdc\xc0\xb3\x85\xb8\xc1\x94\x5b\x31\x56\xa5\xdd\xe8\x48\xe5\xc4\x49\x26\x34\x64\x00\x98\x57\xe3\x98\xae\xd8\xab\x90\xac\x9c\xbf\xaa\xe1\xb5\xe3\x2b\x77\x84\xa9\xdd\xbf\xeb\xc3\x01\xc4\x34\x1d\x23\x32\xd8\x99\xcd\x7a\xe1\xb8\x1f\xbd\x7a\x89\x60\x1f\xaf\x57\xef\x18\x1d\x04\x79\x20\xcc\x19\x2c\xc5\x24\xef\xef\x31\xbb\x1e\xde\xfc\x14\x6e\xca\x2c\xa2\xdc\x8a\xe2\x0f\xef\xcf\x9b\x44\x52\x52\x64\x55\x38\xe9\xa7\xc8\xfd\xa1\x46\x7b\xb1\x7a\xc1\x43\x29\x94\x58\xea\x7a\xd8\x61\xd3\x7a\x84\x77\x6c\x8c\x6c\xad\xae\x41\xdc\x7c\x95\x21\x14\x8d\x41\x95\x86\x21\x9b\xee\xe3\xbe\x3e\xb1\x1d\x72\xfa\xd2\x0a\x4b\x83\xc2\x7e\x8e\x81\x27\x62\x93\xe3\x02\xe4\x1c\x26\xbe\xeb\x27\xb0\x7b\x30\xe2\x1b\xcc\xa7\x0c\xbe\x46\x2e\x27\xc5\x0d\xa0\xc3\x45\x5a\xbc\x18\x1e\xa0\xc7\x47\xb1\xdc\x11\x33\x0e\xf7\xf1\x08\xa5\x4d\xb1\x75\xcf\x6e\xde\xac\xce\xf1\x6c\x89\xa4\xea\x7a\x95\x45\x3a\xfc\x46\x52\xa8\xbe\x84\x12\x5d\xf8\x21\x87\xe4\xc9\x16\x2a\x76\x05\xcc\xa4\x1f\x43\x68\xc1\xa1\x4f\x99\x3d\xae\xe4\x2f\x20\xdc\xc7\x80\x66\x9b\xad\x8d\x1f\xf9\x90\xb6\x47\x26\xf9\x36\x07\x44\xf5\x52\x4f\x76\x15\x8e\x67\x2a\xa7\x59\xbd\xb2\xce\xce\xa9\xe6\x29\x16\x46\x23\xaa\x29\x60\xd0\xea\xb1\xa8\x75\xba\xfc\x0f\x19\xbf\x31\xf9\xe9\x2c\x40\x15\x27\x93\x9e\x64\xae\x67\xe9\xea\x39\x9c\x3b\xb3\x61\xa9\x60\x3a\x61\x02\x07\x9b\x3d\x53\x38\xc5\xf7\x7f\xd6\x1c\x4a\x65\xf7\x9a\x44\x5f\x08\xdd\xbb\xea\xb3\x27\x24\x9a\xe9\x1f\x8d\xb8\x18\xa8\x00\x0c\xe6\xf9\x96\xb5\x6c\x57\x97\xab\x9b\xb3\xc5\xaf\x3c\xda\x4d\xef\xf1\x50\xa0\x99\xaa\x73\x17\xf3\x50\x55\xd0\x1e\xf9\x26\x8c\xce\x5e\x11\x27\x05\xb9\x52\x18\xb3\xf0\xba\x59\x41\xeb\xab\x95\xd5\x35\x43\xc8\xe4\xa0\x67\x55\x41\x72\x34\xaf\x58\xb2\xf4\xfa\xaa\x69\x3d\x73\x7b\x88\x5b\xdd\xb9\xeb\x14\xb8\x79\x25\x24\xdf\x2d\x1f\x3a\xd7\xbb\x93\x4b\x27\xba\x9f\x95\x8e\x37\x0e\x9b\x86\x56\x8b\x2d\xb1\x94\x5d\x80\x2b\x9e\x3d\xcc\x18\xc7\x44\x7f\xda\x3a\xdb\x29\x3c\xea\x87\xcf\x97\x00\x01\x67\x09\x4b\xdd\x39\xd0\xd9\x96\x5d\x0a\xc2\x7a\x07\x72\x6a\x54\x46\xb4\x2b\xba\x82\x7c\x0d\x9b\xfb\xe2\xa5\xf7\x7c\x67\xde\x94\x7e\x77\xd3\xc6\x1c\x6c\x0c\xc3\x8c\x13\x96\x11\xff\x3d\x0b\x79\xce\x4d\xf1\x84\x1c\x4d\xc8\x18\x92\x32\x76\xd3\x6c\x26\x87\x13\xff\xf4\xb4\x2a\x79\x11\x6f\x7c\x04\x53\x77\x6f\x0f\xc8\xe3\x88\x84\xe1\x28\x9a\x78\x56\xdf\x50\xff\x89\x55\xd0\xaa\x9e\x65\xa0\x0b\xba\x57\xca\x40\x47\xbb\x41\x95\x5a\x5b\x56\x5a\x45\xbd\x95\x98\xba\x74\xd5\xf0\x72\x69\xf7\x81\x23\x76\xa4\xc1\x21\x0e\xff\xb4\xe6\x47\xfe\x99\x7a\x2b\x52\x96\x63\x28\xf6\x21\x87\xf6\x7d\x9f\xad\x51\x50\xed\xae\xf6\x5b\x6e\x4f\x72\xd1\x2a\xa0\x3d\x7c\x84\xa0\x76\x1f\xe4\x5f\xe6\x88\x35\xf7\x7e\x5d\x24\x34\x6b\x9c\xec\x21\x4b\xd8\x60\xc2\x2e\xd5\x7e\x13\x06\xea\x5d\xbd\x61\xe5\xf0\xfd\x8a\xd9\x4f\x94\x78\x6e\xd9\x5b\xb3\xa9\x2b\x0e\xc4\x92\x45\x54\xf7\xb4\x7c\xd1\x56\xf5\x80\xc2\xc6\x43\xf8\x6e\x78\x73\x79\x29\xc3\x98\xaf\x59\xf4\x30\x96\xb3\x19\xae\xeb\xea\x0d\xd1\x5d\xf9\x85\x66\x68\x88\x66\xce\xe3\x64\x86\xaa\xd9\xf9\xc9\x70\x2a\x33\x36\x9b\x7f\x04\x98\x9f\x3c\x74\xef\xff\x4b\x60\xb6\x93\x32\x57\xce\x37\x96\x45\x17\x6e\xcf\xfb\x42\x20\x75\x95\xfd\x55\x50\xda\xf9\x38\xdc\xbc\x70\xd1\xfe\x54\xbc\x14\x02\x72\xb6\xfa\x63\x71\xf3\x0e\x8d\x7d\xd7\xfa\xb0\xd5\x16\xc0\xeb\x56\x05\x7e\x29\x6d\xc9\xe0\x70\x4d\xa5\xf7\xbc\xdd\xb1\xa6\x92\x98\x6b\x4f\xee\xd6\x13\x99\x93\xdb\xea\x12\x42\xf3\x85\xff\x93\x29\x62\xbc\x15\x90\x9c\xcc\x89\xd1\xd7\x68\x01\x8d\xbd\x9d\x2e\xb1\x94\x84\x67\x32\xd7\xa5\x7a\x1e\xea\xc0\xd6\x6f\x61\xcb\xb2\x86\x54\xb7\x30\xaa\x92\x15\xde\xa7\x68\x5e\xc8\x90\x4c\x17\x32\xab\x05\xdb\x97\x35\x70\x6e\x79\x76\x2a\xf2\x2d\x28\x6b\xe9\xf0\x73\x8a\xdf\x19\x7e\x14\x11\xf3\x7f\x2f\x98\xdc\x5e\x9a\x63\xb4\x90\xe3\x91\xdf\xa8\x56\x8d\x5a\xb7\x33\x00\xa8\xe3\x52\xdd\x7c\x4e\xb2\x22\x49\xba\x97\x43\xac\x3d\xcd\x4e\x77\x1d\x7b\xdc\x97\x31\x30\x68\xf0\x9e\x88\x13\x19\x4d\x7c\x93\x55\x1c\x77\x34\xe0\x07\x9e\x7d\xdd\xf1\x7d\x5f\x5f\x6b\xba\x6d\x87\xfe\xe3\xfe\x75\xfa\xe3\x8f\x5a\x33\x04\x19\x45\xb7\x82\xb7\x74\x8c\x09\xd1\xe4\xe9\xe8\x3b\x67\xdd\x7c\xf4\x94\x65\x21\xf8\xef\xc3\xfb\x33\xbc\xaa\x27\x32\xe8\x31\x76\x2f\x41\xee\x09\x9a\x31\x1f\x91\xa7\xa4\x47\x0c\xdf\x4d\x7a\x6c\xb3\x9f\x86\xdb\x77\x62\xb4\xdc\xee\x38\x59\x81\xe9\x4d\x17\xb0\x1b\x16\x82\xf6\x14\x90\x33\x1e\xe1\xfa\x8d\x3a\xd7\x6a\x70\xe9\xca\x6e\xb0\x74\x4b\x9a\xe0\xd7\x9f\xdb\x0e\x27\xe8\x58\x02\x83\x65\x6c\x43\xcc\xbd\x95\xb1\x77\x6a\x4e\xd7\x58\x93\x41\xa5\x36\xc9\x7b\x41\x3c\x98\x54\xd3\x99\xdd\x2b\x3c\xcd\xd5\x27\xe0\xc1\x30\x86\x0c\xac\x3b\x1a\x16\xcb\xe1\x08\xeb\x33\x33\x52\x57\x87\x39\xf3\x8e\xab\x5a\x2e\x9a\x81\x75\x32\x63\x05\x96\x4e\xb1\x12\x27\xe5\xd6\x27\x6f\xf1\x7b\x35\xd7\x84\x2b\xb4\x0b\xb2\xbe\x3d\xa6\x35\x0d\xeb\x0e\x00\xdc\xee\x6a\x54\xa6\xf8\x4b\x10\x0a\xae\xac\xd7\xa8\x17\xdf\xdd\xf5\xad\xda\x22\x29\x64\x73\xcd\x2a\xfa\x80\x1f\xee\xd7\xb8\x37\x42\x11\xcd\xe6\x70\xa9\x9a\x8b\xd9\x8a\xc7\x97\x49\x62\x42\xb2\x75\x16\x6d\xae\x2e\xae\xac\xd5\xe1\x43\xee\xb8\xd2\xb1\x59\xe0\xc3\xb6\xbb\xbb\x61\x79\xd7\x31\xa1\x7d\x61\x6d\x97\x44\xf0\x33\xc7\x9c\xbc\x02\xf6\xf5\xe1\x27\x08\x04\xe4\xe8\xf0\xf0\xb0\xe9\x0b\x2c\x20\x8c\x51\x96\x83\xe4\xe1\x31\xfc\x37\x23\x2d\xbb\xa0\xe9\xe9\xd3\x2e\x0a\xb0\x43\xc2\x96\x08\xe6\x0b\x88\x31\x3f\xa5\x37\xe3\xc3\x03\x92\xe3\x35\xd9\x33\x08\x14\xab\xe1\xdf\xfc\x3f\x18\xe5\x2f\xb5\x96\x7c\x51\x68\x36\x1e\xb5\xce\xe2\xa3\xc9\x01\x98\x33\x21\x53\xb4\xb3\x03\x24\x1c\x20\xa2\x5b\x55\x0e\xb0\x4c\x04\xc0\xcd\x0c\x19\x90\x6f\x9f\x3f\x3b\x3c\xec\xe9\x10\xe3\x81\xb3\xdd\xc3\x76\x79\xec\xba\x40\xdf\x6f\x9e\xf7\x76\x85\x2c\x1c\x0c\x1c\xe8\x6c\xfa\x40\xdf\xe7\x7d\x3d\x15\x83\x90\x88\xfa\x0c\x7d\xbc\xdb\xa1\xf6\x0b\x46\xc3\xa9\x2d\x93\x22\xa9\x99\xb9\x9e\x90\x43\xf2\x9d\x9d\xf6\x53\x32\x8a\xc8\x88\xbc\x20\xa3\xd1\x04\x1e\xec\xcc\xa0\x31\x26\x48\x4d\xa5\xb5\xd0\x90\x9a\x86\xd2\x08\x68\x50\xa3\xfe\xd8\x69\xdc\xd0\x43\xe0\x34\x81\xaf\x98\x86\x45\x63\x12\x42\x6f\x8c\xef\x0e\x0c\x4a\x2a\x81\xbb\x09\x0a\x7f\x32\x22\xaa\x78\xfb\x64\x54\x94\x82\xcd\x78\xe8\xc3\x61\xad\x71\x18\x8b\xad\x91\x9b\xdb\x25\x7a\xb9\xe9\x89\x20\x20\x57\xef\x5e\xbd\xb3\xc7\x54\xc2\x6e\xb8\xd2\x78\xf2\x32\x37\x22\x49\x02\x4f\x2c\xc3\x80\x9e\xba\xd2\xfe\x86\x42\x33\x30\x89\x64\x2b\x7c\x27\x51\x10\x18\x1d\x17\xb0\xa1\xb4\x1a\xcd\x87\xfc\xf1\x35\xaa\x3a\x77\x9a\x80\xcf\xf1\x4b\xd6\xe8\x60\x20\x4d\xa8\x73\x83\xc9\x64\x37\xc0\xad\xcb\xfb\x6e\x53\xce\x82\xf2\x8a\x34\x64\xec\xe6\x2e\xfb\xff\x00\xfb\xd9\x01\x3e\xdc\x2e\x00\x00")
 
 func templatesIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +118,91 @@ func templatesIndexHtml() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/index.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7d, 0xba, 0x97, 0xec, 0x5e, 0x84, 0x7e, 0xff, 0x2c, 0x1d, 0x43, 0x4e, 0x30, 0x5f, 0xfa, 0x18, 0x64, 0xf9, 0x95, 0x5d, 0xc9, 0xf5, 0xbf, 0x30, 0xc5, 0x8a, 0x18, 0x68, 0x96, 0xd1, 0x63, 0x8a}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0x52, 0xaf, 0xdf, 0x1c, 0x43, 0x52, 0x5, 0xb8, 0x6e, 0xdd, 0xc3, 0x8, 0x9d, 0x4a, 0x57, 0xb9, 0x92, 0x11, 0x3a, 0x6, 0x51, 0x75, 0x2, 0xa1, 0x93, 0xce, 0x88, 0x9d, 0x7f, 0xfe, 0x1d}}
+	return a, nil
+}
+
+var _templatesLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x75\x93\x4d\x6f\x9c\x40\x0c\x86\xef\xfb\x2b\x2c\x94\x5c\xa2\xc2\x28\xd7\x88\xa5\xd2\xe6\x52\xa9\x51\x55\x55\xab\x5c\x9a\x1e\x06\x30\x30\x0a\x30\x74\x66\x20\x42\x88\xff\x5e\xcf\x40\xf8\x48\xe8\x9e\x58\xbf\xb6\x79\xfd\xd8\x0c\x83\x0f\xec\x0e\x7e\x0a\x4c\x50\x83\x2e\xb8\xc2\x14\xe2\x1e\xb0\x43\xd5\x43\xc3\x73\x0c\xe0\x5a\x60\x45\x62\xc2\x6b\x20\x15\x33\x51\x23\xf0\xba\x07\x99\x81\x29\x50\x53\xc6\x1d\x03\x7f\x1c\x4f\xa7\x61\x80\x59\xf7\x0a\xe4\xa9\x07\xe3\x48\x21\xac\x53\x38\x50\x51\x59\xfd\x04\xcb\x8f\x74\x91\x41\x70\x15\xa6\xc4\xbd\x12\x16\xf7\x11\xa9\x8b\x14\x32\x0a\xec\x2b\xb1\xd4\x07\x45\x55\x5b\x1a\xe1\x63\xc5\x45\x89\xea\xa8\x6a\xb2\x36\x10\x85\x03\x97\x99\x94\x66\xe7\x32\x6c\xd6\x7a\xca\xbb\x09\xae\xdf\xc0\x7b\x54\xc8\xcd\x44\x2d\xe4\x50\x28\xcc\xce\x2f\x5e\x61\x4c\xa3\x1f\x18\x8b\x5b\xf5\x8a\x81\x46\xd5\x09\x02\xfc\xe2\x45\xdf\xb1\x13\x35\x5c\x6c\x38\x64\x3c\x72\x74\x61\x67\x13\x84\x86\x30\x8e\x6a\x69\x42\x16\x47\x90\x29\x44\xd0\x32\x33\x6f\xb4\x9b\x00\x2e\x3d\x74\x02\xdf\x44\x9d\x13\x7c\xca\xb4\x2b\xfa\x02\xbd\x6c\x81\xe7\x36\xd3\x48\xbb\x94\x8d\x93\xdb\xdf\xf7\x7f\x34\xa3\x39\x2a\xed\xcb\xcc\x9f\xad\x90\x13\x17\xb2\x4b\x6c\xf5\x6c\xe5\x17\x6d\x05\x64\xab\x3e\x55\x37\x4a\x74\x3c\xe9\xa9\x68\x7e\x82\x46\x96\x22\xe9\x5d\x99\x47\x1c\x2e\x7c\x4b\x3f\x64\x0b\xa7\x95\xd8\xc4\x8b\x70\xc9\xaa\xa1\x31\x53\x7a\xad\x1d\xe2\x56\x53\x83\xe0\x19\x95\x16\xb2\xa6\x16\xcb\xbb\x17\x84\xb9\x30\x45\x1b\x07\x89\xac\xd8\xab\x85\xe7\x90\xb2\x1d\x32\x2f\x7a\xef\xfe\x4c\x6c\x1c\x00\x4d\x73\x24\x08\x89\x4c\xed\xb1\xa6\x74\xb9\x8d\x54\x06\x50\x29\xa9\xb4\xe7\x6e\x88\x47\x5b\xbf\xff\xb9\x81\x92\xd7\x79\x4b\x8c\xf5\xee\x0c\x20\x29\xb9\xd6\xe7\x8d\xfa\x71\xcc\xa7\x59\xb1\x65\x0f\xab\xa6\x28\x8c\x10\xbc\xab\x7a\x6d\x3a\x5d\x3f\xfe\x85\xe0\xd1\x7a\xbe\x09\x9e\x64\xc2\xa7\x6b\x8f\xdd\xf1\xff\xe0\xd5\x74\xfb\xee\xef\x7c\xef\x0b\xad\xaf\xd6\xca\xd9\xe6\xb9\xf2\x71\xf4\x9c\xe0\xa2\xde\x36\xbc\xef\xc5\xa3\xf5\x2b\xf8\xf8\x55\x1c\xc3\xf9\x07\xff\x5c\x41\x6c\x32\x04\x00\x00")
+
+func templatesLayoutHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLayoutHtml,
+		"templates/layout.html",
+	)
+}
+
+func templatesLayoutHtml() (*asset, error) {
+	bytes, err := templatesLayoutHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/layout.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0x9, 0xfc, 0x5, 0x20, 0x2a, 0x11, 0xfb, 0xe2, 0x1c, 0xe5, 0x58, 0x63, 0x4, 0x81, 0x97, 0xa8, 0x6a, 0x5a, 0x59, 0x2b, 0x31, 0x1d, 0x7d, 0xaa, 0xe1, 0xc2, 0x2e, 0xd0, 0x87, 0xf2, 0x7}}
 	return a, nil
 }
 
-var _staticBootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfd\xaf\xe3\x38\x92\x20\xf8\xfb\xfc\x15\x9a\x2c\x14\x2a\xb3\xd2\x72\x4a\xf2\xd7\xb3\x8d\x7a\xd7\x73\xbd\x8b\x9d\x06\xa6\xe7\x87\xdb\x3e\x60\x81\xea\xbc\x03\x2d\xd1\xb6\x2a\x65\x49\x2d\xc9\xef\xa3\xbc\xde\xbf\xfd\x20\x7e\x89\x0c\x06\x25\xd9\xef\x55\x75\x1f\xb6\x27\x31\x5d\x7e\x64\x30\x18\x8c\x08\x46\x90\x21\x32\xf8\xe5\xc7\x7f\xfd\x17\xef\x47\xef\xff\x2c\x8a\xa6\x6e\x2a\x52\x7a\x4f\xb3\xe9\x6c\xba\xf2\x3e\x1e\x9b\xa6\xdc\x7c\xf9\x72\xa0\xcd\x4e\xd6\x4d\xe3\xe2\xf4\xa9\x85\xfe\x63\x51\xbe\x56\xe9\xe1\xd8\x78\x51\x10\x86\x7e\x14\x84\x4b\xef\x2f\xcf\x69\xd3\xd0\x6a\xe2\xfd\x29\x8f\xa7\x2d\xd0\x7f\xa4\x31\xcd\x6b\x9a\x78\xe7\x3c\xa1\x95\xf7\xe7\x3f\xfd\x85\x23\xad\x5b\xac\x69\x73\x3c\xef\x5a\x7c\x5f\x9a\xe7\x5d\xfd\x45\x75\xf1\x65\x97\x15\xbb\x2f\x27\x52\x37\xb4\xfa\xf2\x1f\x7f\xfa\xe3\x7f\xfd\xcf\xff\xfe\x5f\xdb\x2e\xbf\x7c\xf9\xf1\x5f\xbd\xbc\xa8\x4e\x24\x4b\x7f\xa5\xd3\xb8\xae\x5b\x42\x83\xe9\xcc\xfb\x9f\x0c\xb3\xe8\xcc\xfb\x9f\x9e\x86\x3a\xa7\x71\x91\x91\xfa\x8b\xd9\xee\xc7\x2f\xc7\xe6\x94\x5d\xf6\x45\xde\xf8\x7b\x72\x4a\xb3\xd7\x4d\x4d\xf2\xda\xaf\x69\x95\xee\xb7\xfe\x33\xdd\x7d\x4b\x1b\xbf\xa1\x2f\x8d\x5f\xa7\xbf\x52\x9f\x24\xbf\x9c\xeb\x66\x13\x06\xc1\xf7\x5b\xff\x54\xe3\x35\xd7\x5d\x91\xbc\x5e\x4e\xa4\x3a\xa4\xf9\x26\xb8\x92\xaa\x49\xe3\x8c\x4e\x48\x9d\x26\x74\x92\xd0\x86\xa4\x59\x3d\xd9\xa7\x87\x98\x94\x4d\x5a\xe4\xed\xcf\x73\x45\x27\xfb\xa2\x68\x79\x76\xa4\x24\x69\xff\x73\xa8\x8a\x73\x39\x39\x91\x34\x9f\x9c\x68\x7e\x9e\xe4\xe4\x69\x52\xd3\x98\xb5\xa8\xcf\xa7\x13\xa9\x5e\x2f\x49\x5a\x97\x19\x79\xdd\xec\xb2\x22\xfe\x76\x25\xe7\x24\x2d\x26\x31\xc9\x9f\x48\x3d\x29\xab\xe2\x50\xd1\xba\x9e\x3c\xa5\x09\x2d\x14\x64\x9a\x67\x69\x4e\x7d\xd6\x60\xfb\x44\x5b\xd2\x48\xe6\x93\x2c\x3d\xe4\x9b\x1d\xa9\x69\x5b\xcb\x11\x6d\xf2\xa2\xf9\xf8\x73\x5c\xe4\x4d\x55\x64\xf5\xd7\x4f\x0a\x45\x5e\xe4\x74\x7b\xa4\xad\xc8\x37\xc1\xf5\xe7\x63\x9a\x24\x34\xff\x3a\x69\xe8\xa9\xcc\x48\x43\x0d\xb8\x2b\xb9\xec\x48\xfc\xad\x1d\x4b\x9e\xf8\x71\x91\x15\xd5\xa6\xa9\x48\x5e\x97\xa4\xa2\x79\x73\x25\x1b\x12\x37\xe9\x13\x9d\x90\xcd\xb1\x78\xa2\xd5\xa5\x38\x37\x2d\x09\x2d\xdb\x76\xbb\xea\xe7\x26\x6d\x32\xfa\xf5\xb2\x2b\xaa\x84\x56\xfe\xae\x68\x9a\xe2\xb4\x09\xcb\x17\x2f\x29\x9a\x86\x26\xd7\xdd\xa4\x6e\xaa\x22\x3f\x70\x09\x3e\x73\xa2\x56\x41\x70\x4d\xf6\x39\x2f\xab\x9b\xd7\x8c\x6e\xd2\x86\x64\x69\x7c\x3d\x86\x52\x2c\xd3\xe5\x8a\x9e\xbc\x60\xcb\x61\xd2\x5f\xe9\x26\xa2\xa7\xeb\x89\x54\xdf\x2e\x9c\xca\xef\x82\x20\xd8\x76\xb4\x6f\xbe\xdb\xef\x83\x6b\x7d\x22\x99\xd0\x16\xd6\xe6\x21\xf8\xfe\x5a\x9f\x77\x93\xfa\x5c\x5e\xca\xa2\x4e\x5b\xe1\x6c\x2a\x9a\x91\x76\x4c\x1a\xee\xd5\xe2\xfb\x2d\xe3\xbb\x64\x9b\x93\xf5\x2d\xa6\xa6\x28\x37\xfe\x74\x41\x4f\x2d\xee\x8b\x18\xb4\x3f\x8d\xda\x92\xf4\x74\x10\xdc\xd8\x04\xd7\xfa\xe9\xc0\xa4\xb4\xa9\x8a\xa2\xf9\x74\x69\x19\xb8\xcf\x8a\xe7\x0d\x17\xc9\x95\xeb\x95\x1c\x71\x48\x4f\xde\x3c\x28\x5f\xae\xc7\xea\xa2\xc8\x90\x1a\xbe\x2b\x5e\x5a\x4a\xd3\xfc\xb0\x69\x25\x4e\x73\x56\xb4\xf5\x4f\xc5\xaf\xae\x3a\xbc\xf8\x5a\x56\xb4\x23\x84\x9c\x9b\xe2\x1a\x17\x09\x9d\x7c\xdb\x25\x93\xb2\xa2\x93\x9a\x9c\x4a\x63\xba\x9d\x8a\xbc\xa8\x4b\x12\xd3\x89\xfa\xa5\x31\x2e\xa4\xa7\xeb\xee\xdc\x34\x45\x3e\x49\xf3\xf2\xdc\x4c\x8a\xb2\xe1\x13\xa3\xa6\x19\x8d\x9b\x49\x3b\x01\x49\x45\x89\x9a\x6e\xac\xf1\x26\xcd\x8f\xb4\x4a\x9b\x2d\x97\xa5\xf8\x4b\x60\xea\xc8\x7b\x4a\xeb\x74\x97\x51\xd9\x03\x47\x79\x61\x73\x9a\x29\xe9\xbe\xa8\x4e\x5c\x8d\x05\x44\x6b\x2c\x3c\x46\xc8\xcf\xcd\x6b\x49\x7f\xe2\xc5\x5f\x27\x5a\x51\x45\x6b\xda\x18\x25\xf5\x79\x77\x4a\x9b\xaf\x17\xc9\x6b\x52\x96\x94\x54\x24\x8f\xe9\x86\xb7\xdf\xc6\xe7\xaa\x2e\xaa\x4d\x59\xa4\x79\x43\x2b\xd1\xd9\xcf\x49\x5a\x93\x5d\x46\x93\xaf\x7a\xb7\xaa\xf0\x22\x1a\x25\x74\x4f\xce\x99\x1c\xdb\x66\xc3\x44\xb6\x2f\xe2\x73\xed\xa7\x79\x4e\x2b\x4e\x89\x5d\x7e\x29\x49\x92\xb4\xc2\x0b\xb6\x4a\x9f\x18\xe8\x45\x57\x54\x6e\x2d\xaf\xda\x68\xe2\x23\x8d\xbf\xed\x8a\x17\x73\xd0\x24\x49\x8b\x6e\x84\x9a\x6a\xa8\x99\x6b\x2b\x93\x56\x85\x97\x2a\x0a\xf5\xfe\xf3\xf3\x69\x47\xab\xaf\x9b\x8d\xec\x8c\x8d\xc6\xaf\xcb\x34\xf7\x75\x4d\x71\x40\x17\xe7\xc6\x84\x96\x73\x81\xa9\xaa\x2e\x35\x4a\xaa\xf8\x88\x8e\xe9\x6d\x33\x64\x8b\xe8\x41\xab\x72\xfb\x94\x66\x09\x42\x41\x47\x3b\x2f\xf0\xe3\xb6\x49\x86\x0c\xd6\xd5\x20\xa1\x71\x51\x91\xd6\x36\x61\x3a\xc8\xf4\x9b\x75\x5e\xd3\x46\x69\xc5\x74\xb6\xa0\x27\x6f\xba\x8c\xd8\x7f\x56\x0b\x7a\xda\xca\x19\xe6\x45\xe5\x8b\xd4\x99\xd6\x14\xd7\x45\x96\x26\x5e\x9d\x66\x4f\xb4\xba\x66\xf4\x40\xf3\x04\x53\x2e\x35\x53\x4d\xeb\x20\x27\xb4\x65\xc1\x9b\x56\xcf\xa5\xe5\x6f\xed\x82\x8e\xaf\x75\x25\x19\x29\x6b\xba\x91\x3f\xae\x4d\x32\x69\x8e\x5d\xc7\xd7\x76\x91\xf0\xdf\x8b\x73\x15\xd3\x8d\x87\x2c\x35\x8e\x8b\x5d\xc9\x9c\xff\xc2\xdf\x15\x69\x46\x2b\xe6\xbc\x8c\x25\x47\x5d\xc5\x5f\xe2\xba\xfe\xd2\xfa\x60\xb1\x5a\xf8\xc3\x89\x26\x29\xf1\xca\x2a\xcd\x9b\xcb\x8f\x93\x0d\xd9\xb7\x2e\x7b\xb3\xa3\xfb\xa2\xa2\x9a\xe7\xf8\xd7\xf4\x54\x16\x55\x43\xf2\x66\xcb\x97\x08\x47\x92\x14\xcf\x8c\xd7\x5a\x95\xe6\x5e\x02\x4f\x6f\x63\x28\x1d\xde\xd4\x55\x73\x25\x13\xc2\x0c\x5b\x43\x13\x6e\xca\x3a\xf1\x6f\xd8\xea\x8b\xbb\xf8\x9f\x8f\x15\xdd\x7f\xe5\x03\xb8\x08\xf5\xdc\x7c\xf0\x3e\x7e\xf0\x48\xd3\x54\x1f\xdb\xda\x4f\xde\x87\x4f\x1f\x74\x3f\xec\x84\x66\xd5\x02\x9c\x21\xfe\x7f\x7e\xfa\xf0\x0b\x79\x22\x75\x5c\xa5\x65\xb3\xf9\x20\x5a\x4e\x54\xe5\x77\x1f\x2c\x64\x1f\xae\x6c\x51\xf2\xb7\x73\xd1\xd0\xd6\x55\x5c\x2c\x15\xfb\x6e\xbd\x5e\x6f\x4b\x72\xa0\xfe\xae\xa2\xe4\x9b\x9f\xe6\xed\x8a\x6a\x43\x9e\x8a\x34\xb9\x36\xed\xba\x49\xad\x3d\x98\xf2\xf8\x7c\x29\xe5\x33\xfd\x6a\x3d\xe7\xa4\x69\xcd\x1e\xde\xbe\x75\xac\x27\xf2\xe2\x3f\xa7\x49\x73\x64\xcb\x38\x8d\xa7\xc7\x68\x72\x9c\x4d\xca\x4b\x51\x95\x47\x92\xd7\x9b\xd9\xf6\x39\x4d\x8a\xe7\x7a\x33\xe3\x55\x3a\x56\x36\x2c\x81\x74\x9a\x93\xa7\x1d\xa9\xcc\x25\xd1\x74\xd7\xe4\x8f\xd3\x98\x54\xb4\x99\x4c\x93\xaa\x28\xcf\xe5\xa3\x56\x26\x55\xbe\x29\x4a\x1f\x53\xa8\xeb\x34\x23\x3b\x9a\x21\xec\x09\x82\xe0\x3a\x35\xa6\x8d\x35\x4b\x74\x34\x0c\xd2\x6b\x92\x89\xfc\x75\xb4\xd7\x6a\xdf\xed\xf7\x7b\xab\x8d\xcf\xb1\xd3\xa4\x6b\xac\x15\x1d\x11\xca\x92\x24\xd1\xb0\x5c\xff\x20\x16\x00\x31\x35\x96\x02\x3f\xfc\xb7\xec\xb5\x3c\xa6\x71\x91\xd7\xde\xbf\x93\x6c\x9f\xa5\xf9\xa1\xfe\x61\x5b\x57\xf1\xe6\x5c\x65\x1f\xa7\xd3\x2f\x2d\x74\xfd\xe5\xa0\xc0\xfc\xa3\x04\xf3\x2b\x7a\x38\x67\xa4\x9a\xd2\xa2\xf9\x74\x7b\x93\xff\xe3\xbb\x94\xee\xd3\x97\x4f\x5e\xeb\xf2\x49\xf3\xf1\x07\x7a\xda\xd1\x24\xa1\x89\x5f\x94\x34\x6f\xad\xeb\x0f\x9f\x26\xe3\x31\x3e\x17\xfb\x7d\xd4\x21\x63\x7f\xde\x8c\xc0\x6c\x7f\x53\xf3\xa6\xd1\x5a\x37\xd5\x99\xde\x3c\x82\xfa\xe9\xf0\x5d\x07\xf0\xff\x2a\x00\x51\xdf\x61\xaf\x9f\x0e\x3f\x7c\xba\x4e\x15\x2c\xb2\x0e\x6e\xd7\xb3\x61\xf9\xb2\x45\xf7\x20\x23\x14\x40\x5b\xc7\xf3\xf5\xc8\x56\xf7\x15\xf3\x20\x30\xd6\xd6\xa1\xb2\x9e\xbc\xdd\xa9\x28\x9a\x63\xeb\x12\x48\xde\xa4\x24\x4b\x49\x4d\x13\xee\xb6\x8b\xfa\x05\xc2\x1c\x2a\xf2\x5a\xc7\x24\xa3\xda\x88\x7c\xe6\x0d\xd2\xfa\x5b\x67\xe6\x85\xc9\xfa\x6b\x10\x44\xe4\x83\x0e\x5a\x66\xe7\x1a\x05\xdb\x19\x60\xf4\x5c\x09\xa8\x89\x59\x5a\xd8\x8d\xa3\x80\xc4\x46\xe3\x53\x9a\x63\x9d\x44\x51\x18\x19\x70\x71\x56\x9c\x13\x04\x6e\x19\x84\x26\x31\xf9\x13\xcd\x8a\x92\x22\xa0\xab\x60\x6d\x0e\x8f\xe6\x71\x9a\xa1\x80\x7b\x03\xf0\x90\x91\x1a\xa1\x91\x06\xa0\xef\xd3\xb9\x4e\x63\x14\xce\x1c\x0b\x5f\xc9\xa0\x80\x33\x03\xf0\x48\x49\xd5\xa0\x70\x0b\x13\x61\x43\x2a\x14\x6c\x69\x81\xf9\xf4\x54\x36\xaf\x28\xf0\xca\x00\x3e\xd7\x14\xc7\xf9\x60\x80\xed\xd3\xec\x84\x82\x99\xbc\x6e\x8e\x7e\x46\xaa\x03\x22\x16\x1a\x84\x01\x00\x45\x81\x42\x0b\x5f\x5a\xa3\xbc\x01\x8a\x53\x20\x9a\x4e\x83\xd0\x64\x74\x45\x4f\xc5\x13\x4e\xdc\xdc\x00\xfc\xb5\x28\x4e\x7e\x9a\xa3\x90\x0b\x1b\xb2\x38\xe3\x24\x9a\x72\x29\xf6\x7b\x14\xca\x14\x48\x9d\x1e\x72\x82\xa8\x2b\x0d\x42\x53\x24\x71\x71\x40\xa1\x80\x44\x2a\x52\xa3\x9c\x8e\x4c\x71\x1c\x8b\x13\xca\x98\x28\x84\x7a\x80\x83\x99\xd2\x68\x52\x07\x36\x20\x8f\x82\x20\x93\x9d\x06\x91\x29\x8d\xa4\x78\xce\xb3\x82\x24\x3e\xc9\x50\x3e\x47\x0b\x14\x1c\x05\x35\x45\x72\x2e\x9d\x80\xa6\x54\xd2\x7c\x57\xbc\xa0\x70\x0f\xc0\x96\x92\x57\x3f\x4e\xab\xd8\xc1\xa6\x35\xd0\xc7\x92\x12\x74\x48\xb3\x00\x00\xee\x2b\x8a\xcb\x71\x66\x0a\xa8\x9d\x2e\x2e\x3e\xcd\x4c\x21\xb5\xae\x0c\x05\x33\x85\xb4\xcf\x08\xaa\x68\xb3\x39\x34\x62\x49\x79\x2c\x72\x8a\x9a\xd0\x99\x29\xa2\xa7\x22\x3b\x9f\xa8\x6b\x46\xcc\x96\x18\x70\x2b\x56\x14\x7a\x85\x41\x9f\x4b\x14\xd6\x94\xd6\xdf\xaa\xb8\x48\x50\x41\xcd\x4c\x41\xed\x88\x13\x72\x0e\xcc\x1a\xce\xac\x79\x08\xa1\x50\x36\xcd\x4d\x09\xed\x0a\xdc\xac\xcd\x67\x16\xd8\x89\x54\x38\xa8\x29\x25\xb6\x09\x44\xe1\x4c\x01\xc5\xe4\x44\x2b\x82\x02\x9a\xc2\x61\x91\x2b\x0c\x6c\x05\x48\xcc\xd0\x69\x36\x37\x05\xc2\x43\x9e\x28\x20\x30\x6b\xed\x26\x51\x2c\x9e\x10\xe8\x45\x60\x43\xf3\x4d\x12\x06\x6c\xca\x86\x05\x37\xfd\x8c\xee\x71\xcc\x11\x02\x1c\xd3\xbc\xc1\xdd\xe8\x62\x86\x80\x57\x4e\xb2\xe7\x08\xf4\x2f\xe7\xba\x49\xf7\xa8\x2f\x5f\x2c\xac\xb9\x8f\x82\x2d\x81\x2d\x4b\x68\xde\xb8\x47\x08\x2d\x1f\x83\x76\xd3\x0c\x16\x0a\x24\xa6\xad\xf5\xf7\x59\x08\x1f\x6d\x00\x96\x67\x69\xdc\x9c\x2b\x74\x6a\x2d\x4d\x29\x9e\x48\xe9\xb7\x6a\x8e\x73\x7a\x09\x04\xc3\x3f\x6d\x60\x80\x33\xe0\xaa\x70\x05\x5e\x9a\xb2\xa0\x49\x8a\x83\x81\x25\xda\x91\x38\xc6\x62\xca\x80\x45\x24\x51\x38\x93\xfb\xae\xf5\xca\xf2\x01\x2c\xf9\x68\xe9\xb7\x1b\xe1\x67\x52\xa1\xf3\x6c\xb9\x06\x52\xaa\x9b\x5e\xf8\x55\x00\xec\x5f\x0f\x68\x68\x79\x40\x14\xcc\x94\x4f\x49\xce\x35\x3a\xb2\xd5\x0c\x8c\xac\x40\x2d\xf9\x6a\x0e\xcc\x50\xe5\xa4\x6f\x61\x0f\xbd\x0f\x1c\x2e\xa6\x69\xd9\x0b\x6e\xca\x8b\xfe\x42\x63\x54\x4f\x56\x0f\x50\xfe\x4f\x55\xe1\x36\x33\xab\x35\x0a\xee\x9c\x85\x0f\x81\xb5\xa5\x63\x2b\x49\x14\x36\xb4\xb7\x66\x6e\xe0\x08\x59\x41\xbb\xa1\x67\x60\x51\xee\x86\x34\xe5\xf7\xb7\x33\xad\xdb\x0d\xb8\x1b\x7e\x01\xac\xd2\xbe\x70\xc3\x02\x11\xc6\x15\xa5\x79\x7d\x2c\x70\xce\xad\xb0\x01\xba\x97\x70\x0f\x0f\x70\x88\x3d\xb0\x70\x15\x91\xf7\x00\xaf\x4d\x11\x92\xaa\x2a\x9e\x9d\xfa\xb1\x0e\x11\x60\xa7\x76\xac\x23\x04\x1a\x5f\x21\xad\x67\x08\xa8\x6b\xe9\xb5\x9e\xdb\xc6\xcf\xb5\xf8\x5c\x2f\x00\x9f\xd9\x17\xe8\xfd\x39\x43\xf7\x3a\xeb\x25\x06\xcd\x3e\x65\xa2\xe0\x60\x16\xbe\xc4\x19\x39\x91\x3e\x85\x0a\xc1\xa6\xfe\x90\xa2\x8c\x0e\xc1\x9e\x3e\xa3\x04\x5b\xb2\x86\x60\x47\xbf\x4f\x51\x2f\x10\x06\xc0\xa9\xbc\x52\x16\xab\x43\x41\x17\x16\x68\x9c\x15\xa8\xcd\x0c\x41\x00\xe0\x99\x54\x79\x9a\x1f\xdc\x43\x5f\x41\x8b\x9d\xe3\x68\x81\xcd\x22\x19\xcd\x13\x34\x04\x11\x82\x38\x40\x45\xf2\xa4\xc0\x02\x06\x21\x88\x02\xc4\xc5\xe9\x44\x51\x07\x1c\x82\x50\xc0\x89\x1c\x72\x8a\x03\x46\xa8\xad\x44\xf5\x3b\x04\x11\x01\x09\xec\xd0\xf0\x10\xc4\x05\x2a\xda\x3c\x53\x07\x15\x70\x21\x50\x94\x65\x2b\x84\x18\x8f\xed\x84\x21\x5c\x47\x67\x2c\xf8\xed\x12\x31\x88\x12\x08\x70\x97\xf2\x80\x50\x81\x98\x3e\xf2\xfb\x3d\xda\x02\xee\x4c\x59\x8b\x63\x51\xa5\xbf\x16\x79\x83\xb7\x81\x21\x84\x04\xf3\x90\x21\x88\x20\xec\xce\x59\x76\x2c\x2a\x94\x6c\x10\x45\xd8\x51\x74\xb6\x87\x20\x8a\x10\xb7\xc3\xda\xa7\x31\x69\x50\xce\x81\x60\x42\x73\x3c\x9f\x76\xb5\x43\x3b\x40\x24\x41\xc0\xba\x94\x03\x04\x13\x8e\x24\x4f\x9c\x36\x38\x04\x01\x05\x06\xec\xb0\xee\x21\x08\x2a\x30\x58\x07\xc1\x6b\x1b\xd2\x45\x2e\x88\x29\x70\x4f\x34\xe0\x3a\x42\x10\x5e\x30\x1a\xb9\xc8\x07\x71\x06\xa3\x0d\x3e\x0c\x10\x72\x30\x5a\x38\x87\x63\xca\xf5\x90\x15\x3b\x54\xfe\x20\xf4\xf0\x5c\xd1\x1c\x8d\xca\x86\x20\xec\xd0\x90\xfa\x1b\xb6\x49\x0f\x41\xc0\x61\x9f\x66\xf8\xe6\x2f\x04\xd1\x86\x5d\x95\xd2\x7d\x4c\xf0\xf9\x0d\x02\x0e\xad\x5f\xe4\xeb\x16\x0c\x18\xc4\x1c\x12\x52\x1f\x77\x05\xbe\x40\x0d\x41\xe4\xa1\x24\x25\xad\xe2\x2c\x45\xc5\x00\xc2\x0f\x2c\x2e\xed\x8c\x24\x87\x20\x0a\x91\xa5\x39\xb6\xa3\x09\x61\x04\xe2\x58\xe0\xde\x06\x44\x20\xca\x73\x7d\x2c\xd1\x10\x6c\x08\x42\x10\xe7\x1a\x1f\xb8\xc9\xfd\xc3\x0e\x1f\xb2\xc9\xf7\xba\xc0\xad\x35\x08\x28\xb4\x60\xfe\xee\xd5\x27\x59\x79\x24\x3b\xdc\x21\x80\xb0\x02\x6c\xe2\x58\x27\x85\x20\xc0\x20\x9b\xf1\xcf\x93\x18\xfc\xcc\x0d\xef\xec\x63\x8e\x93\xd6\x34\x55\xba\x3b\x37\x68\x08\x2f\x04\xc1\x06\xbb\x91\xb3\x37\x20\xae\x9c\x6d\x7e\x29\x2a\xb4\x05\x5c\xc8\x95\x24\xc7\x01\x61\x30\x9c\x7f\x2b\x76\x5a\x0b\x10\x75\x50\xf0\xb8\x3d\x02\x91\x87\xac\x38\xe0\x5f\x03\xc2\x65\x08\x63\xa5\x68\x94\x36\x5c\xc2\xd0\xeb\xc1\xf1\xd1\x20\x04\xe1\x89\x9c\x3e\xfb\xcf\x69\x9e\x14\xcf\x28\x30\x5c\x9e\xc4\x05\x6e\x05\x60\x98\x82\xa0\x61\x85\x10\x44\x29\x5c\xcb\x0b\x10\xa4\x68\xb1\xe1\xbd\x82\xe8\x1e\xfb\x9a\x8e\x02\xae\xa1\xd8\x1d\x80\x20\x2e\x51\x53\x5c\x3b\x56\x50\x2c\x45\x59\xbe\xfa\x09\xfa\x3d\x94\x86\x20\x34\x21\xa0\x9d\xa3\x5a\xc1\xf8\x38\x03\x77\x7e\x5b\x0a\x61\xa8\xa2\x43\x8f\x42\x2f\x30\x68\x97\x24\x40\xb4\x22\xae\x68\x92\x36\xed\x9a\x13\xa7\xdc\x94\x1b\x3f\x2f\x88\x9b\x15\x18\xaf\x38\x37\x19\xad\x50\x37\x00\x42\x15\xfc\xfc\x0a\x06\xf8\x60\x2d\xfd\xcb\x8a\xd6\x35\xce\x64\x10\xa4\xa0\xa4\x72\x3a\x0e\x10\xa2\x60\x70\x2e\x5b\x04\x02\x14\x4d\xf1\xec\xa0\x15\x58\xc8\x86\x34\xa8\x51\x04\x61\x89\x3a\x71\xc6\x3d\x43\x10\x95\x38\xf6\x81\x82\xf9\x75\xde\xb1\xc3\x4a\x38\x05\x20\x12\xc8\x0e\xc2\xd4\x0d\xad\x1c\xa8\xa1\xbf\x3b\xb3\x15\x63\xb6\x43\x65\xbb\x86\x6e\xaf\x85\x5e\xf8\x21\x0a\x0b\xfd\x5d\x0b\xbb\x74\xc0\x42\x27\xd7\xc2\xae\x1c\xb0\x60\x6d\x28\x8f\xee\xfb\x8e\x4f\x1e\xe1\x1a\x1a\xc5\x43\x5a\x37\xfc\x30\x99\xbb\x0d\xf8\xfc\x91\x15\xe7\xa4\xef\x43\x62\x08\x22\x0e\xbc\x81\xf3\x73\x62\xb8\x7e\x00\x33\x8f\x52\x3f\x2e\xf2\xd4\x31\xfb\xd6\xf0\x23\x2e\xa5\x7e\x42\xe3\x34\x39\x17\xd8\x31\x0a\x1a\x05\x60\x6e\x61\x44\x44\x20\xe4\xd1\x5a\x20\xd7\x07\xdd\x08\xc4\x3d\x5a\xfb\xe3\x86\x05\x0b\x41\xfa\x44\x33\xdc\xb1\x46\x20\x00\xd2\x0a\x13\x05\x03\x6b\x41\x52\xa3\x7b\xbb\x08\x04\x3e\x48\x46\x51\xb7\x11\x81\xf0\x04\xfd\xdb\x99\x5d\xa7\xc0\x78\x1f\x81\x08\xc5\x37\x76\xc0\x17\x01\x0b\x61\x00\x13\xb5\xd0\xf0\x80\x4b\x49\xd0\xf5\x49\x04\xe2\x12\xbb\xb4\x3e\xa2\x81\xef\x08\x44\x24\xbe\xe5\x8e\x9d\x5b\x04\x02\x12\x3b\xb2\x7b\xf5\xf7\x45\x75\x3a\x67\xd8\x77\xbd\x08\xc4\x23\x1a\x34\x2a\x13\x2d\xf7\xe6\xd9\xa1\x5d\x46\xe2\x6f\xae\xbd\x47\x04\xc2\x10\x3b\xd4\xd4\x47\x20\xf4\x40\xca\x12\x53\xb3\xfd\xc3\xde\x3c\xae\x43\x2b\x7c\x2b\x15\x81\x80\xc3\xb1\x38\x57\x8e\xa3\x3d\xd1\x2c\x34\xcf\x38\x65\xe4\x84\x32\x1d\x44\x1c\x92\x73\x99\xb9\xe2\x0d\x11\x88\x37\x94\xe9\xe1\xf0\xea\xef\x08\xba\x39\x8a\x40\xc0\xa1\x8e\xd3\xba\x2e\x2a\x74\x8a\x83\x68\xc3\x2e\x6d\xe2\x02\x5d\x94\x46\x20\xd4\xb0\x6b\xb0\x2f\xaa\x10\xea\x65\x87\x6a\x11\x80\x7a\xc5\x94\x3c\x08\x88\x39\x8c\x5f\xb0\x59\x6d\x41\x55\xe7\x1d\x26\xe8\x28\xd8\x25\x10\x6e\x04\x14\x3b\x01\x87\x8d\x00\x84\x3d\xd2\x98\xfa\x59\x91\x65\xa8\xdd\x01\xd1\x0e\x05\xeb\x37\xad\x05\x42\xb5\x17\x04\x3b\x68\x72\x8e\xf9\xb9\x65\x0c\x16\x7c\x1e\x61\x57\xa9\xfa\x83\x6c\x11\x08\x73\x88\x36\x3d\xa1\xbc\x08\x04\x3c\x4e\x34\x3f\xfb\x47\x72\xda\x9d\xab\x03\x6e\xf1\x40\xe0\xe3\x54\x24\x24\x73\x6f\x3a\x22\x10\xff\x28\xb0\xc3\x75\x34\x02\xc1\x8f\x43\x45\x70\x65\x05\x81\x8f\xfa\x9c\xb3\xc9\x8a\x2e\x76\x22\x78\xd8\x42\xde\x64\x43\x61\x43\x1b\x96\x1f\x10\xc6\x80\x23\x1b\x58\x3b\xe9\x8e\xb5\x00\xb2\xdc\xfd\x42\xe3\x46\x7c\xb2\xc7\xbf\x59\x46\x20\x12\x62\x34\x11\x37\xa6\xb0\x56\x0b\x77\xab\x7e\xd5\x01\x71\x12\xa3\xa5\x23\x76\x17\x81\x73\x1b\x46\x9b\x3e\xa5\x03\xd1\x16\xa3\x9d\x2b\xb8\x18\xc1\x43\x1d\x55\x4a\xf2\x43\x46\xdd\x0d\xe0\xb9\x0e\xd9\xc0\x35\x1a\x10\x83\x51\xf0\x6e\x6e\x83\xf0\x8b\x6a\xe1\x10\xe9\x02\x2e\x4e\xf3\xba\xc0\xcd\x10\x8c\xb9\x9c\x4b\x5a\x89\xab\x06\x18\xf4\x02\xee\x00\x7a\x60\x97\xf6\x7c\x77\x32\x64\x65\xc3\xba\xb9\xfd\x60\x03\x3b\xe2\x2b\x11\x88\xaf\x30\x58\x7c\x09\xb8\x0c\x3e\x5c\x7f\x7c\xe7\x6b\x57\x57\x70\xa9\xe5\x9d\xb1\x77\xf7\x6d\xf9\x05\xbf\xa0\xec\x6e\x44\x35\xa4\xf4\x8f\xe9\xe1\x98\xb1\x3d\x09\x37\x30\xd5\x61\x47\x3e\x06\x13\xf6\xef\x13\xbf\x59\xab\x1f\x19\xff\xf0\xef\x34\x7b\xa2\xed\x54\xf2\xfe\x93\x9e\xe9\x87\x89\xfa\x7b\xf2\x6f\x55\x4a\xb2\x89\x76\x9d\x57\xeb\x75\x5e\xbe\x98\x87\xc6\xa7\xf3\xe8\x61\xb1\x0a\xe7\x33\x71\x65\xf0\xbb\xd9\x6c\xb6\x45\xaf\x43\x98\xf7\x11\xe1\x35\x44\x9d\x36\x79\x09\xb1\xeb\x57\x96\xe8\x5d\xcb\xcb\x89\xe4\xa2\x7a\x5e\x91\xdd\x6a\x0b\xef\xee\xf0\xeb\xb4\x1b\x76\x85\x4f\x5d\x97\x15\x4d\xa2\xd9\x22\x5a\xc5\x56\x13\xed\xba\x0f\x6f\xa7\xae\xd7\x2e\xca\x17\x8f\x9c\x9b\xc2\xeb\xce\xca\xc7\xe7\xda\xaf\xd8\x57\xb6\x16\xe7\x56\x40\xfa\xc5\x7e\x5f\xd3\x66\xe3\x47\xe5\x0b\xb8\x50\x1a\xb0\x3b\x33\xe0\x22\xeb\x29\x4d\x92\x8c\x5e\xa7\x31\xa9\x8a\x73\x4d\x33\x7e\x3d\xef\x71\x9a\x36\xf4\xf4\x48\x1e\xd3\xd3\x61\x82\xd7\xb1\x9a\xf4\x74\xf0\x2b\x5a\x97\x45\x5e\xa7\x4f\x74\x32\x65\x1f\x8d\x72\x92\x66\x9e\x68\xaa\x0a\xda\x3f\xcd\xeb\xcf\x5b\xf3\xf6\xce\x56\xbf\xd7\xc7\x11\xb7\x82\xa4\x89\xbc\x1b\x53\x91\x24\x3d\xd7\x9b\x65\xf9\xc2\xab\x15\x6a\xfc\xae\xb4\x1b\xbb\xba\xa7\xd8\xa3\x54\xa8\x26\xd9\x97\xe8\xbe\x4b\x92\x64\x6b\xd2\x37\xd7\xe7\x47\x45\x72\x71\xb9\x82\x64\x99\x37\x8d\x6a\x8f\x92\x9a\xfa\x69\xee\x17\xe7\x66\xeb\x17\x43\x10\xfd\xd5\x9c\x0f\xfc\x83\x11\xe0\xd2\x22\xf8\xfe\x7a\xac\x84\xe4\x99\x01\x8f\xda\x89\x2b\xfe\x16\x2e\x80\x15\xc9\xfb\x7e\xdb\xee\x1a\x93\x3e\x40\x4a\xe9\x75\x5a\x57\x7e\x91\x67\xaf\xdd\x55\x11\xb2\xab\x8b\xec\xdc\xd0\xad\xe0\x70\xf9\x22\x19\xdc\xfe\xec\x2e\x13\x0a\xcd\xf3\xdb\x52\x70\xdb\x79\xcb\x3e\xc4\x54\x34\x6e\x94\xb5\xe8\xee\x1e\xca\x1e\xb9\x9a\x93\x76\xad\x2c\xee\x9d\x23\x35\x7c\xa6\x28\xda\xea\x86\x34\x69\x2c\x28\x63\xf2\xd6\x65\xaf\xae\x1d\xc3\x4b\xc5\x9c\x1e\xa6\x7d\x3f\x57\x45\xa6\xee\x0a\x5f\xc0\x65\xdf\xe9\x31\x9c\x4c\x8f\xd1\x64\x7a\x9c\x4d\xa6\xc7\xf9\x64\x7a\x5c\x4c\xa6\xc7\xe5\xe4\x18\x4e\xf8\xad\xb3\xe3\x7c\x72\x5c\x4c\x8e\x4b\xb7\x69\x11\x17\x61\x16\xf0\x22\xcc\x34\x04\xf7\x9f\xa7\xc7\xd0\x9b\xb2\x83\x1f\x93\xf6\xa7\xfc\x15\x75\x85\x91\x2a\x9c\x75\x85\x33\x55\x38\xef\x0a\xe7\xaa\x70\xd1\x15\x2e\x54\xe1\xb2\x2b\x5c\x8a\xc2\xae\x73\xd5\x77\xd7\xb5\xea\xb9\xeb\x58\xf5\xdb\x75\xab\x7a\xed\x3a\x55\x7d\x76\x5d\xca\x1e\x2f\xfd\xd7\x84\xc4\x44\x5c\xad\x56\x86\x10\x24\xe3\x07\x94\xbd\x75\x5c\x6f\x65\xe8\xbd\x1c\xd1\xfc\xe7\x72\xf1\xfd\xd5\x50\x1b\xa9\x2d\x1a\xf5\xa1\x93\xfa\xb7\xc9\xf3\x4d\x62\x91\x79\x11\x18\xef\x8f\xa1\x56\x38\x63\x26\xb9\x95\x41\xa4\x97\x72\x8a\x67\xad\x64\xb4\xa4\x0d\x73\x3e\x8e\xc9\x71\xae\x2f\x2a\x1e\x58\xe9\x62\x72\x5c\x5c\x4c\xa7\x7f\x65\x3c\x5a\xea\xa5\xad\x63\x2b\x95\x4f\xf3\x02\x8f\xf3\x26\xa3\x24\xb9\x20\xf6\x4d\x6b\xb9\x94\x7f\x0a\x15\x9b\x59\x13\x70\x7e\x15\x17\x86\x3f\x9e\xd2\x5c\xb8\x8f\xd5\xf2\xa1\x7c\xf9\x74\xe1\x1d\x68\x23\x09\xcb\x97\xeb\x55\xb0\xca\xca\x33\xd1\xf2\xe9\x44\xaa\x6f\x13\x96\xa1\x42\x5d\xd2\x8e\xe8\x09\x73\x2d\xf1\xfe\x81\xce\xae\x53\xb6\x1c\x68\x57\xaf\xfc\x1e\x30\x77\xd0\xed\xdf\xa2\x8a\x2d\x56\xf5\x3a\x56\x20\x2a\xf9\x39\x6c\xbd\x96\x97\x88\x6a\x71\x92\x5a\xaf\x17\x45\x02\x20\x2f\x9e\x2b\x52\x5e\x9e\x8f\x69\x43\xd9\xf5\x6d\xba\xe1\x45\x92\xae\xe2\x99\x56\x31\xa9\x29\xcc\xb7\xa0\x2a\x04\xe0\xb9\x2c\x71\x40\x55\x21\x29\x26\x25\x3b\xf3\xfe\xab\x05\xd9\xd5\x08\xd0\xd3\xb9\xa1\xc9\x45\x37\x00\xac\xb8\xac\x52\x96\x56\xc5\x58\x88\x5d\x89\x51\x29\x17\x60\x66\xa1\xb9\x1a\x7b\x58\x06\xeb\x40\xe0\xac\xcf\x71\x4c\xeb\x5a\xe1\x8c\x57\xcb\x59\x22\x71\x8a\x4a\x13\xa7\x2c\x34\x71\xee\x16\xf3\x28\x16\x38\xd3\x7c\x5f\x28\x84\xe1\x2a\x78\xd8\x4b\x84\x6d\x8d\x89\x8d\x95\x98\xa8\xe6\x8b\x68\xb9\x16\xa8\xc4\xf9\x36\x59\xf7\x40\x96\xc9\x6c\x27\xb1\x89\x4a\x13\xa1\x2c\x34\x70\x2e\x97\x8b\x50\x91\x97\x90\xfc\xd0\x55\x91\xf5\x7c\x3e\x8f\x24\x4a\x5e\x67\x62\x14\x65\x06\xc2\x87\xf9\x6c\x31\x9b\x5f\xa7\xbb\x03\x94\x0a\x5b\x38\x59\x3a\xaf\x64\xd5\x35\x50\x9d\x68\x45\xbc\x0f\xbb\xb9\x14\xd9\xee\xa0\x04\x66\x03\x25\xfb\x7d\x90\x3c\xf0\x3e\xa0\xe4\xb4\x22\x57\x1f\x71\x48\xa3\xdd\x8c\xf5\xc1\x04\x88\x74\xb0\xa6\xc9\x5e\x0c\xc2\x90\xa4\xfc\xdb\x85\x9a\xec\x93\x75\xbb\xb0\xda\x1d\x94\x40\x9d\x66\x81\x68\x50\x7a\x07\xa6\x5c\x91\xe6\x2b\x1a\xef\x16\xac\x0f\x21\x60\x04\x26\x4a\x68\x42\x79\x17\x40\xd2\x5d\x89\xab\x03\x3a\xdf\xad\x77\xeb\xeb\x94\xdd\xa5\xe7\x9f\x3e\xa5\xa5\x93\x16\x78\xad\x1c\xd9\x66\x1e\x94\x2f\x5e\xe0\x69\x6b\x4e\x3d\x3d\x90\xb6\xda\x2c\xb2\xc9\x39\xd3\xdd\x61\x80\xf9\xc2\x22\xf3\x8a\x6c\x52\x64\xde\xb9\x05\xf7\x58\x23\xaf\x6b\x27\x40\x83\xeb\x94\x5d\x0a\x3b\xe7\xec\x3a\xb2\xca\x73\xc1\x63\x04\xad\xf5\xaf\xbb\x9b\xca\x39\x15\xd0\x7c\x1f\x01\x61\x05\x66\xf6\x97\xbf\x60\x5b\x07\x77\xe3\xc7\x2c\xc5\xb7\x25\x12\x29\x0f\x3c\x2c\xba\xd5\x32\x47\xbc\x28\x5f\xae\x49\xef\xe8\x5b\x06\x5e\x93\x64\x92\x98\x89\x5f\xba\xbd\xcb\x35\x69\xec\xec\x4a\xca\x35\xf2\xc1\xf4\xb8\xb9\x24\xd3\x22\x6b\x5e\x8b\x2b\x2b\x48\xc3\xdc\x90\x5c\xed\x2f\x03\x74\x39\x4f\x49\xc5\xc1\xa0\x87\xe2\x05\xaa\x01\xcd\xb2\xb4\xac\xd3\x7a\x8b\xf9\x1a\xd0\xbd\x49\x77\xf8\xd0\x0e\x9e\x67\xb8\x48\x48\x43\xfc\xa2\x4a\x0f\x69\x4e\x32\x9f\xe7\xbb\x98\xe8\x39\xa8\xc4\xba\xfd\x48\xb3\x12\x51\x38\x9e\x8f\xca\xe3\xce\x24\xcd\x53\x76\xd5\xbc\x3e\x69\x3e\x7c\x1d\x7c\xbf\x75\x7a\xb0\x2e\xf5\x85\x72\xee\xad\x5a\x7a\xda\xc2\x93\xad\x4d\xe0\x12\x64\x35\x5d\x74\xfa\x2f\x25\xae\x6b\x7f\x87\xd8\x2b\xb2\x4d\x46\xea\xc6\x8f\x8f\x69\x96\x4c\xb4\x8a\xd2\x51\x7e\xd6\x1b\x58\x33\x41\x03\x14\xab\x16\xad\x44\xe4\x32\xd3\x4a\xf8\x92\xc6\xdc\xb1\x1b\x89\xb4\x06\xe2\x31\x2d\x63\xad\x2e\x45\x8c\xca\xee\x19\xa9\x40\x0f\xbc\xff\xf0\xd7\x28\x08\xe7\xde\x5f\x83\xe0\xdf\x82\x1f\xae\xd3\x0e\xdc\xaf\xe8\x13\xad\x6a\x1d\xc3\xb4\x3c\x67\x99\x58\x34\x99\xd3\x2e\xb4\xe6\x5d\x60\x2b\xad\xdc\x50\xcb\x89\xaa\x49\xc9\x10\x60\x80\x91\x01\xc6\x8b\x41\x98\x03\xc7\x20\x1c\x2c\xd3\xc6\xe5\x64\xab\x0e\xe3\xe2\xb0\x0e\x83\x33\x1b\xe5\xb0\xec\x93\x47\x1d\x7b\x46\xe6\x06\xd0\x11\xf4\x8e\xab\x0f\xc4\xe8\xa5\x6f\x54\x66\xde\x9a\x1f\x98\xee\x78\x4c\x8f\x7e\xb8\x92\x24\xa9\xda\xc5\x83\x73\xe3\xa0\x67\xb1\x70\xd8\xdb\xfe\x7c\x69\x7f\xa6\x79\x56\x4c\xfe\x5c\xe4\x24\x2e\x26\x7f\x64\x31\x72\x52\x4f\x3e\xfc\xb1\x38\x57\x29\xad\xbc\xff\xa4\xcf\x1f\xba\x4c\x6a\x0c\x97\xb2\x28\x51\xf9\xe2\xcd\x0d\xfb\xd1\xda\x24\xb9\x3a\x59\x45\x8b\x39\xc5\x76\x13\xeb\x7d\xb4\x9f\xdb\x51\xa9\xeb\xb7\x5d\x32\x0e\xb5\x6b\xc5\x36\x03\x48\x67\x5a\xa8\x4b\xcb\x6f\x94\xe6\x35\x6d\xbc\xc0\xf3\x43\xe6\xf1\xb5\x80\xf0\x34\x5a\x7c\xda\x8e\x86\x6c\x09\xf6\x74\xa2\xf5\xec\x7f\x2c\xa8\x07\xdc\x9c\x2b\x0d\x13\x4c\xbe\xc4\x72\xde\x99\x96\x4d\x76\xb1\x66\xf6\x19\x6c\x2e\xf5\x6e\x67\xa3\x02\xd1\xcf\x45\x95\xf0\xf4\x42\x1b\x91\x64\x28\xcb\x78\x61\xeb\xe5\x44\x59\xfb\x37\x26\xbf\x45\xfb\x0f\x89\x35\xc6\x71\x8c\x48\xb5\xac\xa8\x67\x68\x4d\x80\xc4\xaf\x8d\xb0\x92\xe1\x77\xcb\x8a\x32\x9a\x6c\x42\xb4\xb4\x8f\xa0\xdb\xe0\x3a\x6d\x9b\xd5\x71\x55\x64\x19\x4b\x5f\x74\x22\x2f\x92\x21\xb3\xb9\xbe\x3a\xf0\x5f\x37\x1c\xec\x3a\x6d\x27\x20\x49\xb5\x4c\x76\x4e\x63\x1c\x76\x32\x10\x30\x5a\xe8\x8e\x83\xb0\x38\x9d\x7b\x11\xd3\xf5\x25\xca\x17\x6c\xe1\x60\x37\x58\xaf\x23\xb4\xc1\x7a\xe5\x68\x10\x46\x41\x80\xb6\x08\x43\xde\xa4\xab\xf0\xf7\xd9\x39\x4d\xde\x6d\xb4\xd3\xaa\x78\xbe\x18\x70\xbe\xde\x94\xaf\x4b\xdb\x92\x96\x84\xcc\xcf\x0e\x7e\x38\x51\xbf\x82\xee\xa7\x56\x1a\xa9\x9f\xdd\xaf\x99\xfa\x35\x57\xbf\x16\xea\xd7\x52\xfd\x5a\xa9\x5f\x0f\xea\xd7\x9a\xff\x3a\x25\xb2\xeb\xf6\x57\xd0\xfd\xd4\x4a\x23\xf5\xb3\xfb\x35\x53\xbf\xe6\xea\xd7\x42\xfd\x5a\xaa\x5f\x2b\xf5\xeb\x41\xfd\x12\x5d\xd7\x27\xd9\x75\xfb\x2b\xe8\x7e\x6a\xa5\x91\xfa\xd9\xfd\x9a\xa9\x5f\x73\xf5\x6b\xa1\x7e\x2d\xd5\xaf\x95\xfa\xf5\xa0\x7e\x89\xae\x5f\x6a\xd9\x75\xfb\x2b\xe8\x7e\x6a\xa5\x91\xfa\xd9\xfd\x9a\xa9\x5f\x73\xf5\x6b\xa1\x7e\x2d\xd5\xaf\x95\xfa\xf5\xa0\x7e\xad\x91\xec\x4d\xad\xae\xda\xc1\xf8\x5e\xf5\xbb\xfe\x1d\x07\xd0\x6d\x2f\x3a\x2a\xa2\x4b\xf7\xe5\xa6\x2b\x0d\xe5\xdc\x0c\xa7\x4b\xfe\x7f\x2b\xad\x36\x10\xb5\x0f\xb3\xe9\x4c\xfc\x5f\x57\xbb\x56\x76\xa0\x2b\x7b\x10\x65\xcb\x25\x82\x6e\x25\x2a\x17\x0f\x08\xb6\xa5\xac\xd4\xa8\x5b\x88\xb2\x39\x46\xdc\x5c\x54\xce\x30\xda\x66\xa2\x32\xd2\x68\x53\x0c\xc0\x68\x93\x7c\xc0\x48\x63\x8b\x9f\x30\xba\x08\x69\xeb\xfc\xe3\x55\xa1\xa8\x42\x99\xc8\x41\x02\x01\x82\x72\x92\x81\xac\x05\x84\xce\x4e\x56\xf1\x20\x2a\x50\x9e\x32\x88\x95\x80\x40\x19\xcb\x20\x96\x12\x02\xd2\xbe\x10\x15\x28\x8b\x19\xc4\x5c\x40\xa0\x7c\x66\x10\x33\x01\x11\x41\xca\x15\xcb\x9c\x94\x4b\xce\x39\x09\x97\x7c\xe3\xd6\x5a\xd5\xd4\xc7\x56\x20\x7c\xae\x99\xf2\x68\x6b\x42\x5e\xe3\x10\x47\x0b\x11\x70\x08\x87\x34\xea\xa3\xbf\xe6\x00\xa6\x30\xea\xa3\xff\xc0\xcb\x1d\xb2\xa8\x8f\xfe\x8a\x03\x38\x44\x51\x1f\xfd\xa5\x00\x80\x54\x2f\x78\xb9\x43\x10\xf5\xd1\x9f\x73\x00\x87\x1c\xea\xa3\x3f\xe3\x00\x11\xa4\x59\x32\xca\x49\xb3\xe0\x97\x93\x64\xc1\x2d\x43\x06\xfc\x93\x78\x2b\x05\x23\x98\xa0\x0b\x43\x82\x84\x06\x08\x2a\x15\x09\x1a\x18\xa0\xa8\x78\x04\xe8\xda\x80\xd4\xe5\x24\x00\x1e\x0c\x00\x54\x60\x02\x72\x65\x40\xa2\x92\x13\x90\x4b\x13\xd2\x1e\xeb\xc2\x00\x40\x65\x29\x20\xe7\x06\x24\x2a\x54\x01\x39\x33\x20\x23\x7b\xa4\x40\x04\x3d\x23\x35\x25\xd1\x33\xd0\x60\x74\x68\xeb\xef\xb6\x40\xb0\x9c\x1c\xeb\xc5\x72\x72\x8c\x0c\xa7\x93\x63\xf4\x3a\x9d\x1c\xeb\x06\x38\xb9\x96\x08\xa7\x93\x6b\x69\x75\x3a\xb9\x76\x48\xd0\xc9\xb5\x03\x76\x3a\xb9\x96\x2f\x4e\x27\xd7\xb2\x0f\x3a\xb9\x96\xb9\x4e\x27\xd7\x0e\xd5\xe5\xe4\xea\x93\xd3\xc9\xa9\x2a\xb7\x93\x53\x20\x6e\x27\x27\x41\x2c\x27\x27\x2b\xdc\x4e\x4e\x42\xb8\x9d\x9c\x84\xb0\x9c\x9c\xac\x70\x3b\x39\x09\xe1\x76\x72\x12\xc2\x72\x72\xb2\xc2\xed\xe4\x14\x5f\x5c\x4e\x4e\x02\xd8\x4e\x8e\xd5\xa0\x4e\x4e\xd5\x38\x9d\x9c\x82\x70\x3a\x39\x09\x01\x9d\x9c\x2c\x77\x3a\x39\x09\xe0\x74\x72\x12\x00\x3a\x39\x59\xee\x74\x72\x12\xc0\xe9\xe4\x24\x00\x74\x72\xb2\xdc\xe9\xe4\x14\x3b\x1c\x4e\x4e\xd6\x5b\x4e\xae\x3e\x0d\x3a\x39\x0d\x64\xc8\xc9\x69\xa0\x43\x4e\xae\x03\x75\x38\xb9\x0e\x60\xc8\xc9\x75\x90\x43\x4e\xae\x83\x74\x38\xb9\x0e\x60\xc8\xc9\x75\x90\x43\x4e\xae\x83\x74\x38\xb9\x0e\x60\xc8\xc9\x69\xfc\xed\x77\x72\x1d\x20\x74\x72\xbd\xa1\x8c\xbf\xd3\x0e\xdc\xf2\x72\xac\x17\xcb\xcb\x31\x32\x9c\x5e\x8e\xd1\xeb\xf4\x72\xac\x1b\xe0\xe5\x5a\x22\x9c\x5e\xae\xa5\xd5\xe9\xe5\xda\x21\x41\x2f\xd7\x0e\xd8\xe9\xe5\x5a\xbe\x38\xbd\x5c\xcb\x3e\xe8\xe5\x5a\xe6\x3a\xbd\x5c\x3b\x54\x97\x97\x3b\x25\x4e\x2f\xa7\xaa\xdc\x5e\x4e\x81\xb8\xbd\x9c\x04\xb1\xbc\x9c\xac\x70\x7b\x39\x09\xe1\xf6\x72\x12\xc2\xf2\x72\xb2\xc2\xed\xe5\x24\x84\xdb\xcb\x49\x08\xcb\xcb\xc9\x0a\xb7\x97\x53\x7c\x71\x79\x39\x09\x60\x7b\x39\x56\x83\x7a\x39\x55\xe3\xf4\x72\x0a\xc2\xe9\xe5\x24\x04\xf4\x72\xb2\xdc\xe9\xe5\x24\x80\xd3\xcb\x49\x00\xe8\xe5\x64\xb9\xd3\xcb\x49\x00\xa7\x97\x93\x00\xd0\xcb\xc9\x72\xa7\x97\x53\xec\x70\x78\x39\x59\x6f\x79\xb9\x53\x32\xe8\xe5\x34\x90\x21\x2f\xa7\x81\x0e\x79\xb9\x0e\xd4\xe1\xe5\x3a\x80\x21\x2f\xd7\x41\x0e\x79\xb9\x0e\xd2\xe1\xe5\x3a\x80\x21\x2f\xd7\x41\x0e\x79\xb9\x0e\xd2\xe1\xe5\x3a\x80\x21\x2f\xa7\xf1\xb7\xdf\xcb\x75\x80\x23\xbc\x9c\x16\x7f\xff\x3b\xc5\xb8\x2d\x37\xc7\x7a\xb1\xdc\x1c\x23\xc3\xe9\xe6\x18\xbd\x4e\x37\xc7\xba\x01\x6e\xae\x25\xc2\xe9\xe6\x5a\x5a\x9d\x6e\xae\x1d\x12\x74\x73\xed\x80\x9d\x6e\xae\xe5\x8b\xd3\xcd\xb5\xec\x83\x6e\xae\x65\xae\xd3\xcd\xb5\x43\x75\xb9\xb9\xec\xe0\x74\x73\xaa\xca\xed\xe6\x14\x88\xdb\xcd\x49\x10\xcb\xcd\xc9\x0a\xb7\x9b\x93\x10\x6e\x37\x27\x21\x2c\x37\x27\x2b\xdc\x6e\x4e\x42\xb8\xdd\x9c\x84\xb0\xdc\x9c\xac\x70\xbb\x39\xc5\x17\x97\x9b\x93\x00\xb6\x9b\x63\x35\xa8\x9b\x53\x35\x4e\x37\xa7\x20\x9c\x6e\x4e\x42\x40\x37\x27\xcb\x9d\x6e\x4e\x02\x38\xdd\x9c\x04\x80\x6e\x4e\x96\x3b\xdd\x9c\x04\x70\xba\x39\x09\x00\xdd\x9c\x2c\x77\xba\x39\xc5\x0e\x87\x9b\x93\xf5\x96\x9b\xcb\x0e\x83\x6e\x4e\x03\x19\x72\x73\x1a\xe8\x90\x9b\xeb\x40\x1d\x6e\xae\x03\x18\x72\x73\x1d\xe4\x90\x9b\xeb\x20\x1d\x6e\xae\x03\x18\x72\x73\x1d\xe4\x90\x9b\xeb\x20\x1d\x6e\xae\x03\x18\x72\x73\x1a\x7f\xfb\xdd\x5c\x07\x68\xb9\x39\xf1\x06\x50\xdf\xc3\x8b\xe2\xed\x49\xf5\x35\xb9\x29\xca\xcd\x83\xf6\x2d\x4f\x9c\x5c\x69\x8b\xba\x03\x58\x5b\x78\x8e\xbc\x39\x22\x47\xcb\x59\xe7\xda\x55\x29\x70\x73\x0a\x39\x7e\xc8\xdb\x3c\x36\xbb\x22\x79\x7d\x6c\xaa\x47\xf5\xac\x90\x56\x74\x54\x45\xfb\xa2\x68\x00\x94\x2a\xea\xa0\x8e\x94\x24\x00\x4a\x15\x75\xcf\x84\x3d\xb8\x8f\x5f\x80\x8b\x6d\x4d\x51\x3a\xae\x34\x25\x49\x72\x45\xba\x80\x2f\x3c\xb2\xf1\x82\x93\x83\x11\x8a\x45\xc8\xe6\xb3\xc4\xb6\xd9\xa7\x95\x3c\x86\xa7\x8d\xa7\x1f\x4c\x71\x22\x2e\x32\xf6\x00\xd6\x20\xba\x7e\x38\x93\xb3\x66\x9d\x0b\xe5\x30\xe8\x51\x7b\xed\x6a\x13\x18\x8a\xf0\x99\xfd\xaf\x5e\x8f\x72\xcb\x9b\x3a\xb4\x9d\xdd\xd5\x14\xaf\x53\xc5\x45\x9e\xb0\xf7\x67\x11\x1d\x43\x2b\x8f\x48\xa5\xa5\x77\x68\x25\xd6\xd2\xd2\x45\xb4\xb2\xd3\xca\x85\x9a\x13\xea\x5d\x2d\xfc\x51\x2d\x08\x85\x0d\x0f\xa9\x3b\xda\x75\xf6\xe0\x90\x3a\xa4\x9d\x3d\x34\xa4\xce\xf1\x24\x98\x4d\xfd\x4d\xd8\xc4\x24\x12\xb6\x25\xea\x78\x56\x37\x55\x5a\x6a\x03\xde\xe4\xcd\xd1\x2f\xf6\x7e\xf3\x5a\xd2\x8f\x45\x92\x7c\xc2\x94\x65\xdd\xfe\x93\x18\xd8\x11\xf5\xae\xbd\xf3\x48\x3c\x3b\x5a\xc5\xcd\xad\x17\x17\xd9\xcf\x71\x46\xea\xfa\xc7\x9f\x5a\xf3\xfc\xd5\xba\x41\x68\xbe\x4d\x17\x17\xd9\xf9\x94\x6f\xf9\xe2\x9f\x9d\x22\x93\xef\xb1\x19\x58\x26\xf2\x6d\xb6\x9b\x70\xd3\x2c\xd3\x31\x03\x63\x3a\xe5\x77\x1f\x11\x33\xab\x6a\x8e\xb6\x01\x4e\xa6\xf2\xca\xa4\x65\x9a\x61\x8d\x50\x18\xa4\x1f\x58\x83\x59\x75\x07\x36\xa4\x1f\xa1\x12\x48\x3f\xb0\x06\xf3\x0b\x0e\x6c\x5d\x3f\x6e\x89\xa3\x6a\x22\x5a\x6d\x44\xa9\x52\xe1\x5e\xa8\x23\x0e\x25\xaa\x4d\x12\x01\x4c\x37\x04\x0e\xed\x82\x3a\x1a\x50\xd8\xc5\x8b\x87\xf6\x9f\xa5\x25\xe2\x3e\x0b\xa6\x26\xaa\x0a\xd5\x13\x51\x8b\x29\x0a\xac\x92\xfa\x80\xf4\x65\x55\xa1\xba\xe2\x40\x88\xf5\x25\x75\x02\xe9\xcb\xaa\x42\xf5\xc5\x81\x50\xeb\xcb\x7d\x69\x08\xd7\x05\xe3\xca\x90\x5b\x65\x00\xd8\x80\xce\x98\x64\x22\x4a\x63\xa0\x73\x6b\xcd\xd0\x7d\xa6\x24\xa0\xeb\x78\x69\xa9\x4d\x9a\xef\x0b\x4c\x67\x78\x39\xaa\x30\x6d\x15\xa6\x2d\x46\xb9\xd4\x07\x88\xdf\x2c\x47\x95\x04\xc3\x63\xe1\x97\x3a\x00\xf1\x9b\xe5\xa8\x62\x60\x78\x24\x7e\xf7\x35\x2f\x5c\xd6\xdd\x3d\x2f\xb7\x3e\xe8\x30\x03\xca\xa0\x91\x86\x68\x42\x87\xc8\xad\x06\xbd\x17\xcf\xe2\x39\x9d\xed\x67\x96\x0e\x88\xbb\x64\x98\x1a\xa8\x2a\x54\x13\x44\x2d\xa6\x0c\xb0\x4a\xca\x1d\xe9\xcb\xaa\x42\xb5\xc2\x81\x10\xeb\x4b\xea\x00\xd2\x97\x55\x85\x6a\x88\x03\xa1\xd6\x97\xfb\xc2\x1e\xae\x03\xc6\x75\x3d\xb7\xaa\x00\xb0\x01\x6d\x31\xc9\x44\x14\xc6\x40\xe7\xd6\x99\xc1\xbb\x84\x64\x1f\xc5\xb1\xa5\x36\xfc\x82\x20\xa6\x35\xb2\x06\x55\x1a\x5e\x89\xe9\x0c\xa8\x91\x7a\x61\xf7\x03\x6b\x50\x85\xc1\xb1\x21\xfd\x48\x9d\xb0\xfb\x81\x35\xa8\xb2\xe0\xd8\xba\x7e\xdc\x17\x2f\x71\x1d\xd0\xef\x5d\xba\x35\xc5\x84\x1a\x50\x14\x83\x44\x44\x4f\x74\x64\x6e\x35\x19\xba\x10\xba\x8b\x63\xa5\x25\x5a\x5e\x98\x8b\x76\x24\x79\x1a\x84\xdf\x77\xb7\x03\x5e\x8c\x83\xfc\x3c\xe9\xbb\x47\xf2\xc4\xfb\xd8\x05\x21\x56\xcb\x15\x0b\xf8\x5b\x58\x9d\x31\x0a\x76\xc8\x59\xbb\x81\x20\x6e\x28\xfa\xa7\x5a\x5d\x42\x14\x17\x7b\xda\xa2\x96\x82\x63\xca\x82\x28\xfc\xaa\xc2\x8e\x54\x78\xa6\x17\x7b\x64\x8f\x62\x33\x6b\xdd\x3a\x75\x00\x62\xfb\xbd\x3e\xa0\x63\x0f\x90\xbd\x03\xec\x03\xea\xc3\x64\xef\xe2\xfa\x80\x8e\x78\x8e\x00\xbc\x9d\xb5\x1f\x76\xf3\x06\xdd\x14\xeb\xd1\x07\x27\x71\xe8\x96\xf9\xd6\x96\x1d\x3b\xef\x6e\x79\x73\x9f\x1d\xe3\xef\x6e\x69\xf4\x79\x01\xf7\x12\x6f\xe2\xb4\x76\xa7\xf4\x36\x46\xdf\xd6\x50\xe3\xf3\x9d\x0d\x6f\xed\x51\xe3\xf2\x9d\x0d\xf5\x1e\x2f\xc6\xbd\xd0\x5b\x98\xac\x21\xe9\x9b\x6a\x03\x0d\xdd\x13\xd9\xe6\xd5\xed\x3d\x62\x0d\x41\xfc\x66\x13\x5c\xaf\xfb\x94\x66\x49\x4d\x9b\x4b\xf7\x61\x36\xb0\xd3\x3e\x05\x5d\x42\xa7\x8c\x1e\x68\x9e\x80\x4b\x77\x9a\x01\x87\x6d\x1d\x29\x5c\xa2\x10\xc4\x7f\xcd\x0b\x6e\xda\x1d\xc5\x2e\xa1\x15\x92\x65\x60\xd1\xfe\xbb\xf2\x67\xf9\xc7\x24\x0f\x33\x69\x5a\x80\x34\x32\xab\x20\xb8\xb2\xe4\x72\x3f\x37\xaf\x25\xfd\x89\x3f\x7d\xfd\xf5\xbd\xf3\xf0\x69\x3d\xb0\x37\x24\x76\xc5\xcb\xd7\x89\x56\x58\x91\x24\x2d\xbe\xca\xbc\x38\x73\x76\xa1\x52\x71\x53\x44\xc0\xff\xba\x36\x98\xc7\xaf\xb5\xea\x98\xf7\x69\x46\xbf\x9a\x52\xba\x1a\x7d\xe4\x07\x58\xaf\x49\xf1\xca\x93\xeb\xfd\x7c\x3a\x67\x4d\x5a\x66\xf4\xab\xc8\xb6\xf7\x73\x2b\xbb\xaf\x17\x3d\xc1\x1b\xec\x53\xa4\x9d\xc0\x06\x69\x57\xf1\xa1\xbe\x4b\x86\xbc\xe2\xdc\x94\xe7\x06\xbf\x0c\xca\xb8\xb6\x32\xaf\x7f\x0e\xe7\x21\x5c\x2c\x16\xd7\xe9\xbe\xa8\x4e\x7e\x5c\xe4\x4d\x55\xc0\x3b\xf4\x76\x4e\xba\xd9\x5c\xcb\x99\xb6\x2c\x5f\xbc\x30\xba\xa3\x53\x57\xca\xba\xae\x34\x3d\x91\x03\x95\x17\x62\x47\x5d\x2e\xed\xbb\xdd\xdb\xb6\x6d\xff\x5f\xbf\xb4\x1b\xac\xf0\xfb\xbd\x4e\x58\x24\x51\x9e\x20\x82\x0d\x41\x4f\x76\xe7\x4d\xc3\x45\x3d\xb1\x09\xb2\x60\x40\x5a\xbd\x7e\x7c\x7d\x78\xde\x03\x89\xa9\x0a\x42\x65\x75\x6c\x9b\xef\x96\x4b\xb2\xa7\x6b\xa9\x9d\x1b\xf4\x16\xf3\x10\x23\x27\x81\x17\x78\x0f\xb2\x22\x0c\xa2\x49\xb8\x5a\x4c\xa2\xd9\x6c\x32\x5d\xde\x24\x91\x5e\x44\x60\x30\x1b\x66\xc3\xca\x8c\xc4\xf4\xc8\x9e\x64\x93\x19\x7f\xd6\xeb\xf5\xb6\x28\x49\x9c\x36\xaf\x9b\x10\x34\x6a\x57\xdc\x6c\x2a\x3b\x1a\x5a\x7d\x08\x66\xdc\xd4\xe6\x54\x8b\xd7\x73\x7a\xbf\xa9\x6a\xe9\x06\xf5\xf6\x3f\x27\x29\xcb\x2b\x98\x7c\x9d\x98\xe5\x15\x25\x49\x91\x67\xaf\x5f\x27\xd2\xfd\x75\xa0\x9e\x39\xe5\x91\xbd\x11\xa5\x2e\x9e\x68\x1d\x0e\x22\x16\x49\x4d\xf2\xa2\xf1\x49\x96\x15\xcf\x34\xb9\xca\x54\xa6\x26\xa0\xc3\xd8\x42\xe7\x44\xca\x92\x92\x8a\xe4\xb1\xc8\x61\x83\xec\xc4\x24\x68\xeb\xea\x13\xfa\x94\xc6\xd4\x2f\xd3\x17\x9a\xf9\x2c\x69\xe9\x26\xf8\x74\xd1\xf0\x27\xa4\xa1\x5f\x0d\x4a\x74\xc3\xdd\xa4\xa7\x9e\xda\xb6\x2d\x7b\x3e\x39\x2b\x62\x92\xb9\xe1\x4e\x45\xde\x1c\xcd\x6a\x23\x15\xce\x8c\xe5\x8d\xe3\x0a\xc3\xbe\x90\xfa\xf5\xc9\x83\x34\x4e\x7a\x00\x18\x99\x7d\x00\x80\xd2\x3e\x50\x4e\x2c\x1c\xe6\x57\xd1\xa2\x3e\xd9\xec\xc1\x6a\x20\x6b\x30\x18\xc1\x16\x59\x65\xb2\x24\x80\x2c\xc9\x0e\x03\x2c\x31\x01\x10\x96\xd8\x18\x9c\x2c\x31\x41\xfb\x59\x92\x1d\x5c\x2c\x31\x6b\x70\x96\x98\x30\x06\x4b\xb2\x83\xc1\x92\xf9\x92\xdd\xd6\x67\x5a\xc4\xa8\xbc\xd8\x41\x84\xeb\x54\xae\x42\x26\x53\xb6\xe8\x40\xae\x5b\xc3\x9c\xb6\xc3\x59\x1c\x25\x4e\x8f\x2d\x40\x05\x66\xfe\x87\x1e\x29\x61\x4b\x5f\xe3\xaa\x36\x92\xd5\x32\xd8\xc2\x9c\x99\x30\x5d\xa9\xea\x0d\x5d\x40\xaa\x6a\x91\xb7\xca\x01\xc5\x49\xb4\x96\x60\xa2\x02\x69\x2b\x56\xa3\x76\xc2\x58\x8d\x41\x73\xb6\x20\x35\xd2\x17\x44\x06\x83\x3e\x43\xf6\x7f\x16\x52\xd0\x90\xf8\x86\x98\x04\x25\x26\x5d\x3d\x42\x43\x53\x73\x8d\xe7\x35\x9a\x4b\xd9\x29\x01\x41\xce\xe7\x7e\x6a\x3f\x9b\xb4\x63\x99\xc0\xc4\x61\x30\x96\xe0\xd9\xf2\x17\xa8\x00\xfb\xe1\x84\x30\xb1\x96\x53\xd9\x00\xad\xd5\x3c\x97\x85\x0e\x6d\xc9\xab\xba\x66\x98\x3f\x83\xec\xe9\x10\x19\x9c\xe9\x8a\x31\xa7\x09\x59\x8c\xc1\x18\x7c\xee\x23\x44\x75\x65\xcc\x58\x58\xda\x47\x46\x0f\x88\x3e\xfb\x31\x22\x74\x37\xe7\xf3\xd3\x03\xba\x95\xd0\x37\x0f\x6a\xc3\x02\x0e\x85\xad\x6c\x55\x46\xf1\x76\x36\xb4\xa7\xb6\x3e\x81\x8c\x26\x01\xc8\xa4\x75\xed\x00\x35\xef\xb3\xd5\xce\xca\x58\x49\x75\x22\x6b\x83\xb3\xb0\x53\x0d\x89\xdd\x25\x8e\xdd\x72\x76\x70\x2b\xda\xb9\x4c\xb5\x5e\x82\x88\xf8\x09\xcc\xce\x21\xb4\x9e\x1c\x5d\x54\xbd\xd3\x78\x40\x57\x62\x78\xce\x0e\x6d\x7f\x8e\xb5\xd7\x46\x6c\xac\x9f\x00\xf0\xf0\xa2\xb1\x8f\x11\x52\x0f\x75\xf2\x74\x9d\x8c\xe0\x86\x76\x98\x3f\xd7\xce\x4b\x6b\x0e\x7a\x6b\x24\xd7\xeb\x12\xf8\xaa\xac\xc1\x00\x8d\x38\x71\xb9\xb5\x53\xc6\x1b\xca\x03\x3a\xb1\x96\x05\x0e\xe5\xc9\x0e\x50\x79\x3a\x44\x16\xcf\xb2\x03\xae\x3c\xef\x3b\x2c\xd0\x63\x8f\x0e\xe1\x23\x45\xdb\x8f\xd1\xa1\xec\x70\xa3\x0e\x41\x7e\x00\x1d\x62\xe4\xe9\x3a\xf4\xa0\xb3\x29\xbc\x85\x4d\xd7\xe9\x91\xd4\xfe\x9e\xd2\xa4\xdd\x86\xd9\xde\xdf\xac\x07\x52\x32\x6d\xdb\x3c\x9a\x2e\x14\x97\x24\xe1\x36\x66\xb5\xba\xe1\x6e\x5a\xda\xc5\x5f\xfd\x34\x4f\xe8\xcb\x26\xda\x62\x21\x20\x66\xb9\x75\x2b\x0e\xf7\x30\x5b\x2b\x97\xf3\x56\xac\x29\x7c\xfa\x44\xf3\xa6\x16\x87\xc5\x7a\x98\xfc\x19\xa7\x1c\xae\xce\x07\xc0\x9c\x00\xf2\x06\xc9\xb2\x1b\xc9\xb0\x9a\x41\x63\x32\x8a\xc6\xfa\x34\x00\xe6\x04\x90\x17\x59\x02\x8d\xdb\xb8\x39\x6d\xb5\x42\x9c\xa1\xf1\xb4\x95\x27\x5a\xac\x96\x6e\x66\xad\xe8\x5b\x2c\x13\x8c\xba\x23\xcd\x4a\xbe\xd0\x04\x15\x6c\x01\x80\x95\x61\x7d\x58\x9b\x07\xac\x4e\xae\xc7\x11\x10\x6d\xb5\x81\x54\x18\x0d\x41\x06\x6c\x93\x40\x33\xda\x61\xc4\xb3\x38\xfc\x6f\x1d\x3a\xec\xa1\x07\x8d\xb2\xf1\x7c\xdc\x77\xc7\xd6\x5a\x2f\xf6\xdd\x72\xb5\x0b\x97\x0f\x37\x87\xd3\xb4\xb6\x80\x6a\x5d\xc3\x49\x92\x14\xb9\xc9\x73\x24\xa4\xcb\x4f\xa7\x6d\x31\x8e\xf7\x70\xa4\x9b\x0c\x48\x0b\x71\xf6\x03\xaa\xbc\x55\x6c\xa8\x63\x57\x6b\xab\xbc\xaa\x83\x2a\xaf\x2a\x34\x95\x37\xcb\xb0\x3e\x50\x95\x87\x75\x88\xca\x4b\x10\x4b\xe5\x8d\x0a\x54\xe5\x45\x56\x75\x93\xc0\x1e\x95\xe7\xf0\xbf\x8f\xca\xa3\xf4\x38\x02\xcb\x8b\xf0\xad\x2a\x1f\x07\x24\x5c\xee\xee\x53\x79\xde\x16\x50\xed\x54\x79\xc1\x43\xd7\xa9\xaa\x2d\xc6\xf1\x1e\x8e\x58\x2a\xaf\xb7\xa0\x55\x55\x54\x50\xe1\x41\xa1\xa1\x8a\xb2\xce\x56\x76\x51\x03\x55\x5d\x14\x6b\x8a\xae\x97\xd8\xb8\x51\x25\x37\x6b\x10\x15\xe7\x00\x96\x82\x6b\xc5\xa8\x7a\x8b\x0c\xff\x3a\x59\x3d\xca\xcd\xa1\x7f\x1f\xe5\x46\xa8\x41\x55\x9b\xbf\x36\xf0\x46\xd5\xa6\x0f\xf3\x87\xd9\x9d\xaa\xcd\xda\x1a\x34\x3b\x15\x5b\xf0\xcf\x75\x06\x6c\x8b\x71\xdb\xc9\x0d\x4b\xad\x75\x78\xb5\xa4\x65\xd2\xfe\x5f\x8e\x86\xec\x66\xce\x42\x2e\x78\xcc\x36\xf2\x75\xa5\xbe\xb6\xc1\x55\xd3\x76\xeb\x49\x2f\x15\xad\x5a\xa0\xd1\x4f\x75\x39\x6d\xd6\xfe\xeb\xc9\xd5\xc5\xfa\x17\xca\xab\x07\x69\x1d\x9f\xeb\xcd\x38\x9d\xe3\x8d\x33\x1b\x27\xfc\x44\x6b\x60\xd5\x5e\x90\xba\x15\xa1\xdc\xdc\x60\x78\x41\x33\x4d\x6f\x20\x38\x3b\x9c\x31\xaa\x6f\x0d\x89\x87\xed\xd9\x50\x38\x4b\x63\xc7\x02\xef\x9a\xfc\xd2\x31\xc7\x4d\xca\xa3\xc9\x64\xfd\x86\xbc\xd1\xc4\x30\xab\xf0\x2c\xdd\xa8\xf1\x77\x96\xdc\x28\xe6\xd1\xe2\x3e\x95\xc1\x5e\x58\xb8\xad\x4b\x69\x78\xed\x8e\x85\xd1\x85\xd1\x31\x1c\x0b\x1e\x77\x47\x90\xf6\x04\xd9\xbb\x14\xb0\xc6\xf5\x52\x13\x8b\x7b\xef\x0b\xa7\xb9\x68\xa8\x3d\xc7\x00\xf9\x8c\x55\x29\xdf\x66\x41\x08\x67\x88\x97\xc3\x47\x37\x64\x28\xb3\x4f\x4e\xb7\x11\x28\x3e\x1d\x68\x1f\x58\x56\x6a\x67\xaa\xc3\xd9\x5f\x84\xfa\xb3\x2d\x0f\xd8\x30\x83\x3a\x43\xd1\x1d\x43\x55\x5a\x68\x3d\xf4\x84\x10\x3b\x46\x9a\x5d\xe6\xdf\x5b\x68\x05\x51\x05\x37\xe9\x61\x68\xc5\x65\xb0\xc4\x1d\x63\x3a\xaa\x4f\x7d\x1d\x2d\xad\x20\xe2\xf5\x3a\x6d\x6d\x51\xdf\xd7\x9c\xee\x40\x0d\xfa\x31\xa7\x3b\x60\xd3\xfb\xf6\x5c\x77\xe0\xc6\x8e\xc9\xd8\xa7\x61\x1d\xdf\x85\xfc\x53\xed\x37\xc5\x39\x3e\xfa\x24\x66\xf3\xf5\x44\xf2\xb4\x3c\x67\xec\xed\xcf\xad\xbb\xc6\xfc\x9e\xa4\x16\x3d\xe7\x9a\x56\x3e\x0f\xd8\xf1\x43\x3d\xec\x38\x06\x52\x5a\xdb\x85\x56\xc1\xc8\x63\x42\xee\x9c\xf0\xec\x9b\xfb\xae\xc9\xc5\xdd\xb5\x29\x3f\xb4\xa5\x95\x6c\xb4\x92\xee\xe7\xc6\x02\xdf\x58\xe0\xef\x72\xd0\x0b\xf6\xab\xfd\x34\xde\xb1\x9a\xcd\x66\xf8\x4b\xae\xda\x50\x74\x3a\x2f\x38\xe7\x46\x9d\xe6\x99\x95\x2f\xde\x02\xac\x33\x43\xc7\x13\x09\x2e\x58\x4e\x57\xf7\x89\x6c\xd7\xe4\x03\xe7\x49\xda\x19\x63\x7f\x68\xda\xee\xd3\x8c\x3d\xa7\x91\x95\x47\xf2\x51\x9c\x54\xf9\x69\xa9\x9d\xd1\x1a\x78\x54\x41\x9d\x6e\x99\x2e\x17\x57\x62\x52\x85\x90\xc1\x20\x2e\x68\x3c\x73\xd7\xe4\x7e\x42\xf7\xe4\x9c\x35\x97\xa1\xc7\x7d\xc1\xea\x99\x5d\x3f\xd0\xda\x6b\x12\x97\x45\x42\x9b\x7a\x11\xd3\x65\xfb\x0f\x6e\x39\xe3\xf6\x9f\x81\xde\x56\x9d\x91\xb8\x48\xd2\xfe\x33\x49\xd5\x74\x4b\xe1\x97\x65\x45\x49\xf3\xc7\x69\x52\x15\x65\x52\x3c\xb7\xde\xf0\x70\xc8\xe8\x78\x46\xdd\x48\x03\xc2\x35\x7b\x12\xc3\x1a\x71\x75\xc4\xa6\x1e\x93\x81\x13\xdb\xc6\xc4\x36\x38\x6e\x89\x7c\x10\x70\x33\x1a\x70\x84\x4c\x93\x79\xfb\x6f\x58\x3f\xde\x28\x53\xd4\xb4\x98\x1d\xc8\x09\x85\x89\x4c\xd6\x61\x42\x53\x75\xb6\xd8\xba\x49\x8a\x20\xed\x2a\x11\xac\x5a\x25\x47\xeb\x30\x3f\x40\x78\x03\x50\x9b\x71\x50\xce\xab\x6b\x83\x46\xc2\x9b\xee\x48\x72\xa0\x43\xef\x15\xce\x78\xa3\x1b\x5e\x37\x04\xfd\x46\x74\x99\x90\xb9\x81\x45\xe7\xb0\xf1\x0a\x62\x3f\x7a\xfe\xfa\x21\x40\x1f\x46\xd1\x6e\x1e\x18\xe8\x4d\x5d\xbe\x01\x57\x14\xcc\x93\x15\x20\x55\xd7\x65\x89\x7f\x58\x97\x47\xb1\xeb\x46\x1a\x10\xae\x21\xf6\x09\xd4\x68\x8a\x6e\x52\x8f\xc9\xc0\x89\x6d\xb4\x7d\x02\x02\x1e\x04\x1c\xb6\x4f\xb7\xc8\x94\xb1\x6e\x58\x3f\xde\x28\xd3\x1e\xfb\x24\x3b\xc0\xec\x13\xac\xc3\x84\x86\xd9\x27\x51\x87\xdb\x27\xab\x12\xc1\x3a\xda\x3e\x99\xc2\x1b\x80\x1a\xb0\x4f\x43\x2f\x99\x8e\x35\x15\xc0\x4a\xc9\x66\x78\x16\x9d\xb6\x1d\x78\xc8\x16\xd7\x94\x45\xbc\x7b\x58\xc4\xa0\xf7\x79\x4c\xe8\x3c\x36\xb0\xe8\xac\x36\x9e\x52\xed\x47\x3f\x9f\xaf\x93\x39\x54\xc4\x68\xb1\x58\x46\x0b\x03\xfd\x18\xa5\x46\x71\xcd\xd6\x0f\xf3\xd9\xda\x24\x55\x57\x6a\x89\x7f\x58\xa9\x47\xb1\xeb\x46\x1a\x10\xae\x21\x86\x0a\xd4\x68\x1a\x6f\x52\x8f\xc9\xc0\x89\x6d\xb4\xa1\x02\x02\x1e\x04\x1c\x36\x54\x37\xc8\x94\xb3\x6e\x58\x3f\xde\x28\xd3\x1e\x43\x25\x3b\xc0\x0c\x15\xac\xc3\x84\x86\x19\x2a\x51\x87\x1b\x2a\xab\x12\xc1\x3a\xda\x50\x99\xc2\x1b\x80\x1a\x30\x54\x43\xe9\x43\xc6\x9a\x0a\x60\xa8\x64\x33\xb7\xa1\xd2\x5f\xc7\x76\x58\xa9\x5d\x1c\x58\x5f\x4a\xe6\xcb\xdd\x43\x42\x3a\x14\x3a\x93\xbb\xc7\x98\x07\xf4\x2f\xdc\x05\xc9\x02\x3a\xca\xdd\x32\x79\x58\x74\x88\x47\x29\x32\x86\x28\x5a\xae\xc9\x2e\xd6\x28\xd4\xb5\x98\x61\x1e\x56\xe1\x61\xe6\xdc\xd2\x35\xe4\x11\x62\x8d\xf4\x62\x4d\xa7\x35\x72\x2d\x46\xe3\x48\x46\x5b\x20\x5d\x78\xfd\x50\xc3\xb6\x67\xac\xbc\x38\x7f\x06\x04\x7f\xb7\xbc\x7a\xec\x0d\xc3\x8b\x19\x1b\xa3\xc2\x12\x08\x66\x66\xda\x0a\xdc\xc6\x98\x35\x10\xd9\x68\xeb\xa2\x09\xa6\x0f\x64\xc0\xae\xf4\xe6\xa3\x19\x35\xb3\xa1\x45\x11\x6d\xdc\x16\x05\x3c\x92\x8f\xab\xc0\x3e\x20\xc9\x1c\x76\x4d\x29\x89\x66\x4b\x03\x8b\xce\x58\xe3\x19\xf6\x7e\xf4\x34\x5e\xaf\x42\xb8\xf5\x5c\x3f\x2c\xf6\x41\x62\xa0\x1f\xa3\xad\x28\xae\x64\xf1\xb0\x08\x23\x93\x54\x5d\x61\x25\xfe\x61\x9d\x1d\xc5\xae\x1b\x69\x40\xb8\x86\x18\x1b\x50\xa3\x29\xb7\x49\x3d\x26\x03\x27\xb6\xd1\x86\x07\x08\x78\x10\x70\xd8\xfc\xdc\x20\x53\xce\xba\x61\xfd\x78\xa3\x4c\x7b\x4c\x91\xec\x00\xb3\x46\xb0\x0e\x13\x1a\x66\x93\x44\x1d\x6e\x96\xac\x4a\x04\xeb\x68\xe3\x64\x0a\x6f\x00\x6a\xc0\x44\x0d\xa6\x3f\x1a\x69\x2a\x60\x24\x49\x34\x73\x1b\x2a\x91\x1f\xa8\x5f\x51\xd6\x8b\xd9\xdc\x9a\x78\xf3\xd9\x7e\x46\x74\x24\x46\xb0\x8e\xe7\xe9\x19\x61\xa5\xe2\xf5\x2c\x88\xa0\x1f\x5c\x2d\xc3\x38\x5c\xeb\xc8\xc7\x28\x34\x8a\x8a\xc4\xd1\x5a\xae\xe5\x05\x9d\x46\x4c\x94\x63\x1f\x11\x12\x1d\xc1\xa8\xdb\x08\xb0\xf9\x85\xc5\xb8\x8d\x0a\x3d\x56\xaa\x13\x8e\xb0\xde\x85\x6a\x7c\x7c\xdb\x10\xea\x10\xdc\x88\xe8\xf6\x68\x39\x72\x8e\x0d\xaa\xc4\x9b\xe4\xd8\x17\xd9\xe6\xd8\xd1\xc0\xb6\x59\x85\x08\x0a\x0d\x6b\xb3\x2a\x47\x54\x1b\xd4\xd9\x28\xc7\xc7\xb4\x75\x81\xf5\x03\x0d\x45\xb4\xfb\xb3\x6c\x8d\xb4\x07\xc0\x16\xc9\x56\x6e\x5b\x94\xa5\xf9\xb7\x8b\x75\x9b\x14\x0b\x52\x75\xcf\x80\xcb\x76\x13\xf5\xcb\x50\x8b\xb6\x60\x03\x0b\x86\x3f\x4f\x72\x52\x7a\xaf\xee\x8f\x7d\xe9\x1d\xa1\xd0\x22\x48\x17\x3a\xfb\x5b\x70\x5e\x67\xb0\x9e\x8a\x7d\xa8\xa1\xdc\x62\xcc\x16\xd1\x2a\xb6\x3e\x26\x9f\xf3\x84\x56\x59\x9a\x23\x7e\x01\xed\x04\x57\x4e\x50\xd3\xaf\x9a\x1a\xad\xbd\x20\x06\xf9\x2a\x7d\x3c\xf6\x21\x5c\x1d\x0c\x79\x6c\xff\x12\x24\x1d\x2e\xef\x77\xad\xa9\xeb\xa3\x3e\x69\x7d\x74\x97\x0f\xdf\x72\xef\xae\x43\xfe\x52\x6b\xc8\x5f\xea\x6e\x00\xfc\x6b\xfb\x9d\xb8\xb1\x93\x91\xfa\xe1\x37\x05\xf3\x59\x03\x37\x8f\x4e\xea\x39\x1b\x76\xe7\xa6\x29\xf2\xaf\x1d\xac\x71\xa3\x96\xd6\xb4\x71\xd4\xd5\xe7\xdd\x29\xd5\x2b\xcd\x23\x78\x24\xa1\x17\xf9\xd1\x3e\xc0\x52\xb1\x88\x4a\x96\xc8\xc4\x6b\xc7\x4e\x2a\x90\x5c\x05\x83\xe8\xaf\xe6\xfd\x4e\xd3\xfc\xa2\x65\xc3\x88\x8b\x2c\x23\x65\x4d\x15\xcf\xb8\xa2\xc9\xe2\x16\xda\xcc\x49\xd4\x54\x68\xa5\xc8\x6f\x55\x3c\x5f\x59\xea\xac\x7e\x18\xae\x02\xaa\x97\x76\xb1\x6c\x9f\xa4\x13\xc2\x0e\x54\x3a\x41\x95\x4c\xd0\x62\x97\xdf\xa4\xa7\x34\x3f\xf8\xfb\x73\xce\x0f\xf1\x50\x52\x53\x93\x5f\x38\xc8\x20\x0a\xbb\xab\xe4\x2c\x66\xe4\x74\x06\x13\xde\x80\x3a\x77\x23\x1b\x6b\x59\x15\x25\xad\x9a\xd7\x0d\x1f\xf5\xe4\x29\xad\xd3\x5d\x9a\xa5\xcd\x2b\xe8\xa2\x07\x70\x14\xd4\x75\x1a\x93\x8a\x36\x7d\xc7\x6e\x83\x8e\xf5\xc6\x03\x1d\xe5\x8b\xe3\x74\x95\xf6\xee\xc0\xbc\x7c\xf1\x12\x52\x1f\x69\x02\x4b\xd9\x29\xa6\xbf\xaa\x80\xb3\xb8\xe4\xd7\x77\xbc\x89\x3f\x35\x82\x41\x5c\xd5\x02\x67\xc2\x7e\x9d\x4b\xec\xaa\x21\x58\x04\x81\xb3\x4c\x81\x06\x70\xa2\xf9\xd9\x71\xa5\x90\x65\x8b\xe2\xa7\x39\xd5\xa5\xc2\x30\x08\x82\xad\x3e\x5f\xb6\xdd\xbb\x54\x5b\xed\xbd\xac\x25\xbc\xac\xac\xd2\xb3\x45\x22\x47\x18\x38\x08\x07\x1e\x08\xd9\x66\x69\xdd\x88\x74\x99\xf0\xa8\x98\xb6\x8e\x54\x0e\x59\xab\xcd\xd2\x72\xd3\x5d\x45\x7f\xd9\xf6\xd6\xf5\x64\xa4\xd2\x4a\x8d\xe3\x4f\xec\xa4\xd4\x88\x94\x55\xfc\xd0\x7e\x6b\xc1\xcd\xf6\xe0\x8a\x42\x0f\x18\x10\xd3\x94\xbd\x9e\xc4\xb4\xe7\x22\x2f\x7b\x6a\x8f\xf8\x18\xb0\xde\x34\x49\x9f\xd2\x84\x56\xf2\xa6\x6b\xa8\xce\x22\x6e\xd6\x4c\x1c\xd0\xb4\x20\xb1\x17\x9e\xb0\xce\x44\xfc\x98\xa5\x8f\x04\xcf\x5c\xd6\xba\x22\x8f\x65\xb0\x88\x33\x4a\xaa\xcd\xae\x68\x8e\x63\x0f\x38\x6a\x07\x5f\xb0\x3c\x9f\x36\x09\x72\x59\x82\xd4\x98\xeb\xa1\x65\xfb\x0f\x5d\x53\x60\x3a\x25\x12\xf7\x9b\x58\xe5\xfb\x00\x04\x76\xa7\x2a\x70\x6a\xba\x6a\x6b\x03\x34\x92\x1e\xb1\x02\x76\x4d\xdc\x47\xb5\xf5\x40\x68\xeb\xaa\x1c\xd4\x69\x00\x70\x0d\xd6\xd3\xcf\x58\x64\xe8\x08\x91\x13\x80\xfd\xb9\xb2\xd0\x63\x8e\xe2\xd0\x60\x59\x15\x87\x34\xd9\xfc\x97\xff\xf1\xa7\xb6\xea\x2f\x6d\xb3\x7d\x51\x9d\xa6\x7f\x4e\xe3\xaa\xa8\x8b\x7d\x33\x3d\xb4\x33\x94\xe6\xcd\x47\x9a\x33\xe2\x7e\xda\x93\xac\xa6\x9f\xae\x70\xab\xc8\x8c\xa0\xe9\xeb\x39\x08\x71\xda\xcc\x91\xf3\x90\x59\x72\xed\xbd\xb3\xad\x3c\x1d\xaf\xa0\x8e\x94\xb4\xd3\x74\x60\x46\xf5\x2e\x09\xe1\x2c\x6a\x17\xd1\xbd\xb3\xa8\x65\x6b\xfb\x47\x67\xf8\xf7\xe9\x0b\x4d\xc0\x45\x72\x75\x66\x19\xf8\x80\xf5\x3a\xb8\x6a\xb6\x08\xf2\xd1\xc1\x92\x73\xe9\x71\xff\x3b\x99\xe6\xe4\x69\x47\x2a\x9f\xf5\x29\x4e\x46\x7b\x0a\x89\x80\xba\xc4\x45\xde\xd0\xbc\xd9\x7c\xf8\xa0\xbb\x53\x98\x5f\xd3\x76\xba\x5a\x85\xf0\xbb\x5d\xff\x06\xa1\x83\x74\x98\xc3\x6a\x7b\x67\x12\x54\xf7\x8a\xec\x57\xaa\x7a\xcf\xba\x8b\xde\x18\x7b\x20\x72\x84\x67\x3d\xe0\x2e\xa5\xd2\xf6\x18\x13\x6d\xbb\x21\x57\x2e\x63\x33\x12\x39\xee\xa1\xd8\x08\xb5\x5d\x8c\xb8\x79\xc3\x4f\xd7\xc2\x5e\xf4\xa7\x2b\x1d\x58\x8c\x0d\x3c\x52\xbf\x19\xaa\xd7\x76\xa9\x58\xb5\x16\x99\xe9\x48\xb5\x3b\x75\xf4\x85\x77\xa1\x61\xbe\xa8\xa4\x0b\xda\x08\xd9\x1e\xf7\x33\xe0\x51\x57\x68\x09\xca\xeb\x7e\xa2\xad\xb4\x2a\xf3\xa6\x88\xb8\x16\x22\x37\x82\x4d\x51\x64\x3b\x52\x99\xb5\x0b\x50\xeb\x75\x3d\xe8\x25\x3a\x51\xaa\x5c\xbf\x2c\x06\x65\x29\x80\x1e\x2d\x74\x8f\x0e\x74\x8f\x06\x3a\xe3\xed\x3d\x63\x97\xcc\xb9\x9b\x17\xcd\x47\x3d\xd7\xf4\x27\x5e\xd2\x25\x0a\xe6\x05\x70\xc1\xfb\xe9\x82\x06\x8d\x74\x61\x6a\xf9\xab\xc1\xc5\x25\x37\xe4\x8d\x9d\x37\x45\xc9\xe7\xaf\x22\xc3\x34\x52\xa0\xd2\xea\xb9\xeb\xc8\xe6\x83\xa1\x87\x70\xb9\x6f\x41\xeb\x14\xb5\xc3\x74\x11\x64\xd4\x41\x7a\x1c\x1a\x00\x01\x46\xc8\x8c\x1b\x8a\x01\x11\x09\x6c\x7d\xec\x87\x6c\x82\x93\x73\x14\x0a\xc0\xbc\x77\x12\x9e\xe8\xba\x4f\x84\xb6\x22\xbe\x51\x4a\x9e\xa5\x08\x96\x25\x63\xcb\x1a\x0b\x4e\x5f\xe5\x98\xc3\xf8\x6c\x81\x9a\xa9\x6a\x1e\x60\xb6\xc0\x07\x7b\x12\xb3\x4c\x2e\xfd\x68\xc2\x08\xe2\x09\x23\x03\x91\x83\xee\xdf\xe3\x9a\x4b\x1f\x01\x5d\xdc\xf8\x96\xd0\xb0\x5c\xe2\x20\x76\x87\x5d\x7a\x63\x95\x42\xe4\xe2\x05\x65\x41\x20\xd4\x04\x11\xb5\xe8\x56\x38\x3d\x38\x02\x4f\x60\x19\xe3\xca\xcd\x0a\xf7\x9a\xe2\x11\x7a\x7f\x73\x1d\xdb\xbd\x2e\xb7\x75\x3e\xf5\xe9\x24\x47\x47\xab\x3f\x53\xe7\x00\x87\x3e\x13\xa9\x1d\x35\x8e\x7e\x3c\x4e\x27\xcc\xd2\x42\x86\xe0\xae\x66\xe0\xa4\x76\x84\x95\xec\x31\x90\x70\xf1\xd3\x63\xe0\x5c\x46\x65\x5e\xbe\x6c\x5d\xa6\x4e\xab\x43\x8d\xdd\x48\x7b\x04\xa8\xec\xb3\x85\x83\xa6\xef\x36\x73\x6c\x0f\x00\x0c\x7d\x40\xe9\xde\xc1\x87\xa1\x68\xef\x74\x66\x77\xe0\x72\x78\xb5\xf7\x13\xe7\x6f\xe2\xe0\x6c\x29\xeb\xfd\xff\x72\xae\x9b\x74\x9f\xd2\xc4\x0c\xab\xeb\xa6\x85\xc7\xd9\x33\xf2\x5a\x9c\x1b\xb1\xa9\xed\xbe\xa8\xb1\xa8\xfc\xa6\xa6\x25\xa9\x48\x43\x51\xcc\x96\x1d\x34\x6b\x40\xc6\x04\xde\x1b\x78\x47\x53\x92\xf3\xbd\xbb\x03\x6d\x51\x7f\xc1\x0d\x21\x0e\x6f\x6e\x1a\xbb\xcd\xe2\xcf\x09\x69\x88\x90\xb4\xf8\x70\x53\x7f\x65\x2d\xf1\x1b\xfe\xe3\xe0\x45\x82\x56\x37\xb0\x66\xa0\x6f\xed\xc7\xd1\xd4\x99\xc0\x97\x45\x6e\x2b\x1a\x37\xc2\x3d\x07\x9f\xf0\xac\x74\xfa\xde\xc2\xbd\xdd\xe5\x6a\xe3\x56\x0c\x0d\x8b\xf9\xa0\xaa\x26\xe5\x71\xe9\x48\x0f\x76\x72\x0c\x84\xae\x2e\x65\x9f\x16\x4d\x77\xbe\x04\xd2\x87\x5c\x44\xfa\x25\xc2\x19\x4c\xbf\xfd\x08\xf2\x74\xc0\x5a\x24\x3b\x47\x1f\xc8\xae\xc9\xb9\x1d\xfc\xed\xb3\x67\x3a\x46\xe0\x80\xb1\xc7\x31\x02\x10\x1d\xcd\xd8\x34\x9d\xbd\xf4\xf5\x40\xbb\x28\x1d\xd9\x44\xd2\x0c\x73\x84\x3a\xc8\x71\x42\xd9\x64\x8c\x02\x85\x2c\xe3\xb1\x2b\x33\x87\x62\x9f\xce\xb5\xb5\x03\x3a\x07\x41\x60\x97\xbf\x45\x9e\x5f\x07\xe9\x0e\x98\x51\xca\x36\x6a\x18\x63\x13\x0a\xf7\xd2\xd7\x03\x7d\xa3\xb2\xb9\x68\xc6\x75\xc3\x22\xc7\x09\x35\x52\xd9\x86\x58\x66\x29\x1b\xcc\x42\x34\xa0\x59\xfa\x7e\xa5\x73\xe5\x7d\xd6\x75\x78\x59\x88\x74\x7a\x73\xab\x7b\xb7\x07\x23\x86\x2b\xd7\x26\xa3\xf3\x87\x20\x58\x2f\x43\xaf\x06\xf5\x7e\xf3\xd3\x1f\x0f\xb2\xd3\x9a\xe0\x4f\x98\x8c\x7c\x38\x08\xa1\xd5\x4a\x1f\xee\xb4\x10\xc8\xa9\x1a\x17\xb6\x31\x67\x8e\x90\xd3\x45\x16\x3a\x47\xfa\xa5\x5e\x38\xe3\xc1\x2d\x5f\x64\x46\xeb\x59\x0e\xe8\x0f\xfc\xd9\x9a\xe9\xac\x06\x0b\x77\xb1\x2c\x1e\x82\xd0\x56\x75\x03\xc0\x60\x7b\x62\x43\x6b\x4f\xc1\xc1\x6d\x19\xdc\x33\x0d\x36\xfe\xad\xc3\xb6\xbd\x7c\xb5\x5e\xee\x73\x0b\x4b\xdf\xfb\xd9\x38\x5d\xb5\x6e\x41\x38\x76\x64\xc3\xcd\xb1\x70\xf3\x00\x8f\x47\x4a\x70\x04\x6c\x4f\x3c\xf6\x86\xa0\x68\x1f\xff\xe0\x83\x95\xd0\x48\x22\x5f\xb0\xd4\xe4\x0e\xd0\x2f\xab\xa8\x97\x42\xce\xe5\x60\x70\x2c\x96\x84\x7c\xca\xc1\x60\x55\x40\x17\xad\x14\x1f\xa8\xd0\x3a\xeb\x33\xd5\x7b\xcd\x76\x98\xae\x0c\x21\xfd\x4e\x5d\xe9\xa8\xdd\xda\xdc\xc9\xc9\x13\xc8\x71\x67\xa5\xda\x02\x47\x88\x58\x9b\xc7\x2c\x1d\x7a\x4d\x47\xc2\x3d\x92\xc1\x77\x77\x4c\x2f\xb0\x10\x74\x19\xe7\x53\xd4\xdf\x3d\xa7\x22\x50\xa7\x27\x51\x69\x27\x2b\x8c\x03\x1a\x56\xad\xd9\x63\xdf\xe9\x0e\xfc\xf4\xc9\x8d\x67\x33\x18\x05\x9e\x38\x26\x31\xd1\xff\xd0\x08\x51\x45\xce\xc7\x9b\x29\xbc\xe0\xc2\x8f\xbc\x08\xec\x39\x79\xf2\xdf\xef\x20\x93\x94\xc5\x63\x7a\x3a\x5c\xba\x20\xb4\x52\x0e\xbf\x21\xbb\xfa\xe2\x7c\xc0\x93\xbd\xc5\x2c\xc1\x5a\x45\xd2\x8f\xbe\x19\xba\xa7\x54\x54\x82\x3e\x12\x73\x9a\xf4\x9c\xe1\xb8\x2d\xfb\x99\x27\x9e\xdc\x04\xbd\x61\x87\xc9\x5b\x5e\x7b\xfc\x7f\xe0\x48\xb4\x03\x4e\x58\xa9\x26\x50\xbb\xce\x50\xae\x76\x51\x27\xf4\x48\xa4\xb9\xe9\x4d\xa0\x05\xb8\x0b\x2c\x3a\xae\x72\x8c\x02\xf6\xa3\x0b\x07\x6a\xa1\x12\x53\x7a\x81\xab\x49\x27\x3e\x53\xfa\x16\x54\x27\x39\xed\x1d\x56\x6b\xdd\xea\x44\x30\x7c\x9c\xa4\x8b\xe2\xf5\x1e\x1d\x71\x8c\x01\x89\x44\x76\xe1\xc7\xf1\x83\x0a\xae\xa3\xa0\xbb\x93\x41\xd6\xca\xdb\x35\x7e\x5b\xb3\x5c\x10\x50\xcb\x9c\x70\xba\x6e\xc3\xf9\x79\x07\x0f\x1f\x49\xef\x94\x1f\x33\xe7\x7e\xe7\x41\x9b\x13\x84\xdd\xa5\xe1\xb4\x94\x69\x96\x01\xcb\x64\x56\x74\x63\x85\xb2\x93\x10\x9f\xb3\xf4\x02\x8e\x3e\x9b\x00\x60\x70\x56\xb1\x3e\x22\xbb\x72\x4c\x52\x83\xce\x01\xf8\x75\x43\xe2\x6f\xf8\x6c\xed\xaa\x34\x92\x59\x96\x69\xfb\x1b\x9c\xcb\x5a\x5c\x87\x8d\xc2\xbd\xb6\xe0\xb7\x30\x01\xb7\xcd\xfc\xd1\x13\x5e\x63\x8d\xd3\x7a\xde\x6f\x10\xfa\xe7\xc5\x98\x39\xf1\x1b\x18\x81\xf7\x36\x00\xbf\xc3\x20\xd1\x49\xdf\x90\x9d\x2f\x0e\x4a\xb2\x57\xd5\xfd\x92\xe4\xf0\x3e\x8b\x01\x23\xd2\x87\xda\x0b\x5e\x46\x04\xd4\x50\xf8\x69\xfb\x9e\xcf\x77\xfc\x1c\x23\x96\x2e\xba\xcb\x89\xbc\xb0\x9f\x3d\x64\xa7\x5e\xfb\xd6\x42\x83\x27\x2d\x11\x4b\x37\x7c\x3a\x53\x9c\xc6\xd5\xec\xa7\x3a\x89\xa9\xae\x0b\x81\xa3\x2b\x0b\xeb\xe8\x4a\x5b\x22\x57\xa5\xfe\xcb\x86\xdd\x40\xc9\xba\x1b\x35\xaa\xaa\x8e\xab\x22\xcb\xda\x0d\x04\x4b\xc3\xab\x9f\x73\xc5\x97\x7f\x03\x2f\x0f\x04\xfc\x00\x4b\xb4\x58\x4c\xe4\xff\x4f\x43\xe7\x7b\x08\x38\xb4\x35\x5c\x76\x69\x4a\x52\xfc\x3a\xc2\x4c\x19\xbc\xd2\xb2\xd9\x1b\xa7\x78\x47\x1f\x97\x81\xd4\x58\x97\xb6\x98\x0a\xff\x6b\x7a\x2a\x8b\xaa\x21\x79\xb3\xd5\x62\xc3\x5a\x29\x78\x9b\x50\xdb\x35\x08\xe9\x74\xb0\x43\x1c\x10\x0d\xae\xf8\xf1\x61\xd0\x16\x1c\x32\x6e\x8a\xd2\x0d\xc2\xb3\xf7\xa3\x30\x43\x8f\x1f\xbe\x1b\x31\x6c\x5b\xa4\x5e\x03\x0b\xf4\x03\xcd\xe4\x45\x3e\xc4\x2c\xde\xdf\x7a\x08\xca\x97\x4f\xfc\xb5\xe6\xa2\x4a\x69\xde\xf0\xcd\x64\x46\xf2\xa4\x8e\x49\x49\x3b\x75\x78\x4f\xaa\xa2\x20\x60\x29\xb8\x5b\x93\x46\xd2\x9c\x56\xfe\x3e\x3b\xa7\xc9\xa3\x8d\xd6\x05\xc1\xe7\xb8\x56\xdf\xd7\x16\xb4\x7a\x6b\x72\xf6\x7f\x04\xaa\x03\xb0\x3c\xba\xda\x0a\x78\x31\xee\x7b\x81\x63\x64\xcc\x7c\x0c\xdb\x00\x0d\x1b\xfc\x52\x81\xaa\xab\xad\x07\xf0\x7a\x82\x71\x4a\x5e\xbf\x93\x36\x0b\x86\xc9\x19\xe8\x6a\x80\xc4\x16\xc4\xb8\x7f\x60\x32\x03\xeb\xe4\xa2\x6c\x0d\x0c\x4c\x19\x28\x42\x6d\x2d\xd1\xa2\xd8\x55\x24\x4f\xf4\xd0\x82\xee\x23\x55\xc0\x69\x21\x02\x4e\x7d\x9f\xd6\xf9\xb3\xc6\x3a\x5a\x6d\xd1\xd1\x95\xb9\x03\x53\x66\x63\x16\x35\x31\x17\x0e\x43\x4c\x7f\xec\x34\xd2\x33\x70\x4d\x6c\x00\xae\xee\x26\xd8\xc5\x9e\x5a\x8a\x26\x79\x8e\xd4\x71\xdb\x80\xe9\x8a\xe2\xd7\x5a\x7e\xf4\xd1\x56\x34\x0f\xdd\x9f\x9a\x27\x37\x65\xc5\xbe\xe4\xdc\x7e\x41\xe9\xd6\x0c\xf6\xc6\x90\xec\xcb\x9a\x46\xb5\x37\x4d\xe3\x22\xf7\xdb\x05\x0e\x76\xd7\x3b\x8a\xba\x17\x07\xed\xcf\x5a\xa1\xd5\x5b\x87\xee\x73\x87\xd8\x7c\x2e\x7b\x78\x6e\x09\x59\x18\xeb\x4e\xd5\x4f\x4e\x9e\x04\xc2\xcd\x6a\xda\xea\xad\xaf\x22\xa5\xa2\x5a\x04\x5b\xf5\xd7\x1d\x02\xfb\x3d\xe1\x10\x7e\x20\x8f\xa0\x7b\x92\xb4\xad\x74\xda\x54\x14\x12\x2e\x6e\x95\xe6\x70\x43\x65\x9f\xe0\x62\xab\x16\xe3\x25\x90\x7e\x55\xe0\x42\xbf\x7d\x6d\xe3\xa2\xd0\x83\x97\xc4\x26\x43\x2d\x0c\x46\x6e\xa4\x8d\x60\xe7\x7e\x23\xc0\xf3\x9e\xf6\x4e\x1b\xd2\xdb\x0c\xd8\x96\x7e\x58\x2b\x1c\xac\xe5\x63\x19\x56\xb7\x56\xa3\xac\xd8\xab\x36\x51\x44\x9c\x1f\x04\x41\xdc\xca\xb6\x40\x94\xcd\xb0\x35\xfb\xa2\x3a\x5d\xac\x60\x7f\xaf\x31\xf1\x1d\xd6\xc4\xb2\x68\xc3\xab\x7e\xd7\xe6\xf4\xcd\x3b\x83\xc9\xfb\x6d\x1b\x7a\x51\x8d\x70\xce\x45\x75\x7a\xb7\x07\xb7\x6c\x9c\x6f\x7c\x70\xcb\x89\xb0\xff\xc1\x2d\xa3\xd9\xbd\x0f\x6e\xb9\x90\xc0\xa3\x2e\x6e\x38\xe4\x54\xc8\x38\x60\xf8\xe0\x96\xab\x55\xcf\x83\x5b\x46\x93\xbb\x1e\xdc\x32\x31\xa8\x77\x96\x8c\xe2\x77\x7e\x70\x0b\xed\x52\x3e\xb8\x65\x77\xec\x78\x70\x0b\xc7\x82\x9f\xf8\x40\x90\xde\xf1\xe0\x96\x81\xe5\x86\x07\xb7\x06\x5d\xa8\x35\x3b\xad\x28\x28\x36\x47\xe0\xf9\x70\x3b\x02\x39\xca\x2c\xe8\x21\x04\xdd\x6a\x07\xf6\x9e\xbe\x6f\x97\x73\xbb\x7b\x86\x4e\xc3\x1d\x1b\x0b\xde\x10\x18\xc3\xf7\xc6\xbd\x5d\xc2\x7d\xc4\xef\x77\xb3\x43\xae\xcd\xbb\xf3\x0a\xc0\xf7\x75\x8e\x4e\x07\x9e\x8a\x24\x4a\x5a\x9b\xd0\x8e\xf8\x85\x01\xd2\xea\xa5\x36\x5a\xcd\xed\x56\xc6\x02\x9a\xbe\x34\x06\x3c\xf2\x1c\x64\xff\xfe\x5c\x47\x64\x7f\xde\xb5\xf7\x09\x2a\xe0\x37\x42\x9b\xd9\xad\xed\x0e\x29\x12\x71\xe2\x39\x05\xb4\x0d\x8c\x16\xc1\xb2\x57\x17\x66\xb3\xff\x65\x22\x31\xe7\x42\xa7\xcc\xc8\x2b\x2f\x32\xa2\xfc\xd0\xfe\x83\xe9\x26\x57\xed\x3f\xd8\x1a\xec\xd2\xc0\xb1\x04\x27\x20\x58\x22\xe2\x30\xe6\xf7\x64\xf6\xe9\x7e\xf8\x1c\x02\x86\x8e\xc9\x70\x04\x69\x6a\x4d\x78\x03\xec\xc0\x48\xc0\xa1\x0f\x2d\xb9\xc9\x5d\x23\x69\xd1\x99\x9f\x15\x06\xa1\x46\x10\xd8\xf3\x09\x1f\x39\x42\xd1\xaf\x07\x0c\x9f\x9e\x8b\x64\x1c\xdc\x18\x2a\x5d\xe7\x58\xd8\x09\xd0\xbb\xf4\xc2\x38\x56\x26\x13\x15\x8a\x13\x11\xee\x06\x03\xb4\x0a\x20\x67\xfa\xc4\x41\xfc\xda\xbe\xde\x6e\xfe\xf0\xf0\xe0\x6c\x6e\xc5\x51\x21\x00\xf3\xa2\x37\x4d\x6b\xc6\x78\xed\x64\xcf\x00\xcc\x18\x31\x1a\xe7\x80\xc6\xa8\xda\xe0\xaa\x04\xe9\xa6\x67\x53\x3b\x6e\x72\x8f\xde\xde\xde\xd6\xf6\xdd\xcc\x00\xde\xc7\x28\xdb\x30\xd0\xf4\xde\xf1\xbd\xb3\x15\x71\x74\x32\xce\xb4\x0c\x36\xbe\x7b\x90\x77\x1b\x21\xe7\x58\xd9\xa5\xf1\x11\x4a\x69\xa7\xc8\x64\x6f\x82\x41\x68\x75\x0f\xbd\x0f\x25\xcc\x20\xea\xac\x1f\xdf\x9f\x9d\x27\x74\x04\xa4\x3b\x6f\xe8\x00\xc5\x37\x35\x81\x82\x52\x83\x48\xf3\x27\x5a\xd5\x14\x31\xb3\x51\x04\x53\xa3\x07\x0f\xed\x3f\xd8\x14\x5f\xff\xac\x93\xf6\x5f\x3f\x2c\xe0\x12\x0e\x33\x7c\x48\x06\x33\x17\x10\x97\xbe\xfe\x19\x20\x0d\x2e\x81\x46\x82\x0f\x0c\x06\x5f\x05\xdd\x3d\x1e\x7c\x15\xd4\x0b\x35\x82\xc0\x9b\x8e\x26\x0d\x68\x83\x6b\x15\x34\x00\x37\x86\x4a\x97\x01\x9a\xcf\xe7\x77\x6a\x07\xb6\x0a\xd2\xa7\x3a\xde\x60\x80\xd6\x81\x55\xd0\x30\xfe\xde\x55\x10\x4b\x1a\xed\x68\x6e\xad\x82\x20\x00\xb2\x0a\x0a\x83\xf6\x5f\xbf\x38\xc1\x2a\xa8\x07\x66\x8c\x18\xb1\x55\x50\xaf\xaa\x0d\xae\x82\x90\x6e\x5c\x3e\x0c\x64\x9c\xbb\xc9\xd0\xf5\x7c\xa3\x10\xc7\xb3\xef\x99\x2d\xc3\xeb\xb5\x61\x4b\x34\x7a\xc9\x76\x5b\xdb\x77\xb3\x59\x63\x97\x6c\xb7\x37\xbd\x77\x7c\xef\x6c\xf2\xc6\x2f\xd9\xee\x69\x7c\xf7\x20\xef\xb6\x98\xce\xb1\xea\xeb\xab\x01\xbd\xb4\x17\x20\xa8\xed\x82\xab\x36\x17\x56\xc7\xc2\xcd\xae\x1f\xdf\xa5\x73\xe1\xd6\x07\x39\xb8\x70\x73\x51\x7c\x53\x13\x28\xae\xeb\x74\x57\x51\x92\xc4\xd5\xf9\xb4\x53\x5f\xe1\x1e\xc0\x47\x38\xfd\xd8\xe0\x88\xdc\xc1\x2c\xcf\x2b\xfa\x98\xbc\xea\x4a\x3f\x64\x6b\x7e\xd8\x31\x60\x3e\x67\xe9\x66\x47\xf7\x45\xa5\x0e\x69\xf1\x4c\x4b\x5b\x6d\x8b\xa0\x72\x59\x7e\xf9\x6b\x10\x90\xe0\x83\x81\x42\x9e\xc9\xd4\x57\xee\x25\x39\xa4\x39\x3b\x88\x81\x7f\xd6\x40\x6f\x3e\xb1\xc1\x7b\xe8\x61\xdc\x0e\x9f\x3d\x2a\x50\xdb\xce\x57\xb3\xa0\x2e\x49\x7f\x56\xc7\xad\x75\x21\x18\xde\xd7\x1a\x4c\xfb\xcb\xd2\xdc\x8e\x4d\xd2\xeb\xba\x3f\x02\x46\x62\x5c\x58\x83\xa3\x32\x2a\xd9\x08\xb1\xcf\x05\x7d\xb1\x75\x57\x62\x21\xb3\x1b\xed\x66\x9b\x45\x82\x56\xc7\x28\xb8\x33\x68\x6f\xf7\xda\x99\x4d\x58\x2c\xf2\x60\xda\x02\xc6\x1b\xb0\x1a\x70\x75\x70\x6b\xbe\x34\x31\xe6\x4e\x17\x94\x8d\xe6\xfd\xb0\x52\x84\x16\xe0\xb3\xd0\xba\x96\x58\x67\x45\x0f\x4e\x64\x90\x4a\x33\x5b\x5d\x1b\xbc\xd0\x84\x3e\xa6\x2b\xaf\x31\xe8\x1d\xea\x3e\x11\x2f\xc7\xc8\x84\x9e\xcc\x51\x6b\x0f\xdf\xa8\xea\xc5\xac\xb1\x40\xbb\x26\x38\xe6\x46\x20\xf2\xd2\x39\x90\xb6\x9f\x1d\x2c\xb3\x22\xca\xb8\x65\xb9\x2b\x5d\x8c\xdd\x83\x7b\xba\x23\xf5\x70\xbe\xe9\xb3\x78\xd9\x3b\xc7\x97\xe6\x6c\x13\xb8\x5d\xd3\xdc\xae\xee\x9d\xe9\x76\xd7\xb0\xda\xe8\xbb\x3e\xd9\x9c\xe5\x65\x06\x67\x47\x26\x45\xb1\x51\xf7\xb0\xd4\xae\xef\x63\xe9\xac\x97\xa5\x33\x6c\x58\x6e\x96\x5a\xd5\xbd\x2c\xb5\xbb\x86\xd5\xac\x6f\x5a\xe1\xd7\x89\xa5\x53\xb5\x53\x55\x58\xd7\x8b\x19\x16\x0f\xf7\xaf\xac\x42\x8c\x83\xff\x66\x44\xf7\x39\x77\x2e\xb6\x39\x76\x02\x71\xf8\x0a\xa5\x3c\xef\xb7\x50\xc3\xf3\x80\x5b\x90\x25\xb7\xdf\x4b\xe6\x8d\xa7\x39\x7d\x69\xba\x11\xf1\x3f\xd9\xa0\xb4\xef\x97\x0a\xb8\xac\xe8\x53\x5a\x9c\x6b\xad\x81\x2a\xd2\x1a\xf1\xc3\x5a\x02\x00\x58\x4b\xb3\xc8\x1c\x09\x6e\x23\x8d\x0a\xd6\xcb\x1d\xc6\xed\x3a\xe5\xe7\x3b\x4c\x51\x29\x21\x4d\x23\x7a\xf2\xa6\xcb\xf6\x7f\x66\xf4\xa4\xcd\xb0\xd5\xe2\x7b\x23\x11\xca\xca\x95\x08\x45\x3d\x07\x60\x68\xd7\x70\x7e\x96\x1d\xa9\x29\x7f\xd1\xc9\x10\xf9\x34\x5a\xd0\xd3\x95\x70\xaa\x05\x97\xe4\x5f\xe3\x9e\x21\x10\x9c\x11\xf9\xd5\xc4\xf8\x37\xf4\x54\x36\xaf\xe0\x6a\x11\xcb\x65\x2a\x8e\xbf\x58\xeb\x43\x79\x6b\x48\x20\xe8\xf9\xf2\xcc\x96\xbc\x06\xd0\xcf\xc7\x8a\xee\xd5\xce\x04\xab\x72\x3e\xed\xca\xbe\x18\x4b\x74\xe2\x15\x7b\xe7\x03\xf8\x00\x0e\xeb\xd6\xac\x72\x75\x1b\x3d\x2c\x83\x75\x20\xd1\x21\x2f\x80\x4b\xf2\xd8\xbb\xd4\x00\x0e\xeb\xd6\xac\x72\x75\xcb\x5f\x86\x97\xe8\xe0\x2b\xc0\xb2\x4f\xf6\x72\xad\x0e\x84\x75\xa8\x95\x3b\xc3\x77\xec\xcd\x67\x89\x08\x79\xeb\x53\x6a\x16\x7b\x81\x12\xc0\x61\x7d\x9a\x55\xce\x1c\x01\xec\x0d\x58\xa5\x21\xd6\x5b\x7e\x72\xd9\xc1\xde\x9a\x33\xc1\x50\x3d\xd2\x6b\x5c\x7d\xf2\x57\x1d\xaf\xe2\x35\x3b\xfc\x68\x59\xf7\xda\x8d\x7e\x34\x7f\x56\xbe\x78\x2b\xdb\xd7\xfe\xa6\xa6\x40\x3e\x46\x84\xcd\x2c\xe8\x11\xd8\x29\x1b\x36\x2c\xf7\x94\xe6\xa3\xee\x9b\xd2\xd6\x63\x66\xa2\x91\x7c\xd3\x4c\xe2\x30\x4e\x6a\xc9\x07\xce\xae\x44\x10\x20\xad\x13\xff\xeb\x4e\xeb\xd4\xba\x61\x4e\x4a\xda\xd0\x93\x5c\xd4\x4b\x72\xba\x6b\xc8\x6a\x0b\xf1\x68\xbe\x51\x28\x57\xef\x0e\xe3\x6f\xa2\x97\x6d\x0d\x1f\x87\xc3\x7c\x16\xa0\xc6\x49\x9c\x05\xbc\x4e\xdd\x91\xa3\xef\x3f\xd9\xaa\xe4\x97\xf3\x69\x57\x34\x55\x97\x70\x8b\x9d\x69\x9a\x21\x47\xe3\x67\xf6\x69\x2a\x56\xc4\x07\x92\xe6\x47\x5a\xa5\xd8\xc6\x85\xb9\x73\xd5\x8d\x37\x3d\x86\x13\xed\xcf\x63\x78\x31\x10\xe8\xa0\xf0\x0c\x20\xb8\x8b\x12\x85\x40\xe5\xa3\x20\xd0\x9a\x3f\x1e\x2b\x7d\xbd\x26\x27\xf0\xa2\xfd\x77\xd5\xef\x8c\xa8\x16\xd6\xbd\x28\x0f\x61\x4f\xef\x85\x4c\x24\x21\x97\x36\x72\x85\xfd\x02\x92\x53\x8b\x00\x7c\x1d\x57\x94\xe6\xfc\xb2\x9b\x7d\xc0\x0b\x97\xd4\xfc\xc1\x96\xd4\x9c\x1d\x8d\x7b\xe3\x08\xf5\xc7\xb5\xf8\x08\x97\x01\x18\x8f\x25\xc9\x4e\x36\xcb\x19\x3b\xda\xde\x1c\xcf\xa7\x5d\x4e\xd2\xcc\xf1\xf4\x8c\x7d\xd4\x2e\x82\x17\x30\xf4\xac\x25\x77\x2f\x51\xf5\xd7\xb3\xb4\x47\xfc\x38\x90\x37\x8d\x6a\x8f\x92\x9a\xfa\x69\xee\x17\xe7\x06\x3c\x04\xe8\x00\x1a\x84\xd0\x46\xef\xb1\x6c\x30\x93\xae\x40\x24\x87\xd1\xe6\xac\x7e\x0f\xa4\xbb\xaa\x4f\xba\x26\xf2\x1d\x11\xad\x48\x99\xb6\xae\x04\x4b\xc9\x22\x57\x21\x1d\x39\xd3\x98\x94\x2c\xee\xa7\x5d\x5d\xda\xea\x9f\xcf\x48\x46\xab\xe6\xa2\x5f\x04\xbb\xf5\xe6\x34\x16\x20\x64\x58\xbd\xe3\xdc\x3c\xd6\x0a\xa6\x3f\x07\xe2\xff\xb1\x1f\x6e\x5d\xb5\x53\x9c\xd5\x3d\x96\x13\xf1\xe3\x6c\x9d\xf3\x56\x20\x9f\x4b\xf8\xf8\xa4\x40\x9c\xa4\xf5\x29\xad\xd9\xaa\x7d\x62\x16\xa5\x3b\xeb\xe1\x80\x19\xde\xd0\x9b\xc6\x59\x51\x63\xed\x45\x8d\xcb\xb9\xb5\xae\x5a\x1c\xb1\x64\x36\x0c\xe3\x80\x5a\xde\x49\xb1\xc4\xab\xe5\x0c\xdb\x3d\x24\xfb\x7d\x90\xc0\x13\x95\xc9\x92\xae\xe3\x25\x40\xe5\xa1\x06\x31\x5e\xd3\x68\x37\x83\xa0\x3a\xff\xe5\xea\x73\xb7\x98\xb7\xab\x15\x5e\xc3\x96\x81\x6a\xc9\xb6\x0a\x1e\xf0\xf7\xbd\x69\xb2\x87\x31\xab\x5d\x4c\x1f\xf6\xa1\x8e\x07\x27\x8c\x2c\x69\x48\x8d\xfe\x50\xaa\xe6\x8b\x68\xb9\x96\x50\xe0\xb9\xff\x07\xb2\x4c\x66\x3b\xcc\x6e\xc4\xfb\x07\x3a\x03\x84\xed\x09\xdd\xc5\x31\x40\x85\xd3\xb6\x5f\xd1\x70\xb7\x80\xa0\x08\x79\xcb\xe5\x22\xec\x98\x66\x3e\xf5\x4d\xd6\xf3\xf9\x3c\xc2\xa8\x8b\x12\x9a\x58\x6f\xb2\xef\xe2\x38\x09\x4d\x4c\x38\x71\x74\xbe\x5b\xc7\x01\x80\x44\x68\x7b\x98\xcf\x16\xb3\xf9\xf5\x0f\xd2\x30\x7e\xa3\xaf\xfb\x8a\x9c\x68\xed\x95\x55\x71\xa8\x68\x5d\xfb\x3b\x76\xc5\xb6\x4a\x4b\x5a\x5f\xf6\x55\x71\xd2\x17\xb1\x4a\xb9\xe7\x2c\x78\x71\x6d\x0a\xb4\x36\xf0\x82\xeb\xf5\x0f\x7e\xf1\x9b\xa2\xff\x0d\x71\x4f\x25\xc6\x8b\x76\x5b\x0d\xb3\x86\xc3\x29\xc2\x5c\x1f\x84\x86\x2e\x56\x59\xcf\x36\x3a\xef\x4d\xd9\x90\x1d\xfd\xec\xbc\x83\x95\xfa\x5b\x3d\x46\xca\x12\x5b\xf5\xc4\xee\xa2\x6e\xad\xe7\xd8\x42\x38\x63\xd6\x3d\xc3\xf3\xb5\x0b\x5d\xc6\xdb\x97\x63\x21\x11\xaf\xce\x06\xe6\x4d\x97\xdc\x1b\x03\x6f\x0e\x2a\x9d\x35\x26\xdf\x84\x26\x25\x93\xae\x54\x94\x78\x26\x7f\xad\xdb\x86\x92\x3e\xfe\x44\xb0\xaf\x1e\x0b\x9c\x2f\x12\x7a\x98\x20\x17\xd9\x16\x9f\xbc\x68\xf1\xfd\x44\x73\xa5\xd6\xdf\x8b\xe0\x7b\x47\x4b\x77\xcd\x0a\xe0\x00\x7f\x7f\xb2\x2f\x19\xfb\xc5\xff\x0f\x89\xfe\x87\xa7\x18\x79\x4c\x96\xcd\x37\x66\x89\xe6\x81\x19\x88\x35\x6b\x4c\x95\x54\xef\xca\xc9\x42\x51\x00\x14\x52\xf6\x47\xf2\xf4\xc4\x37\xb8\x98\x85\xf4\x22\xf9\x88\xb5\x97\xe6\xfb\x34\x4f\x1b\x36\x6f\x6e\x6f\x74\x73\x0b\x38\xcf\x06\x83\x5a\xfd\x13\x10\x43\xf0\xcf\x89\xf8\xcf\x89\x68\x51\x0c\xf4\x6e\x20\xaa\x39\xa0\x74\xb0\xf5\x3f\x35\xee\x9f\x1a\x37\xa4\x71\xc3\x91\xed\x01\xa5\x43\x10\xfc\x53\xef\xfe\xa9\x77\x43\x7a\x37\xf8\x69\x63\x40\xed\xec\xf6\xff\xd4\xba\x7f\x6a\x1d\xa2\x75\x2c\xa6\x0d\x2f\x4a\x8b\x62\xec\x65\x5c\xf1\xcc\x04\xab\x9f\xf0\xff\xf8\xbb\x22\x79\xbd\xc0\x8d\xf5\xaf\x45\x71\xda\x84\x57\x1d\x44\xc5\xd2\x59\xde\x36\x51\x53\xec\x7e\xa1\x71\x03\xd3\x50\xea\x75\xd3\xf4\x74\xf0\xbb\x18\x35\xcc\xd6\xcd\x41\x59\xa4\x4e\x10\xf4\xa8\x3d\x49\x6e\x1e\xe5\x08\xb5\x8e\xdb\x02\xb3\x01\xbb\x95\x0d\xbe\x20\x68\x0d\xda\x31\x4c\xec\xc6\xa2\x1f\x24\x09\x2b\xf8\x34\xd7\x14\xa5\x44\xc5\xbf\xd2\x5d\x1c\x59\x27\x64\x77\x2c\x75\x18\xfc\xd4\xcf\x4a\x25\xcc\x91\x92\x96\xd8\x4b\x4f\x6e\x8b\x85\x36\xe2\xb4\x6e\xe0\xd1\x16\xeb\xe8\x4a\xf7\x05\xab\x3f\xa9\x3e\x4f\x07\x04\xbe\x77\xdd\x9e\x26\x1f\x49\x97\x7e\xdb\x39\x4f\x40\xc1\x98\x37\x06\x07\xb2\x22\xd8\x38\xdd\x99\x2b\xde\xf6\xf2\x24\x81\x3d\x4d\xf8\xcb\x78\x16\x5b\xbb\xab\x94\x76\x1b\x0f\x16\x48\xad\x70\x20\x73\xc2\xeb\xb7\xfc\xac\x5e\xba\xe3\x23\xa0\x9c\x9f\xb1\xc1\xbb\x12\x8d\x1c\x95\xd6\x2d\xd1\xb1\x87\x7e\x59\x80\xee\xea\x60\x95\xfe\x02\x64\x17\xfc\xe2\xa7\x89\xe0\x07\x62\x79\x22\x68\xe2\xac\x51\xe7\x05\x5c\xf5\xf7\x9d\x94\x64\x5f\x5b\x5d\x38\xdd\x02\x1d\x20\xf3\x9e\x86\x8c\xfe\x21\x95\x50\x1f\x3d\xc6\x93\xdc\xf2\xfe\x76\x7a\x07\x5a\x39\x88\xb5\xd2\x3b\xe0\x07\x01\x6c\xc4\xbc\xdc\x25\x63\x51\xeb\x38\xf3\xdc\x97\xb1\xdc\x71\xfe\x17\xef\xe0\x06\xa9\x0d\x34\x78\x9c\xd6\x27\x92\x65\xb7\xb7\xeb\x6d\x76\xb3\x6e\x8d\x6a\x36\x40\xeb\x50\xeb\xfe\xc6\xfd\x4a\x7d\x67\xb3\x21\x8a\x07\x5a\xb3\xc6\x43\xf3\xc9\x25\x28\x7c\x5e\xf4\xb3\xaa\xb7\xcd\x88\x99\x14\xaf\x92\x84\xda\xc7\x5e\x6e\xfc\xe4\x69\x7b\x12\x89\xc0\xe1\x16\x70\xfc\x6e\x34\xb7\xba\xbf\xc1\x76\x40\x46\xce\x8e\x5d\x1e\x51\xd5\xf7\x79\x46\x80\x64\x00\xc8\xbc\x84\xef\x64\x78\xc0\x3e\x27\x3b\x09\xea\xce\x26\xf4\x03\x0c\x0d\xcc\xd0\xa1\x01\xd2\x65\x9f\xa3\xa0\x46\x31\xc3\x34\xcb\xfd\xc6\x58\x70\xca\x34\xc6\x5c\x9f\x2c\xf4\x37\x7c\x2a\x47\x58\xdc\xb6\x76\x11\x6e\x63\x76\x20\xb8\x59\x95\x7b\x1b\x0d\xea\x71\xdb\xda\x29\x6b\x56\xd9\x2b\x61\xad\x79\x1f\x84\xa9\xbb\x2e\xc6\xc6\x73\x3a\xdb\x23\xcb\x4e\x86\xc3\xad\xb8\x5a\x6d\xef\x48\x46\xa9\xac\xde\xd5\x30\xc8\xf0\xd0\x6f\xd1\x54\xc1\x17\x53\x53\xb9\xae\x58\xb8\x6f\x3b\x3e\x81\xf0\x54\x20\x70\xd1\x8e\xe2\x77\xa3\xb9\x59\x6b\x87\xda\x0d\x2a\xae\x40\xe0\x94\xb8\xac\xef\x95\xb6\x89\x64\x00\xc8\x10\xa1\x9b\xe1\x64\x1f\xc5\xb1\x9b\x60\xb7\x1e\x9b\x00\x43\x03\x1b\xa5\xcd\xa0\xcf\x51\x50\xa3\x98\x71\x83\x5a\x4b\x4e\x19\x6a\x2d\xf4\xc9\x42\x7f\xd3\xb1\x1b\x84\xc9\xbc\xbd\x8b\x78\x0c\xbb\x13\xc9\xcd\x2a\x3d\xd0\x6c\x50\xa3\x79\x7b\xa7\xdc\x45\x75\xaf\xbc\x0d\x14\xfd\x30\x86\xe4\x9c\x8c\xa6\xbb\x38\x46\xb5\x99\x63\x71\x2b\xb3\x51\x3f\x30\xa6\x51\xaa\x6c\x76\x38\x06\x68\x0c\x1b\x6e\xd0\x63\xc9\x23\x43\x8f\x85\x0e\x39\x65\x3e\x14\x93\x43\xd7\xde\xf6\xc3\x95\xc6\x85\xc7\xeb\xb4\x24\xb9\x95\x31\x37\x0a\xc6\x07\xcd\x7a\x0f\x7f\x62\x67\x80\xf8\x91\xa5\x10\x1c\x59\x0a\xcc\xe3\x3f\x4e\x20\x41\x30\x8f\xff\xea\x27\x55\x65\x85\xe4\x96\x1d\x18\x1c\x93\xeb\x7a\xf8\x8e\x21\x76\x09\x10\xf4\x8d\x3e\xcb\x25\x92\xe2\x80\xfd\x1a\x6f\xd7\xa4\x4d\x46\xfb\xe4\x1b\xe8\x47\xb4\x96\xf6\xd9\x51\x0d\x8d\xda\x55\xda\x65\xfc\xb2\x5b\x57\x0a\xfe\x44\xda\x89\x66\x38\xd5\xfb\xa2\x68\xba\xab\x8d\x3a\xa3\x07\x8e\xc0\x99\xe9\xc8\x91\xd7\x19\x07\x2e\x59\x22\xf7\x3b\x73\x9a\x3d\x6a\x13\x60\x22\x8b\x38\xa5\x32\x81\x8f\x0e\x62\x1f\x1e\xb6\xb0\x58\xe6\x77\x04\x5a\xab\xcd\xc5\x7e\x91\x63\x6b\xbd\xae\x6e\x75\xad\x47\x9d\x2d\x94\xe6\xd3\xda\x83\x24\x8d\xc6\x75\x31\x9e\x51\x7a\xd3\x54\x30\xfa\xef\xa2\xdd\x76\xf7\xfa\xcb\xd3\xc3\x23\x19\x87\x09\x3e\x35\xf7\x3e\xda\x65\x4c\xf1\xcf\xb7\xa8\xc0\x98\x4f\x08\xbd\xe9\x9c\x41\xd7\x77\x74\x25\x4e\x7d\xea\x4e\xe2\xb3\x39\x8d\x31\x60\x87\x40\x1a\x7e\x7e\x5e\xd4\x22\x7f\xf9\x15\xad\xcb\x22\xaf\xd9\x65\x29\x56\xe2\x9c\x6d\x28\x6e\x4f\x5c\x54\x30\xb1\xe2\xa5\x76\x5f\x1e\xb8\xe6\xd0\x7b\x77\xe7\xea\x42\x66\x3e\x49\xcd\x6a\xd1\x79\x67\xd5\x8c\xb8\xa7\xde\x3b\x71\x6e\x24\xe4\xb1\x69\x5d\xa1\x59\x52\xb9\x29\xbd\x05\x71\xab\x70\xa3\x11\xbf\x81\xa6\x9b\x7a\xfd\x47\x63\xaf\xd7\x24\xef\xc3\xed\xc1\x7e\x8e\xbf\x8b\x54\xdf\x6d\x3c\x83\xfd\xf4\x8c\xe7\xfd\x24\xf0\x7e\x3c\x7e\x3f\x2e\xbe\x85\x4f\x3d\xea\xff\x9b\xaa\x38\xe2\xa7\x7f\x0b\x0d\x7f\x8f\x6e\x46\x88\xe6\x77\xe9\xc6\x3d\x9a\x77\xe3\xfe\xbb\xf1\xf7\xdd\x38\xf8\x06\x1e\xf5\xa5\x59\x71\xeb\xb6\x96\xab\x45\x50\xe0\x24\xcd\xb5\x42\xbc\x77\x45\x78\x13\x2d\x42\x3c\x7a\x41\x35\x4a\x0f\xfb\x91\xb6\xab\xb7\x91\x48\xef\x25\x66\x7c\x7f\xff\x78\x6c\x1d\xed\xcd\xde\xd4\xc7\x48\xcf\xfc\x06\x49\xbe\xd3\x38\x06\xfa\xe8\xf3\x7e\xef\xc3\xf2\x77\xe2\xea\x3b\x31\xee\x6e\xde\x5c\xfe\x3e\x9a\xfc\x76\x73\x31\xc8\xf2\xdf\xd8\x22\xbd\xcf\x28\x06\x45\xf5\x76\x13\xd8\xeb\xe3\xde\x85\xa3\xef\xc3\xb4\x7b\xf9\x32\x60\xaa\xc1\xfe\xbc\x1d\xe4\x67\xb0\xd3\xb7\x6a\x34\xf9\x99\x34\x7e\xd6\x60\x9d\x22\xd7\x81\x2e\xce\x40\xa1\x39\xb5\xc6\xac\x9d\x26\xb7\xb6\x38\x1a\x61\x30\x30\x97\x79\x15\x75\x6b\x2e\x04\xbc\xc8\xb7\xc1\x1c\x88\x38\x41\x8f\x4d\xf5\xd8\x6b\xe1\x11\xf0\x3e\xa7\xd3\x81\xb7\x2a\x71\x03\x76\x05\x3e\x0e\x7b\xbb\xbe\xbb\x01\xbb\x02\x1f\xe7\x30\xef\xe4\xd5\x28\x04\xf7\x52\x30\x8a\x9f\xa3\x10\xdc\x4b\xc1\x28\x9e\x8f\x42\x80\xba\x34\xf9\xe2\xdf\xb0\xb6\x3a\xed\x38\xca\xee\x11\xd0\x1a\x6f\x6f\x81\x1e\x85\x5b\xe3\xda\x2d\xd0\xa3\x1c\xe2\x7d\x5c\x1a\xa9\xa6\x77\xb5\x1f\xc3\xc9\x91\x4a\x7a\x57\xfb\x31\xdc\x1e\xa9\xa2\xb6\xcb\x92\xef\xce\x0d\x28\x9d\x69\xe4\x07\x75\x14\x6c\x5c\x87\x54\x63\x2c\x76\x14\x1c\x62\x1f\xa1\x0a\xfd\xfd\xdd\x8c\xe0\x16\x0a\x46\x8d\xf8\x66\x04\x47\xf8\x95\x66\x50\x9e\xfa\xc2\x66\x58\x9c\x3a\xb4\x5b\x9a\x42\xd1\x47\xe2\xc6\xa0\xef\x91\x65\x5f\x6f\xb7\xb6\xbf\xa5\xff\x31\xa3\xbd\xb5\xfd\x90\x1c\x3b\x7c\x8e\xab\x2e\xdd\x07\x2e\xec\xbb\x2c\xbf\x15\xa4\xd5\x7b\xfc\x0f\xd7\xbd\x19\x33\x6f\x39\x6c\xf5\xd9\x6c\xac\xb2\x43\xd9\x90\xea\x1c\x01\x3e\x3a\x14\xb6\xef\xa3\xe0\xe4\xa6\x76\x37\x2c\x85\x4d\x8c\xd6\xc7\x3c\x07\xc5\x1c\x0e\x76\xec\x21\x1d\x5b\xc7\x25\xf4\xbe\x55\xde\x55\x33\x0d\x95\x05\x00\xbe\x9e\xf6\x3e\x57\x07\x0e\x0b\x8c\xc5\x39\x92\x89\xa3\xf1\x79\x66\x26\x47\x49\x14\xfe\xee\x10\x8a\x08\xe7\x31\x46\x97\xd8\x84\xd9\xa4\xa9\x1c\xb3\xe8\xf5\x0f\x03\xc6\xc1\xe2\x3b\x6e\x95\xf4\xa1\xbd\x91\xcb\x63\x50\x7a\xb7\xa4\xcc\x44\x11\xdd\xcb\x68\x93\x3a\x95\x42\x04\x4d\xa9\x66\xc0\xb8\xd4\xf9\xae\x64\x6d\x7d\x98\x6f\x55\xea\x11\x28\x01\xbb\x25\x69\x8e\xb3\xee\x0e\x5c\x77\xab\xb6\x41\x20\xcf\x9f\x81\x66\x89\xeb\x00\x5c\xbc\xbe\x2b\xf9\x9c\x13\xed\x8d\x8c\x1e\xc4\x07\xb9\x2c\x88\x72\x9c\xd3\xc6\x10\xdd\xcb\x62\x93\x34\x95\x2d\x02\x4d\x79\x67\xc0\x38\x18\x7d\x5f\x32\xbd\x3e\xcc\x37\xf2\x7a\x0c\x4a\x68\xac\x05\x69\x8e\xf3\xc3\x0e\x5c\xf7\x72\xdc\x24\x50\x26\x4a\x40\xb3\xf8\xe9\x20\x0e\x7e\xdf\x97\x1e\xb0\x07\xf1\x8d\xec\x1e\x81\x11\x72\x5b\x10\xe6\x38\xe5\x8a\xa3\xba\x97\xd9\x92\x3c\x7a\xda\xd1\x44\x5f\x5c\x0e\x5d\x14\x17\x67\x5e\xbb\x84\xd1\x01\x4c\xdd\x67\x23\xf5\xac\x12\x71\x0c\xd0\x02\x64\x05\x48\x79\xca\xf2\x13\x22\x15\x3c\x13\x01\x52\xf1\x94\x26\xb4\xe8\x46\x43\x76\x75\x91\x9d\x1b\x9e\x42\xb4\x5d\xe5\xca\x73\xbc\xfc\x0a\xbd\x76\x39\x59\x4f\xe8\xd7\xad\xac\xad\x01\x84\xcb\xdd\xeb\xfa\x02\x52\x07\x2f\x96\xd3\x68\xf1\x3d\x02\x3d\xdf\xbd\xce\x20\xf0\xaa\x85\x7c\xa6\x59\x76\x39\xa5\xb9\x91\x27\x50\x1d\x05\x5d\x3b\x32\xc7\xf6\xaf\xf5\xf4\x45\x26\x9d\xb5\xff\x6e\x4f\x9a\x38\x70\xae\x78\x00\x94\x8f\xcb\x63\x1a\xf3\xb7\x73\xd1\x20\x4f\x79\x9b\x13\x10\xe4\x25\xe4\xed\xfd\xac\x3b\x7e\x1c\xcd\xf1\xfc\xd0\x0c\xae\x3e\x19\xa9\x78\x4d\x30\x16\x85\xe7\x09\x65\xb5\x8c\xe0\x7d\x89\xb0\x9d\xb9\xdf\x83\x40\x3c\x32\x62\x9c\xae\x0e\x3c\xb6\x3a\xdc\xa7\x59\x43\xab\x0d\xc9\xca\x23\xf9\x58\x94\x24\x4e\x9b\xd7\x9f\xa2\xe0\xd3\x56\xfc\xde\x4c\x23\x41\x87\xbc\xd7\xcc\xff\x30\x8e\xb9\xab\x1e\xfa\x53\xaa\xe3\x9d\x2d\xf4\xce\xd4\x3d\x7c\x3e\x74\x95\xc8\xae\x2c\x29\xa9\x48\x1e\x8b\x77\xd7\xba\x59\x0c\x7a\xe8\x74\x6c\x13\x78\xfa\x1e\xf3\x54\x24\x24\xf3\x8b\x92\xe6\x30\xb5\x88\xa8\xeb\x26\xdd\x3e\x7d\xa1\x89\x98\x71\x22\xda\x64\xcd\x3c\x79\x7f\x3b\x0c\x16\xc1\x56\xcf\x73\x6f\x65\x04\x95\x43\x90\xe5\x7e\x1d\x57\x45\x96\xb5\xd4\x37\xc5\x39\x3e\x6e\x8b\x73\xd3\x8a\x4d\x11\x39\xdd\x93\x84\x7a\x82\xe0\x24\x25\x59\x71\xb8\x20\x09\x2f\x8d\xa2\x7d\x51\x9d\xbc\xe9\x4c\xa4\xa2\xb6\xd3\x59\xcb\xbf\x6c\x38\x0d\xc8\x85\x09\x76\xc4\x01\x33\xd2\xd0\x8f\xc1\xc4\x8f\x16\xdf\x7f\xda\xfa\xa7\xba\xbf\xbe\xe8\xad\xee\xa9\x93\x4c\x49\xf3\x3e\x96\x58\x6d\x83\x3e\x9a\x82\x1e\x82\x02\x17\x35\xc1\x27\x5d\x89\x04\x31\x4a\x97\xfc\x17\x29\x6f\x55\xf2\xca\xb3\x79\x9b\x54\xdb\x7e\x8a\xdb\x70\x2d\x13\xb8\x4c\x12\xc3\x9a\x89\xf7\xfd\x90\x76\xf8\x05\x0d\x24\xd3\x64\x9c\xa5\xe5\xa6\x33\xe2\xa6\x1d\xb6\xea\x2c\x53\xbc\x5e\xaf\xed\x52\xdd\xf0\x45\x9f\x6c\x0b\xd7\x29\x35\x7e\x07\x64\x56\xbe\x78\x6b\x60\x80\xe1\x15\x10\x1c\x46\x32\xa6\x1d\x44\x52\x15\xe5\xbd\xd3\x76\x1e\x60\x4f\x92\x06\x01\xc4\xcf\x66\xe3\x05\x35\x5c\x9a\xdd\xb2\x9b\xa5\x39\xde\x08\x58\x3b\xd1\x4c\x3c\x9c\x6b\xe4\x5e\x77\x46\x60\xd8\x3b\x34\xa0\xa9\x4c\x3e\xae\x85\xb6\xfc\xa8\x53\x23\xfd\x8a\x89\x75\x0d\x48\xa6\xda\x57\x63\x60\x97\x6b\x2c\x85\x33\xaf\xdb\x70\x50\x78\x09\xa4\xa5\x5b\x4b\x93\xc2\x7d\x16\x1e\xc3\x32\x47\xc1\x11\xb1\xa7\x3a\x3f\xb7\xff\x63\x05\xf8\xf4\x2c\xf9\x16\x01\x5e\xf7\x6a\x88\x8d\x42\x3d\x15\x89\xb5\x61\xbe\xfe\x73\xf7\xd3\x7c\xab\x51\xb6\xe0\x26\x7b\x47\x2a\xff\x44\x49\x7d\xae\xa8\x63\x85\xe6\xaf\xd7\xeb\xd6\x95\xf3\x39\xbd\x68\x17\x3d\x82\xcb\x0b\x23\x59\x34\xc7\xd7\x3d\xc1\x6c\x3d\xfb\x60\xd8\x0c\x5e\xb5\x0c\xba\x14\xd4\xec\x0d\x0e\x4f\x37\x2f\xd2\x4e\xa0\xb3\x6d\x21\xae\xe6\xf4\x4e\x37\x07\x90\x62\x81\x4c\x85\x33\x63\xd9\xb6\x10\xda\xd7\xeb\x48\xa3\x3d\x93\x74\xaf\x39\xfc\xb4\x29\x8a\xac\x49\x4b\x84\x73\xdd\xa4\x5c\x05\x60\xd9\xce\xd6\x37\x7b\x72\x4a\xb3\xd7\xcd\x87\x7f\xa7\xd9\x13\x6d\xd2\x98\x78\xff\x49\xcf\xf4\xc3\x44\xfd\x3d\xf9\xb7\x2a\x25\xd9\xa4\x26\x79\xed\xd7\xb4\x4a\xf7\xe8\x13\x39\x32\x2b\x55\x75\x22\x99\xb1\x70\x9a\xc3\x85\x53\xf7\xf8\x04\x48\xf9\xa3\xff\x5d\x37\xa4\x6a\xf0\x55\x8f\xbe\xd8\xea\x0a\x3a\xb7\xc2\xca\x32\xda\x34\xb4\x62\x8f\xef\xb4\x53\x47\xd0\xf5\x5c\x54\x89\xbf\xab\x28\xf9\x66\x94\x60\x50\xcf\x15\x29\x55\x81\xf1\x90\x0f\x1f\xe1\x90\xc9\xe2\x63\xe6\x7d\x71\x55\x12\x22\x72\xda\xae\xb5\x6e\xbb\xd6\x1d\x7c\x53\x94\xc6\x8b\x80\x6a\xb6\xb2\x39\xc1\x56\xb2\x12\xd4\x48\xa2\x26\x9e\xb3\xb5\x9e\xa7\x91\xc0\x22\x65\x99\x13\xb5\x01\xac\x67\x5b\x43\x10\x1b\x64\xf8\x69\x6e\x3e\xc8\x12\x05\xf0\xa1\xa5\x87\xdb\x13\x9f\xb7\xab\x60\xe4\x3b\x84\xec\x93\x54\x55\xf1\x8c\xa8\x3f\x48\xc9\x1e\x98\x5b\x0c\xe4\x7a\x22\x57\x64\x66\x46\x0d\x19\x78\xa0\x2b\xd3\xf9\x2d\x82\xef\x4d\x86\x68\x3e\x46\xd8\x2b\xfe\x86\x92\x67\x5c\x46\xd2\x3d\xa3\xd6\x17\xc3\x01\x3b\x54\x0f\x11\x6d\xa1\xd9\x96\xe9\xd8\xde\xd6\x27\xc3\x3f\x30\x4a\x3b\x05\xdc\xfd\x7d\xa2\xfd\xb1\xaf\x46\xc1\xf7\x5b\x33\x8b\x1d\xd3\x74\x67\x4f\x46\x6f\xfc\xb0\x15\xd6\x1f\xc6\x53\xd9\x9d\x5c\xd1\x0c\xf6\x17\xc8\x1e\xb7\xda\x91\x0e\xb4\x3b\xce\x20\xac\xc3\xd1\x2a\x63\x75\x66\x86\x6b\x90\xee\x50\xc5\xd1\x17\x6d\x9a\x04\xf1\x41\xde\xd1\xa7\x53\x90\xb6\xd6\xdc\xdf\x67\x59\x94\x6c\x4f\xec\x0a\xde\x58\x4b\xd0\x25\xd8\x39\x6a\xe6\x68\xb5\xd4\xe3\x29\xd2\x83\xbd\xcd\x11\xce\xff\x37\x75\x84\xbf\xd7\x46\x29\x8e\xe3\x3b\x36\x4a\xee\x05\x5b\x00\xd6\x62\x11\xb6\x60\x43\x80\x2c\xb7\x2e\x14\x93\xb9\x69\x5d\xcb\xf9\x3e\x53\xd6\x72\xcf\xac\x4f\x77\xb3\x5e\x38\x63\x3d\xb5\xab\x51\xcf\xfc\xaf\xb9\xe4\xd6\xeb\xc5\x16\xc4\x78\x64\xbf\x7b\x08\xcc\xbc\xe2\xee\x78\x99\x76\xd5\xfe\xeb\xd9\x16\xed\xda\x7f\x80\xc5\xca\xfa\x7a\xdd\x0c\xed\x36\xd5\x5d\xf4\x8d\x11\xa3\x20\x1e\xa7\xcc\x44\x4c\xc0\xdf\x1b\xb2\x6f\xd0\x09\x6e\x2e\x5a\xdf\xe6\xd0\xcd\x2e\xc1\xfd\xf1\xd0\x26\x52\x10\x25\xc6\xb4\xf9\xf0\xc1\xb4\x5c\xa6\x90\x9a\xa2\xec\x10\x8b\x0c\xa9\xec\x11\x7d\xd4\xdc\xb3\x2a\xdb\x47\x76\x11\x01\xad\xd4\x50\xc2\xc5\x27\x60\x27\xbb\x3b\xc5\x16\x21\x82\xfe\x4e\x9e\x5b\x4b\x89\xb6\x6a\x70\xde\x07\x84\x1e\xed\x9d\x70\x57\x77\x4c\xb9\xe5\xc8\x0d\x0f\xce\x07\x69\x4c\x0b\x6d\xd4\x86\xaf\xd6\xc6\xad\x97\x3b\x46\xce\xdc\x6e\x2f\x21\xe6\xc8\xf9\x48\xf9\xc4\xc3\x87\x6c\x10\xa3\x0d\x1a\xef\x89\xa3\xd5\xc7\x7c\x8b\xa8\xa5\x0e\xa3\xee\x4e\x63\x84\x51\x01\x38\xe1\xa0\x45\x0c\x5b\x84\x03\xc6\x4b\xbb\x97\x24\x7e\x3c\x40\x33\x45\x50\xd8\xe2\xe9\xb5\x11\xd2\x06\xfd\xe8\xcb\x27\x6d\xe0\x5a\xb1\x73\xd8\x1a\x1d\x62\xd0\xe2\x2e\x7a\xb7\x42\x76\x8e\x78\x90\x16\x36\xe2\x98\x54\xc5\xb9\xc6\x1e\x49\xee\xea\xc4\x66\xc7\x15\x79\x64\x5f\x8c\xac\x90\xb8\xd9\xf8\x71\x9a\xf6\x67\x63\x66\x8e\x1c\x09\x52\xcb\x87\x95\xc4\xeb\x88\x1e\x5b\x3b\x98\xa1\x69\x14\x64\xa0\x1e\xa7\xef\x51\x3c\xb8\x88\xd6\xa5\xa7\xc3\xc5\x58\xdb\xc8\x08\x06\xc9\x32\xfe\xf8\xa6\x5a\x96\xf8\xb3\xe4\xd3\xe4\xa3\x15\x5f\x6e\x8b\x2f\x38\x63\xc6\x85\xe7\x97\x7d\x0f\x4e\x9a\x11\xfa\xa5\xf3\xd9\xc9\x1e\x7c\xda\x8a\x66\x4f\x62\xea\x3f\xa5\x75\xba\x4b\xb3\x76\xb7\xae\xbd\x4e\xe6\xa8\x92\xad\x4b\x5a\xd5\x25\xe5\xa9\x8c\xc2\x80\x6f\x8b\xad\x22\x9c\xff\x22\x03\xd2\x54\xe4\x4e\x47\x41\x72\xfa\xd2\x5c\xc4\x4a\xb8\x27\x82\x3f\x4b\x3e\xb6\x7a\x39\x71\xc5\xe3\xf5\xfa\x7e\x62\x78\x66\x75\x14\xa2\xac\xe8\xd3\x38\x5a\xfc\x21\x62\xfc\x71\xd4\xf4\x30\x65\x88\x50\xb1\x3e\x1b\x45\x6e\xd0\x47\x2a\xaf\xbc\xda\x74\xba\x48\xcc\x59\xe2\x57\x58\xca\x98\x07\xb2\xec\xe3\x18\x2f\x32\x96\x7a\x03\x62\xd7\x1e\x4a\x7b\x3e\x17\x45\x77\x11\x0b\x57\xac\xbe\x13\xb7\xef\x46\xe0\x10\x83\x25\x01\xd7\x60\xf9\x32\xb8\xaf\x17\x7d\x92\xa0\xe4\xb6\xce\xa0\x2a\xb2\x5b\x0f\x01\x2c\xf4\x87\xfc\x86\xdf\xed\xb3\x3f\x08\xc3\x67\x04\x97\x9f\xec\x45\xb8\x56\x1f\x7c\x1a\xf5\x39\xd7\x1a\x17\xe7\xd0\xe8\x97\x44\x98\x3c\xcc\x90\xb4\x17\x18\x05\x41\x10\x84\x9f\xbc\x96\x89\xe3\x9e\xf9\x78\x2b\x46\x41\x68\x87\x8f\xe1\x9f\xb0\xb8\x46\x53\x94\x13\x1e\x6c\x68\x7f\xed\xab\xe2\xf4\xd1\xec\xe9\xd3\xa4\x29\x3e\x5a\x7d\x7d\x1a\xf1\xd6\x47\x53\x78\xdc\xb0\x8e\x26\x5d\x88\xa7\xac\x8a\x43\x9a\x6c\xfe\xcb\xff\xf8\x53\x8b\xf7\x2f\xd2\x28\x4c\xff\x9c\xc6\x55\x51\x17\xfb\x66\xaa\xfa\x60\x5b\xf9\x3f\xb6\x92\xae\x9b\xea\xa7\x1f\xbe\x7b\x08\xf8\xff\xfd\x30\xf1\x68\x9e\x68\x15\x41\x57\xf1\xdf\x44\xe3\xbf\xbc\x96\xf4\xa7\xd0\x18\x48\x45\x4b\x4a\x9a\x0d\xff\x8f\xff\x82\xe8\x02\x9f\x05\x32\xb6\xa5\x9e\x40\x76\x73\x7d\x50\x98\x9c\x01\x01\xe4\xd2\x1b\xd4\xe3\x46\x8c\x6f\x50\x0f\xae\x0b\x50\x43\x16\xf7\xab\x47\x2f\xe9\x6f\x57\x8f\xc0\xa5\x1e\x0f\xef\xa3\x1e\xea\xc4\x09\x2c\x1f\xf7\x9e\xbf\xfb\x1b\x86\xfa\x38\xad\x7f\xcd\x80\xbd\x78\xd3\x43\xf6\x5a\x1e\xd3\xb8\xc8\xfd\xf8\x48\x9f\xaa\x22\xf7\x81\x77\xe8\x81\x84\x8b\x20\x05\xca\xa0\x80\xeb\x33\x2b\x7b\x3c\x60\xbb\x93\x91\x01\xc4\x85\x5a\x7d\xa7\x39\x8f\xf9\xb0\xe8\x83\x1d\xe1\x79\xcb\xc0\x3a\x82\x1c\xfb\xc6\xd1\x3d\x0c\x33\x44\x7e\x3f\xe8\xfa\x90\x9b\x36\x47\x27\x23\x59\x29\x3f\xf1\x74\x5f\x63\xd9\x6f\x3d\xa4\xca\x83\xa5\xe6\xfe\xa0\x0f\xe7\x66\x47\xf7\x45\x45\x55\xd4\xe5\x87\xbf\x46\xc1\x6c\xfd\x43\x2f\x91\x68\x1b\xf2\x83\xb1\x42\x48\xd2\x98\x34\x45\x55\x23\xe2\x97\x01\x92\x40\xdf\xc3\xab\x60\xf2\x62\x2b\xbf\x12\x7f\xbf\xc5\xdf\xd9\x11\x5f\xc1\xcc\x77\x4c\xc4\x62\x80\x5d\x54\xd1\x5f\xed\x41\x48\xf2\xb2\xf4\x82\x2a\x5c\x17\x67\xea\x0e\x21\x76\x81\xbd\x50\x9e\x08\x68\x09\xcd\x1b\xf6\x89\xbc\x5d\x9b\xb8\x8e\x6d\x69\x21\xf5\xbf\xae\x87\x16\x20\x76\x10\x56\x0b\x8b\x88\x08\x60\x08\xf7\x2b\x6a\x40\x72\x7d\x2a\x06\x10\x69\x03\x88\xf4\xc8\xa4\xe3\x32\x42\x27\x6a\x99\x70\xce\x92\x99\x4c\x3d\xf7\xfd\x56\x3f\x07\x29\xb2\xcf\x69\xd2\x53\xc7\x52\xd9\xb4\xd5\x0f\x53\x1a\x07\x28\xdf\xbc\xa0\xb3\x89\x66\xa7\x20\x2e\x30\x4e\x2f\xf7\xc6\x75\x5c\x51\x9a\xf3\xed\xb1\x7d\x48\xe1\x1f\xcb\x60\xca\xd3\x09\x9d\x14\x67\x01\x8c\xf5\xa8\x69\xcf\x16\xc9\xb3\x77\xb4\x8e\xbf\x9d\x51\xec\x37\x85\x42\xf5\x2a\x61\xd6\x44\x50\x33\xd2\xac\x80\x50\xa0\x99\x6b\x1a\x5c\xf4\xab\x76\xd7\xee\x20\x8d\x2f\x5f\x0a\x7b\xec\xca\x78\x14\x6b\x32\x00\xc4\xed\x1c\x87\x6a\x8a\x22\xdb\x91\x4a\x6f\x28\x8b\x24\x58\x9c\x51\x52\xed\xd3\x17\x09\xa3\xfe\x56\x00\x45\xde\x90\x34\xa7\x95\xbf\xcf\xce\x69\xa2\xe0\x40\xb1\x05\x6e\x01\x2a\x90\x24\xf3\x8f\x45\x95\xfe\xda\x56\x64\x5e\xa2\x50\x5a\xe5\xb2\x01\x8b\xff\x68\x55\xbc\xc0\xe0\x49\x1f\x88\x44\xa3\x9f\x3f\x92\xed\x8c\x32\x13\x90\x1f\xed\x32\x01\x45\x99\x04\xcc\xc9\x93\xac\x6f\x7f\x6a\xc5\x3b\x52\xa9\x83\xf5\x1a\x88\x51\x0c\xc0\xcd\xfe\xcc\x42\x13\xd4\x84\x51\x95\x25\x39\x74\xed\xf9\x1f\x5d\x95\x3c\xd9\xdf\xd5\xab\x12\x09\xa4\x02\xa5\xfc\xa7\x70\x98\xc6\x53\x78\x7a\xac\xf4\x06\x6d\x05\x7a\x68\xe9\xdc\x80\x8a\x39\x15\x64\x84\xf0\x31\x39\x63\x22\xd5\xc4\xe8\x10\x17\x2a\x18\x53\x12\x06\xef\x6d\x46\x6b\xcc\x15\x9f\x8d\x5a\x46\x6c\x76\x45\x73\xbc\x4e\xb9\x23\x11\xe7\xe0\xcc\x4f\x5a\x86\x11\xd2\xce\xaa\xfa\x6a\xcb\x76\xd5\x1f\x49\xd4\x4e\xac\xff\x6b\x7a\x2a\x8b\xaa\x21\x79\x73\xd5\x5e\x45\xe4\x00\xed\x4f\xbd\xfe\x98\x26\x9d\xb4\x5b\x3f\xa4\x57\xd6\xc7\xe2\xd9\xa4\x4a\xaf\x4d\x73\x16\xd2\xcc\xe8\xc5\x0a\x6d\x5e\xa7\xcc\xbd\x31\xe4\xad\xf1\xdf\x04\x5f\x02\x8f\x6c\xed\xaf\x71\xd6\xd7\x6a\xcb\xf1\xdb\xdf\xee\x36\x01\x23\x3b\xa1\xb9\x93\x70\xb2\xdf\xa7\x2f\xe0\x98\xea\xf5\x0f\xfe\xa9\xf6\x9f\x52\xfa\xdc\x82\x09\xdf\x95\xd0\xa7\x34\xa6\xdc\xc9\x5e\xa7\x62\x3c\x7e\x76\x98\xa8\xdf\xa7\xa4\xfb\x5d\x9f\xba\xdf\x2f\xb5\xb3\xf7\x0e\x0d\x17\xec\x44\x2f\xe1\xeb\x38\xa4\x08\xc2\x9e\x12\xa4\x04\xb6\x56\x45\x10\xb6\x3e\x21\x25\xb0\xb5\x2a\x82\xb0\x2f\x35\x52\x02\x5b\xab\x22\xa0\xbe\x80\x1d\xea\xd4\xa2\x3a\x6a\xb1\x5a\xae\xd8\x62\x06\x61\x25\x54\x32\x66\x7f\x30\x40\x56\xa1\x03\x56\x4e\x28\xbf\x2a\x9e\x75\xc8\x44\x83\x9c\x34\x47\x77\xbb\x98\x66\x99\xd6\x70\xd4\x48\xb0\xa9\x7c\x33\x0e\xce\x56\xb0\xf4\xbf\x13\x0b\x20\x48\x2f\x44\x31\x82\x25\xa7\x58\x88\xaa\x7e\xd6\xeb\xd0\xe8\xa7\x3e\x8d\x94\x9d\x06\xd8\x23\x3b\x08\xe5\x96\x5d\x7d\xd2\x65\x67\xb5\x73\xca\xee\xe6\xf1\x8d\x96\xe8\xed\x98\xc7\xcb\xf9\x5e\xdc\x77\x4b\x9f\x9f\x2c\x86\xfd\x84\x61\xbb\x93\xd4\x3a\x3a\x25\x23\xc5\xaf\x01\xf6\x88\x1f\x42\xb9\xc5\x7f\x4a\x74\xf1\x5b\xed\x86\xc5\x3f\x7a\x80\xb7\xcb\x7f\x3c\xea\x3b\x14\xe0\x56\xe4\x77\x6b\x40\xc8\x0e\xe8\x6a\x28\xb3\xc3\x48\x59\x6b\x80\x3d\xb2\x86\x50\x6e\x59\x67\x07\x5d\xd6\x56\xbb\x61\x59\x23\x43\xb9\x5d\xaa\x18\x92\x3b\xe4\xe7\x46\x73\xab\xa4\x2c\xdb\xcf\x17\x45\x3d\x2b\x93\x9b\xed\x89\xc0\xa8\x59\xd7\x61\x8c\x03\x0a\x2a\x50\x6a\x33\x76\x18\xa5\xe2\x99\x68\xac\xa9\x00\x6c\xac\xd8\x5a\x56\x69\xde\x0c\xac\x49\x38\x8c\xa3\x49\xbf\x8e\x9b\xb0\x3d\x6a\x8e\x00\xba\x35\x9d\x01\xeb\xca\x8e\xb5\x86\xfa\x6e\x02\x8f\x5a\x8c\x61\x03\x1f\x9a\x11\x00\x1a\xa8\xfe\x0d\xfd\x0c\x4e\x1a\x14\xfe\xfe\x71\xdd\x33\xbb\x04\x22\xa1\x6f\xbd\xba\x74\xfd\x97\x2f\x3f\x7e\xe7\xd5\xc5\xb9\x8a\xe9\x9f\x49\x59\xa6\xf9\xe1\xff\xfe\xbf\xfe\xe3\xa7\x5d\x51\x34\x75\x53\x91\x72\x7a\x4a\xf3\x69\x5c\xd7\xd3\x13\x29\xbd\x1f\xbf\xfc\xcb\xff\x17\x00\x00\xff\xff\x29\xa0\x21\x57\x71\xd9\x01\x00")
+var _templatesNotAuthorizedHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x54\x4d\x6f\xd4\x30\x10\xbd\xf7\x57\x0c\x56\x51\x2f\x75\x22\x7a\x40\x3d\x6c\x2a\x95\xaa\x48\x95\xf8\x12\x2d\x12\x9c\x90\x37\x9e\xac\xad\x3a\x76\xb0\x27\xbb\x5a\xaa\xfe\x77\xc6\xc9\x6e\xd8\x14\x84\x80\xc3\x6a\x63\xfb\xcd\x9b\x79\xf3\xb5\x78\xa6\x43\x4d\xdb\x0e\xc1\x50\xeb\x2e\x8e\x16\xf9\x0f\x9c\xf2\xab\x4a\x3c\x3c\x40\xf1\x26\xd4\xca\x21\x3c\x3e\x8a\x8b\x23\x80\x85\x41\xa5\xf3\x07\x7f\xb6\x48\x0a\x6a\xa3\x62\x42\xaa\x44\x4f\x8d\x3c\x17\x87\x4f\x86\xa8\x93\xf8\xad\xb7\xeb\x4a\x7c\x96\x9f\x2e\xe5\x55\x68\x3b\x45\x76\xe9\x50\x40\x1d\x3c\xa1\x67\xbb\x9b\xeb\x0a\xf5\x0a\x67\x96\x5e\xb5\x58\x89\xb5\xc5\x4d\x17\x22\x1d\x80\x37\x56\x93\xa9\x34\xae\x6d\x8d\x72\x38\x9c\x82\xf5\x96\xac\x72\x32\xe5\x38\xab\x17\x4c\x34\x32\x91\x25\x87\x17\x2c\xe1\xb8\xb8\x03\xf1\x2e\x10\xa8\x9e\x4c\x88\xf6\x3b\x6a\xc1\x7a\x40\xc2\xdb\xde\x91\x85\xeb\x56\x59\x87\x71\x51\x8e\x16\xa3\xb5\xb3\xfe\x1e\x22\xba\x4a\x24\xda\x3a\x4c\x06\x91\x03\x31\x11\x9b\x21\x2d\xc7\xc5\x2b\x95\x72\x56\xca\x44\x2c\xa9\x2e\x97\x21\x50\xa2\xa8\xba\xa2\xb5\xbe\xa8\x53\x12\xff\x49\x34\xa0\x0e\x08\x1e\x1e\x24\x10\xb6\x9d\x53\x84\x20\x72\xfa\x05\x14\x8c\xcf\xc5\x28\xf7\xd5\x58\x2c\x83\xde\xee\x1c\x6a\xbb\x86\xda\xa9\x94\x2a\x91\xf3\xa6\xac\xc7\x28\x1b\xd7\x5b\xbd\x63\x9c\x63\x62\xd8\x4c\xf7\x4f\xad\x9d\x6c\xb5\x7c\x79\xf0\xcc\x00\xb5\x7f\x36\xa1\x45\x99\xd5\xfd\x56\xcd\xcc\xe8\x57\x0d\x18\x27\x15\x13\x73\xa9\x0e\xe2\x28\x39\x90\x29\xdc\xd9\xe1\x5f\x62\x3f\xdf\xb7\x8e\xec\xd4\xd4\x63\x3b\xb4\x39\xfb\x43\x73\x70\x6a\xcf\x66\xe8\x6e\x02\x7f\x09\xfd\x49\x44\x48\x76\xe5\x51\x73\xf7\x81\x4a\xf0\x3c\x9d\xc2\xb2\x27\x20\xa3\x98\xa9\xae\x43\xef\x09\x6c\xf2\x27\x7c\x72\x2e\x6c\x18\x48\x01\x7a\x4e\x0d\x19\x9b\xd8\x96\xb0\x80\x9b\x06\xb6\xa1\x07\xa3\xd6\x08\xca\x07\x32\x18\x27\xdb\x81\xc8\x32\x6b\x76\x03\x81\xa9\x95\x67\x8e\xb8\x05\xb5\xe2\x8a\xc2\xc6\x92\x19\x41\xc1\x63\xc1\xb9\x1c\x9a\xb8\xb8\xd4\x3a\x62\x4a\x83\x80\x6e\x16\x7f\x13\x62\x0b\x3c\x5c\x26\xe8\x4a\x7c\x78\x7f\x7b\x27\xd8\x17\xd9\xe0\x9f\xd4\xcd\x85\x15\x7b\x9b\x65\x8a\xad\xad\xef\xb2\x3a\xde\x12\x5c\x77\xab\x35\x7a\xb1\x1b\xd2\x3a\xc5\xe6\x2b\x85\xfb\x7c\xb3\x56\xae\xc7\x71\x6b\x5c\xdd\x7e\x7c\x7d\x97\x6f\xf3\xe2\x80\xf2\x09\x1d\xa7\x8a\x82\xdf\xf1\xa5\x7e\xd9\xda\x3c\xe4\x63\xe5\x96\xe4\x81\x7f\x52\x63\xa3\x78\x3c\xc5\x94\xf7\xdb\x5d\x26\xc6\xf2\x8c\x14\x33\x89\x65\xd6\xf8\x57\x2d\xd4\xf0\xb0\x62\xdc\x1f\xe7\xdd\x39\xbe\xcd\xba\x73\x0e\xc8\xab\xb1\xe7\x6e\x4a\x33\x4c\xf6\xfe\x93\x74\x72\xc7\x81\x0e\x93\xc9\xfd\x34\x6c\xd7\x1f\xf1\xdc\x49\xdd\x6e\x05\x00\x00")
+
+func templatesNotAuthorizedHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNotAuthorizedHtml,
+		"templates/not-authorized.html",
+	)
+}
+
+func templatesNotAuthorizedHtml() (*asset, error) {
+	bytes, err := templatesNotAuthorizedHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/not-authorized.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf2, 0x81, 0x12, 0x57, 0x29, 0xb7, 0x88, 0x3c, 0xaa, 0x86, 0xd4, 0x39, 0xfd, 0x2a, 0x39, 0x23, 0x2d, 0xeb, 0x63, 0x7, 0xa7, 0x4e, 0xf4, 0x69, 0x47, 0x4, 0x95, 0x88, 0xf7, 0xc2, 0xb3, 0x6a}}
+	return a, nil
+}
+
+var _templatesPageHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x53\x4d\x6b\xdc\x30\x10\xbd\xe7\x57\x4c\x45\x8e\x95\x4d\x2f\x21\x07\x6b\xa1\x0d\x39\x14\x5a\xe8\x21\x81\x5e\x67\xad\xf1\x5a\x54\x1f\xae\x34\xde\x65\x59\xf2\xdf\x2b\xad\x63\x63\xb7\x25\xd0\x9e\xa4\xd1\xbc\x79\xf3\x9e\x46\x6a\xde\xe9\xd0\xf2\x79\x20\xe8\xd9\xd9\xdd\x4d\x53\x16\xb0\xe8\x0f\x4a\x5c\x2e\x50\x7d\x09\x2d\x5a\x82\x97\x17\xb1\xbb\x01\x68\x7a\x42\x5d\x36\x79\xeb\x88\x11\xda\x1e\x63\x22\x56\x62\xe4\x4e\xde\x8b\x75\xaa\x67\x1e\x24\xfd\x1c\xcd\x51\x89\xef\xf2\xf9\xa3\x7c\x08\x6e\x40\x36\x7b\x4b\x02\xda\xe0\x99\x7c\xae\xfb\xfc\xa8\x48\x1f\x68\x53\xe9\xd1\x91\x12\x47\x43\xa7\x21\x44\x5e\x81\x4f\x46\x73\xaf\x34\x1d\x4d\x4b\xf2\x1a\xbc\x07\xe3\x0d\x1b\xb4\x32\x15\x9d\xea\x43\x26\x9a\x98\xd8\xb0\xa5\x5d\xb6\x60\x3a\xa8\xbe\xe1\x81\x9e\xca\x41\x36\x52\x5c\xad\x63\x90\x90\x8f\xc8\xeb\xbc\xff\x3a\x5a\x36\xf0\xe8\xd0\x58\x8a\x4d\x3d\x71\x4c\x7c\xd6\xf8\x1f\x10\xc9\x2a\x91\xf8\x6c\x29\xf5\x44\x59\x5a\x1f\xa9\xbb\x5e\xd4\x6d\xf5\x09\x53\xa1\xab\x13\x67\x93\x6d\xbd\x0f\x81\x13\x47\x1c\x2a\x67\x7c\xd5\xa6\x24\xfe\x93\xe8\x8a\x5a\x11\x5c\x2e\x12\x98\xdc\x60\x91\x09\x44\x19\x88\x80\x2a\xe3\xcb\x78\xea\x79\x3e\xcd\x3e\xe8\xf3\x6b\x43\x6d\x8e\xd0\x5a\x4c\x49\x89\x72\x93\x68\x3c\x45\xd9\xd9\xd1\xe8\x57\xc6\x2d\x26\x86\xd3\x72\xfe\x7b\xb5\x95\x4e\xcb\xbb\x55\x3a\x03\x70\x4e\xf7\xc1\x91\x2c\xee\xfe\xea\x66\x53\xf4\xa7\x07\x8a\x8b\x8b\x85\xb9\xc6\x95\x8e\x3a\x0b\x59\xe4\x6e\x82\x7f\xd1\x7e\x3f\x3f\x26\x39\xe0\xf2\xea\x66\x4d\x50\x3d\x4c\xc9\xb5\x8e\x37\x1a\x77\x79\xc4\x14\xe7\x70\xeb\x69\xca\x6d\x3c\x6d\x01\xe5\x8b\x8d\x59\x43\xda\x60\x9a\x7a\x4d\xba\xb4\x6b\xea\x69\x9e\x79\xc0\xd7\x5f\xfa\x0b\xe6\xd0\x39\x18\xb6\x03\x00\x00")
+
+func templatesPageHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPageHtml,
+		"templates/page.html",
+	)
+}
+
+func templatesPageHtml() (*asset, error) {
+	bytes, err := templatesPageHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/page.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0xbe, 0xda, 0xa, 0xea, 0x11, 0xfb, 0x6b, 0x97, 0x8a, 0x7a, 0x9e, 0xb5, 0xf3, 0xf9, 0x9b, 0x27, 0xb9, 0x74, 0x38, 0x9c, 0xc0, 0x15, 0xc5, 0xfd, 0x3b, 0xc9, 0x53, 0x17, 0x76, 0x6a, 0x2e}}
+	return a, nil
+}
+
+var _templatesSignedInHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x53\xcb\x6e\xdb\x30\x10\xbc\xfb\x2b\x26\x42\x10\xdb\x01\x4c\x5d\x0b\x44\x36\xd0\xf6\xd0\x4b\x51\x14\x48\x2e\x3d\xd2\xe4\xca\x22\x4a\x91\x82\xb8\x8a\x61\x18\xfa\xf7\x52\x14\x6d\xc7\x39\xf6\xc4\x87\x66\x67\x86\x3b\xab\xf3\x79\x83\xf2\x19\xaf\x8d\x3f\x3a\x18\x07\x6e\x08\x9d\xef\x86\x0e\x47\xe3\xb4\x3f\xa6\x0b\x6a\xf7\xa4\x35\x69\xd4\xbe\x6f\xe1\x3b\x72\x01\xec\x11\xcc\x21\xd5\x1c\x0d\x37\x8b\x1f\xde\x1f\x2c\xbd\x20\x50\xc6\x8b\x83\x17\x78\x2e\xb1\x19\xc7\x45\xf5\xa0\xbd\xe2\x53\x47\x68\xb8\xb5\xbb\x45\x35\x2d\xb0\xd2\x1d\xb6\xc5\xf9\x0c\xf1\xd3\x2b\x69\x09\xe3\x58\xec\x16\x40\xd5\x90\xd4\xd3\x26\x6e\x5b\x62\x09\xd5\xc8\x3e\x10\x6f\x8b\x81\xeb\xcd\x97\x22\x7f\x62\xc3\x96\x76\xb1\xfc\x51\xbc\xa1\xf8\x3a\x44\xa7\x8e\x8d\x92\x4c\xc9\x11\x66\x47\x45\x64\xad\xca\x19\x3b\xd7\x59\xe3\xfe\xa2\x27\xbb\x2d\x02\x9f\x2c\x85\x86\x88\x0b\x34\x3d\xd5\xc9\xcc\xa3\xf8\x26\xc3\xe4\xa5\x0c\x2c\x23\x5f\xb9\xf7\x9e\x03\xf7\xb2\x13\xad\x71\x42\x85\x50\xfc\x27\x51\x42\x5d\x09\xaa\xf2\xf2\xcc\x6a\xef\xf5\x29\x73\x6a\xf3\x0e\x65\x65\x08\xdb\x42\x79\xc7\xd2\x38\xea\x37\xb5\x1d\x8c\xce\xaa\x11\xd3\x5d\x1f\xfd\xc7\x0f\xcb\x9e\x52\x10\x31\x9d\xe8\x0e\xf1\x06\x4a\xba\xc8\xe1\xa3\x36\x37\x26\xe4\x20\xc5\xdc\x88\x2e\xeb\x94\x51\x28\x6f\x83\xea\x4d\xc7\x70\xde\x29\x9a\xe3\xf8\xfe\xfa\xfb\xd7\x74\xba\x04\x02\xac\xea\xc1\x29\x36\xde\xad\xd6\x38\x67\x1f\xa6\xc6\x2a\x73\x4f\x23\x41\x3d\x9e\x9e\xf0\x70\x77\x23\x92\x0d\x7d\xab\x01\xb8\x3f\x7d\x38\x01\xf7\x78\x1b\x07\x61\x92\x11\xb1\xad\x5e\xea\xd5\xfa\xe5\x0a\x1d\xe3\xbb\x58\x35\x58\xd1\xfa\x8e\xa0\x2c\xf1\x16\x67\x34\x5b\x70\xf2\xdd\x1c\xe2\x08\x68\x04\xdf\xd2\xb1\xa1\xd8\x1e\xb2\x81\xc4\x8d\x67\xf1\x49\x3a\x79\xbc\x29\x8d\x09\xff\x41\x22\xc3\x2e\xd6\xb0\xc5\x72\x6a\xd2\x25\xdc\xe5\xb5\x30\xad\xe3\xfa\x42\x55\x95\x73\x67\xe7\xb0\xe7\x8c\x63\xe8\xe9\x07\xf8\x07\x40\x0f\xac\x37\x77\x03\x00\x00")
+
+func templatesSignedInHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSignedInHtml,
+		"templates/signed-in.html",
+	)
+}
+
+func templatesSignedInHtml() (*asset, error) {
+	bytes, err := templatesSignedInHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/signed-in.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x23, 0xe0, 0xe4, 0xbd, 0x2f, 0x22, 0x7b, 0xe, 0xa1, 0x91, 0xdd, 0x60, 0xbc, 0xac, 0x72, 0xa2, 0xee, 0xf7, 0xd6, 0x28, 0x41, 0x9b, 0xdf, 0xfc, 0x3d, 0x93, 0xb9, 0xae, 0xa0, 0x35, 0xb5}}
+	return a, nil
+}
+
+var _staticBootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x7d\x6b\xb3\xe3\xb8\xb1\xd8\xf7\xfb\x2b\xe4\xd9\xda\xda\x99\x1d\x49\x43\xbd\x5f\xb5\x27\xf7\xc6\xf7\x56\xae\xab\xae\xfd\x21\x71\xaa\x52\xb5\x9e\xa4\x28\x91\x3a\xa2\x87\x12\x65\x92\x9a\x87\x15\xe5\xb7\x07\x6f\x02\x8d\x6e\x90\xd2\x39\xbb\x76\x2a\xf6\x94\x6d\x1d\xa0\xd1\x68\x74\x37\xba\x81\x26\xd0\xf8\xf0\xe3\x6f\xfe\xa9\xf7\x63\xef\x3f\x17\x45\x5d\xd5\x65\x7c\xee\x7d\x9e\x0c\x27\xc3\x45\xef\xed\xa1\xae\xcf\xeb\x0f\x1f\x9e\xd3\x7a\xab\xeb\x86\xbb\xe2\xf8\x8e\x43\xff\xb6\x38\x7f\x2b\xb3\xe7\x43\xdd\x1b\x47\xa3\xd1\x80\xfd\xcf\xbc\xf7\xc7\x2f\x59\x5d\xa7\x65\xbf\xf7\xbb\xd3\x6e\xc8\x81\xfe\x23\xdb\xa5\xa7\x2a\x4d\x7a\x97\x53\x92\x96\xbd\xdf\xff\xee\x8f\x12\x69\xc5\xb1\x66\xf5\xe1\xb2\xe5\xf8\x3e\xd4\x5f\xb6\xd5\x07\xd3\xc5\x87\x6d\x5e\x6c\x3f\x1c\xe3\x8a\xa1\xfa\xf0\x1f\xbf\xfb\xed\xbf\xfd\xe1\xbf\xfd\x1b\xef\xf2\xc3\x87\x1f\x7f\xd3\x3b\x15\xe5\x31\xce\xb3\xbf\xa6\xc3\x5d\x55\x71\x42\xa3\xe1\xa4\xf7\xbf\x05\x66\xd5\x19\xfb\xcb\x42\x7d\x4a\x77\x45\x1e\x57\x1f\xdc\x76\x3f\x7e\x38\xd4\xc7\xfc\xba\x2f\x4e\xf5\x60\x1f\x1f\xb3\xfc\xdb\xba\x8a\x4f\xd5\xa0\x4a\xcb\x6c\xbf\x19\x7c\x49\xb7\x9f\xb2\x7a\x50\xa7\x5f\xeb\x41\xc5\xda\x0c\xe2\xe4\xcf\x97\xaa\x5e\x8f\xa2\xe8\xfb\xcd\xe0\x58\xe1\x35\xb7\x6d\x91\x7c\xbb\x1e\xe3\xf2\x39\x3b\xad\xa3\x5b\x5c\xd6\xd9\x2e\x4f\xfb\x71\x95\x25\x69\x3f\x49\xeb\x38\xcb\xab\xfe\x3e\x7b\xde\xc5\xe7\x3a\x2b\x4e\xfc\xe7\xa5\x4c\xfb\x7b\x36\x6e\xc6\xb3\x43\x1a\x27\xfc\xff\x9e\xcb\xe2\x72\xee\x1f\xe3\xec\xd4\x3f\xa6\xa7\x4b\xff\x14\x7f\xee\x57\xe9\x4e\xb4\xa8\x2e\x47\x86\xfe\xdb\x35\xc9\xaa\x73\x1e\x7f\x5b\x33\x46\xed\x3e\xdd\xe2\x4b\x92\x15\xfd\x5d\x7c\xfa\x1c\x57\xfd\x73\x59\x3c\x97\x69\x55\xf5\x3f\xb3\x5e\x0b\x03\x99\x9d\xf2\xec\x94\x0e\x44\x83\xcd\xe7\x94\x93\x16\xe7\x03\xc6\x90\xe7\xd3\x7a\x1b\x57\x29\xaf\x95\x88\xd6\xa7\xa2\x7e\xfb\xf3\x8e\x71\xa6\x2c\xf2\xea\xe3\x3b\x83\xe2\x54\x9c\xd2\xcd\x21\xe5\x22\x67\xa3\xfb\xf9\x90\x25\x49\x7a\xfa\xd8\xaf\xd3\x23\xab\xae\x53\x07\xee\x16\x5f\xb7\xf1\xee\x13\x1f\xcb\x29\x19\x30\x09\x14\xe5\x9a\x89\xf6\x54\x9d\xe3\x32\x3d\xd5\xb7\x78\x1d\xb3\x11\x7d\x66\xcc\x59\x1f\x0a\x46\xce\xb5\xb8\xd4\x9c\x04\xce\xb6\xed\xb6\xfc\xb9\xce\xea\x3c\xfd\x78\xdd\x16\x25\xe3\xc9\x60\x5b\xd4\x75\x71\x5c\x8f\xce\x5f\x7b\x09\xfb\x99\x26\xb7\x6d\x9f\x69\x4a\x71\x7a\x96\x12\xfc\x22\x89\x5a\x44\xd1\x2d\xd9\x9f\x64\x59\x55\x7f\xcb\xd3\x75\x56\xb3\x21\xee\x6e\x87\x91\x16\xcb\x70\xbe\x48\x8f\xbd\x68\x23\x61\x98\x00\xd7\xe3\xf4\x78\x63\x95\x9f\xae\x92\xca\xef\xa2\x28\xda\x34\xb4\xaf\xbf\xdb\xef\xa3\x5b\xc5\x54\x47\x69\x8b\x68\xb3\x64\xc2\xae\x2e\x8c\x88\xcb\xf9\x7a\x2e\xaa\x8c\x0b\x67\x5d\xa6\x8c\x0d\x6c\x4c\x16\xee\xc5\xec\xfb\x8d\xe0\xbb\x66\x1b\xc9\x7a\x8e\xa9\x2e\xce\xeb\xc1\x70\xc6\xe8\x61\xb8\xaf\x6a\xd0\x83\xe1\x98\x97\x64\xc7\x67\xc5\x0d\xc6\xa2\xea\xf3\xb3\x90\xd2\xba\x64\xaa\xf3\xee\xca\x19\xb8\xcf\x8b\x2f\x6b\x29\x92\x9b\xd4\x2b\x3d\xe2\x11\x1b\xef\x34\x3a\x7f\xbd\x1d\xca\xab\x21\x43\x6b\xf8\xb6\xf8\xca\x29\xcd\x4e\xcf\x6b\x2e\x71\x26\x1a\x5e\xc4\x54\xbc\xf8\x2b\x55\x87\x17\xdf\xce\xac\x47\x43\x48\x7c\xa9\x8b\xdb\xae\x60\x6a\xff\x69\x9b\x30\x95\x4c\xfb\x55\x7c\x3c\x3b\xd3\xed\x58\x9c\x0a\xa6\x0d\xbb\xb4\x6f\x7e\x59\x8c\x63\x44\xdf\xb6\x17\xc6\x80\x53\x3f\x3b\x9d\x2f\x75\xbf\x38\xd7\x72\x62\x30\x7e\xb1\xc9\xd0\xe7\x13\x90\xa9\x52\x6c\xa6\x9b\x68\xcc\xd4\xfc\xc0\x66\x70\xbd\x91\xb2\x54\x7f\x29\x4c\x0d\x79\x9f\xb3\x2a\xdb\xe6\xa9\xee\x41\xa2\xbc\x8a\x39\x2d\x94\x74\xcf\x6c\x85\x54\x63\x05\xc1\x8d\x45\x4f\x10\xf2\x73\xfd\xed\x9c\xfe\x24\x8b\x3f\xf6\xad\x22\x36\xe7\xd2\xda\x29\x61\x42\x3c\x66\xf5\xc7\xab\xe6\x75\x7c\x3e\xa7\x31\x43\xbf\x4b\xd7\xb2\xfd\x66\x77\x29\x2b\x46\xe6\xb9\xc8\x18\x1f\x4b\xd5\xd9\xcf\x6c\x1e\xc5\x8c\xba\xe4\xa3\xdd\xad\x29\xbc\xaa\x46\x49\xba\x8f\x2f\xb9\x1e\xdb\x7a\x2d\x44\xb6\x2f\x76\x97\x6a\x90\x9d\x4e\xcc\x90\x88\x76\x7e\xf9\xf5\x1c\x27\x09\x17\x1e\xd3\x72\xad\x4f\x02\xf4\x6a\x2b\xaa\xb4\x96\x37\x6b\x34\xbb\x43\xba\xfb\xc4\x04\xed\x0e\x3a\x66\x06\xa3\x19\xa1\xa5\x1a\x66\xe6\xfa\xca\x64\x55\xe1\xa5\x86\x42\xbb\xff\xd3\xe5\xb8\x4d\xcb\x8f\x6c\x40\xaa\x33\x31\x9a\x41\x75\xce\x4e\x03\x5b\x53\x08\x68\x66\x5f\x5c\x68\x3d\x17\x84\xaa\xda\x52\x63\x32\xda\x1d\xd0\x31\xbd\x6c\x86\x6c\x10\x3d\xe0\x2a\xb7\xcf\xd2\x3c\x41\x28\x68\x68\x97\x05\x83\x1d\x6f\x92\x23\x83\xa5\x1a\x24\xcc\xf5\x95\x31\xb7\x4d\x98\x0e\x0a\xfd\x16\x9d\x33\xc5\x35\x5a\x31\x9c\x30\x6b\xd3\x1b\xce\xc7\xe2\xff\x16\xec\x7f\x37\x7a\x86\xf5\xc6\xe7\xaf\x5a\x67\xb8\x29\xae\x8a\x3c\x4b\x7a\x55\x96\xb3\x69\x75\xcb\xd3\xe7\xf4\x94\x60\xca\x65\x66\xaa\x6b\x1d\xf4\x84\xf6\x2c\x78\xcd\xf5\x5c\x5b\x7e\x6e\x17\x6c\x7c\xdc\x95\xe4\xf1\xb9\x4a\xd7\xfa\xc7\xad\x4e\xfa\xf5\xa1\xe9\xf8\xc6\x17\x09\xff\xad\xb8\x94\x6c\x88\x3d\x64\xa9\x71\x98\x6d\xcf\xc2\xf9\xcf\x98\x50\xb2\x3c\x2d\x85\xf3\x72\x96\x1c\x55\xb9\xfb\xc0\x16\x09\x1f\xb8\x0f\x56\xab\x85\x7f\x3e\xa6\x49\x16\xf7\xce\x25\x9b\xa9\xd7\x1f\xfb\xeb\x78\xcf\x5d\xf6\x7a\x9b\x32\x53\x91\x5a\x9e\xe3\x37\xd9\xf1\x5c\x94\x75\x7c\xaa\x37\x72\x89\x70\x88\x13\x36\x62\xce\x6b\xab\xca\x72\x2f\x51\xcf\x6e\xe3\x28\x1d\xde\x94\xaa\xb9\xc5\xcc\x97\x72\xc3\xc6\xdc\xa3\x34\x65\x8d\xf8\xd7\x62\xf5\x25\x5d\xfc\xcf\x87\x32\xdd\x7f\x94\x03\xb8\x2a\xf5\x5c\xbf\xe9\xbd\x7d\xd3\x8b\xeb\xba\x7c\xcb\x6b\xdf\xf5\xde\xbc\x7b\x63\xfb\x61\x12\x5a\x54\x2b\x70\x81\xf8\x7f\xfe\xf4\xe6\xcf\x31\x5b\x86\xec\xca\xec\xcc\x00\x55\xcb\xbe\xa9\xfc\xee\x8d\x87\xec\xcd\x4d\x2c\x4a\xfe\x72\x61\xab\x20\xee\x2a\xae\x9e\x8a\x7d\xb7\x5a\xad\x98\x51\x78\x66\xcb\x17\xa6\x47\x9f\xd8\xcc\xe7\x2b\xaa\x75\xfc\xb9\xc8\x92\x5b\xcd\xd7\x4d\x66\xed\x21\x94\x67\x20\x97\x52\x03\xa1\x5f\xdc\x73\xf6\x6b\x6e\xf6\xf0\xf6\xdc\xb1\x1e\xe3\xaf\x83\x2f\x59\x52\x1f\xc4\x32\xce\xe2\xe9\x61\xdc\x3f\x4c\xfa\xe7\x6b\x51\x9e\x0f\xcc\x2f\xac\x27\x1b\x06\x56\x7c\x61\x3f\x64\x95\x8d\x55\x0c\x4b\x21\x1d\xb2\x45\xdb\x36\x2e\xdd\x25\xd1\x70\x5b\x9f\x9e\x86\x3b\x36\x17\xea\xfe\x30\x29\x8b\xf3\xe5\xfc\x64\x95\x69\x95\x67\xab\x80\x01\xa6\x50\xb7\x61\x1e\x6f\xd3\x1c\x61\x0f\x03\xbb\x0d\x9d\x69\xe3\xcd\x12\x1b\x8d\x80\xec\xb1\x69\xa3\x7f\x1d\xfc\xb5\x1a\x5b\xf5\xec\xbd\x36\x03\x89\x9d\xad\xe6\x4d\x63\xab\xe8\x80\x50\x96\x24\x89\x85\xe5\xf6\xcf\x6a\x01\xb0\x4b\x9d\xa5\xc0\x0f\xff\x25\xff\x76\x3e\x64\x4c\x23\xaa\xde\xbf\xc7\xf9\x9e\x29\xea\x73\xf5\xc3\x86\x4d\xc3\xf5\xa5\xcc\xdf\x0e\x87\x1f\x38\x74\xf5\xe1\xd9\x80\x0d\x0e\x1a\x6c\x50\xa6\xcf\x97\x3c\x2e\x87\x29\x5b\x09\xdd\xdf\xe4\x3f\x7d\x97\xa5\xfb\xec\xeb\xbb\x1e\x77\xf9\x71\xfd\xf6\x87\x94\xf9\x0d\xb6\x88\x4a\x06\xc5\x99\x69\x27\xb3\xae\x3f\xbc\xeb\x77\xc7\xf8\xa5\xd8\xef\xc7\x0d\x32\xf1\xe7\xdd\x08\xdc\xf6\x77\x35\xaf\x6b\xab\x75\x5d\x5e\xd2\xbb\x47\xc0\x96\x96\xdf\x35\x00\xff\xcb\x00\xa8\xfa\x06\x3b\x03\xfc\xe1\xdd\x6d\x68\x60\x91\x75\x30\x5f\xcf\x32\x65\xd8\xa0\x7b\x90\x0e\x0a\x60\xad\xe3\xe5\x7a\x64\x63\xfb\x8a\x29\x5b\xa7\xdb\x4b\x96\x91\xb1\x9e\xb2\xdd\x91\xad\x8d\x0f\xdc\x25\x30\xd5\xcb\xd8\x6a\x9b\xad\xb4\x13\xe9\xb6\x8b\xea\x2b\x84\x79\x2e\xe3\x6f\x15\x5b\x96\xa7\xd6\x88\x06\xc2\x1b\x64\xd5\xa7\xc6\xcc\x2b\x93\xf5\xa7\x28\x1a\xc7\x6f\x6c\xd0\x73\x7e\xa9\x50\xb0\xad\x03\x96\x5e\x4a\x05\xd5\x77\x4b\x0b\xbf\xf1\x38\x8a\x77\x4e\xe3\x63\x76\xc2\x3a\x19\x8f\x47\x63\x07\x6e\x97\x17\x97\x04\x81\x9b\x47\x23\x97\x98\xd3\xe7\x34\x67\x6a\x8e\x80\x2e\xa2\x95\x3b\xbc\xf4\xb4\xcb\x72\x14\x70\xef\x00\x3e\xb3\xad\x36\x42\x63\x1a\x81\xbe\x8f\x97\x2a\xdb\xa1\x70\xee\x58\xe4\x4a\x06\x05\x9c\x38\x80\xcc\xe4\x97\x35\x0a\x37\x73\x11\xb2\xb5\x08\x0a\x36\xf7\xc0\x06\x6c\x63\x5b\x7f\x43\x81\x17\x0e\xf0\xa5\x4a\x71\x9c\x4b\x07\x6c\x9f\xe5\x47\x14\xcc\xe5\x75\x7d\x18\xb0\x89\xf6\x9c\x62\xa0\xa3\x08\x80\xa2\x40\x23\x0f\x5f\x56\xa1\xbc\x01\x8a\x53\x7c\x42\x81\x5c\x46\x97\xe9\x91\x2d\xe4\x50\xc0\xa9\x03\xf8\xd7\xa2\x38\x32\x57\x8b\x42\xce\x7c\x48\xb6\x40\x47\x41\x5d\xb9\x30\x83\x88\x42\xb9\x02\xa9\xd8\xd6\x3a\xce\x51\x40\x57\x24\xbb\xe2\x19\x85\x02\x12\x29\xe3\x0a\xe5\xf4\xd8\x15\xc7\xa1\x38\xa2\x8c\x19\x8f\xa0\x1e\xe0\x60\xae\x34\xea\x8c\xc0\x06\xe4\x51\xc4\x09\x0a\xe6\x4a\x83\xad\x5a\x4e\x39\x03\x1d\xc4\x39\xca\xe7\xf1\x0c\x05\x47\x41\x5d\x91\x5c\xce\x24\xa0\x2b\x95\xec\xc4\x16\xaf\x28\xdc\x12\xd8\xd2\xf8\xdb\x60\x97\x95\x3b\x82\x4d\x2b\xa0\x8f\x6c\x47\x83\x0e\x69\x12\x01\xc0\x3d\xdb\xa3\xa3\x72\x9c\xb8\x02\xe2\xd3\x85\xe2\xd3\xc4\x15\x12\x77\x65\x28\x98\x2b\xa4\x7d\x1e\xa3\x8a\x36\x99\x42\x23\x96\x9c\x0f\x6c\xad\x88\x9a\xd0\x89\x2b\xa2\xcf\x45\x7e\x39\xa6\xd4\x8c\x98\xcc\x31\x60\x2e\x56\x14\x7a\x81\x41\x5f\xce\x28\xac\x2b\xad\xbf\x94\x3c\xce\x83\x02\xba\x82\x62\xeb\x61\x0a\x72\x0a\xcc\x1a\xce\xac\xe9\x08\x42\xa1\x6c\x9a\xba\x12\xda\x16\xb8\x59\x9b\x4e\x3c\x30\x1e\x05\x44\x41\x5d\x29\x89\x4d\x20\x0a\xe7\x0a\x68\x17\x1f\xd3\x32\x46\x01\x5d\xe1\x88\xc8\x15\x06\xb6\x00\x24\xe6\xe8\x34\x9b\xba\x02\x91\x21\x4f\x14\x10\x98\x35\xbe\x49\x54\x8b\x27\x04\x7a\x16\xf9\xd0\x72\x93\x84\x01\xbb\xb2\x11\xc1\xcd\x41\x9e\xee\x71\xcc\x63\x04\x78\x97\xf2\x18\x18\x0a\x3e\x41\xc0\x4b\x92\xec\x29\x02\xcd\x43\xf4\xd9\x1e\xf5\xe5\xb3\x99\x37\xf7\x51\xb0\x39\xb0\x65\x09\x0f\xeb\x90\x23\x5c\x60\xd0\x34\xcd\x60\xa1\xc0\x36\x48\xdc\xfa\x0f\x44\x08\x1f\x6d\x00\x96\x67\xd9\xae\xbe\x94\xe8\xd4\x9a\xbb\x52\x3c\xc6\xe7\x01\x57\x73\x9c\xd3\x73\x20\x18\xf9\x69\x03\x03\x9c\x00\x57\x85\x2b\xf0\xdc\x95\x45\x9a\x64\x38\x18\x58\xa2\x1d\x62\x62\x2c\xae\x0c\x44\x44\x12\x85\x73\xb9\x4f\xad\x57\xe6\x4b\xb0\xe4\x4b\xcf\x03\xbe\x11\xfe\x12\x97\xe8\x3c\x9b\xaf\x80\x94\x98\x97\x08\xc1\x2f\x22\x60\xff\x02\xa0\x23\xcf\x03\xa2\x60\xae\x7c\xce\x31\x5b\x79\xa2\x70\x13\x30\xb2\x02\xb5\xe4\x8b\x29\x30\x43\x25\x49\xdf\xcc\x1f\x7a\x08\x7c\xee\x73\x36\x04\xee\xca\x2b\xfd\x73\xba\x43\xf5\x64\xb1\x84\xf2\xff\x5c\x16\xb4\x99\x59\xac\x50\x70\x72\x16\x2e\x23\x6f\x4b\x27\x56\x92\x28\xec\xc8\xdf\x9a\xd1\xc0\x63\x64\x05\x4d\x43\x4f\xc0\xa2\x9c\x86\x74\xe5\xf7\x97\x4b\x5a\xf1\x0d\x38\x0d\x3f\x03\x56\x69\x5f\xd0\xb0\x40\x84\xbb\x32\x4d\x4f\xd5\xa1\xc0\x39\xb7\xc0\x06\x48\x2f\xe1\x96\x4b\x38\xc4\x00\x2c\x5c\x45\x9c\x02\xc0\x2b\x57\x84\x71\x59\x16\x5f\x48\xfd\x58\x8d\x10\x60\x52\x3b\x56\x63\x04\x1a\x5f\x21\xad\x26\x08\x28\xb5\xf4\x5a\x4d\x7d\xe3\x47\x2d\x3e\x57\x33\xc0\x67\xf1\x05\x7a\x7f\xc9\xd1\xbd\xce\x6a\x8e\x41\x8b\x4f\x99\x28\x38\x98\x85\x5f\x77\x79\x7c\x8c\x43\x0a\x35\x02\x9b\xfa\xe7\x0c\x65\xf4\x08\xec\xe9\xf3\x34\xde\xa3\x60\x60\xcd\x9c\xa1\x5e\x60\x14\x01\xa7\xf2\x2d\x15\xb1\x3a\x14\x74\xe6\x81\xee\xf2\xa2\xc2\xd1\xba\xdc\x62\xb6\xea\x94\x9d\x9e\xe9\xa1\x2f\xa0\xc5\x3e\xe1\x68\x81\xcd\x8a\xf3\xf4\x94\xa0\x21\x88\x11\x88\x03\x94\xf1\x29\x29\xb0\x80\xc1\x08\x44\x01\x76\xc5\xf1\x98\xa2\x0e\x78\x04\x42\x01\xc7\xf8\xf9\x94\xe2\x80\x63\xd4\x56\xa2\xfa\x3d\x02\x11\x01\x0d\x4c\x68\xf8\x08\xc4\x05\xca\xb4\xfe\x92\x12\x54\xc0\x85\x40\x71\x3e\x73\x21\xec\xf0\xd8\xce\x68\x04\xd7\xd1\xb9\x08\x7e\x53\x22\x06\x51\x02\x05\x4e\x29\x0f\x08\x15\xa8\xe9\xa3\xbf\xdf\xa3\x2d\x56\x58\x8b\x43\x51\x66\x7f\x65\x50\x78\x1b\x18\x42\x48\x12\x14\xca\x95\xe3\x96\xcd\x78\x86\x16\x25\x1b\x44\x11\xb6\x69\x8e\xf7\x0b\x64\xc8\x87\xb5\x67\x03\xab\x51\xce\x81\x60\x42\x7d\xb8\x1c\xb7\x15\xa1\x1d\x20\x92\xa0\x60\x29\xe5\x00\xc1\x84\x03\x53\x7a\xd2\x06\x8f\x40\x40\x41\x00\x13\xd6\x7d\x04\x82\x0a\x02\x96\x20\x78\xe5\x43\x52\xe4\x82\x98\x82\xf4\x44\x2d\xae\x63\x04\xc2\x0b\x4e\x23\x8a\x7c\x10\x67\x70\xda\xe0\xc3\x00\x21\x07\xa7\x05\x39\x9c\x29\x88\xde\x16\x5b\x54\xfe\x20\xf4\xf0\xa5\x4c\x4f\x68\x54\x76\x04\xc2\x0e\x75\x5c\x7d\xaa\x50\xb8\x05\x0c\x89\xe1\x9b\xbf\x11\x88\x36\x6c\xcb\x2c\xdd\xef\x62\x7c\x7e\x83\x80\x03\xf7\x8b\x72\xdd\x82\x01\x83\x98\x43\x12\x57\x87\x6d\x81\x2f\x50\x47\x20\xf2\x70\x8e\xcf\x29\x63\x6e\x86\x8a\x01\x84\x1f\x44\x5c\x9a\x8c\x24\x8f\x40\x14\x22\xcf\x4e\x9f\x50\x30\x10\x81\xe0\x31\x22\x14\xce\x95\xd3\xf9\x52\x1d\xce\x19\x3e\x7c\x10\xc4\xab\xf0\x81\xbb\xdc\x7f\xde\xe2\x43\x76\xf9\x5e\x15\xb8\xb5\x06\x01\x05\x0e\x36\xd8\x7e\x63\x6b\x9d\xf3\x21\xde\xe2\x0e\x01\x84\x15\x60\x13\x62\x9d\x34\x02\x01\x06\xdd\x4c\x7e\x9e\xc4\xe0\x27\x34\x3c\xd9\xc7\x14\x27\xad\xae\xcb\x6c\x7b\xa9\xd1\x10\xde\x08\x04\x1b\xfc\x46\x64\x6f\x40\x5c\x27\xb1\xf9\x4d\x51\xa1\xcd\xe0\x42\xee\xcc\x2c\x1a\x0a\x08\x83\xe1\xf2\x5b\x31\x69\x2d\x40\xd4\xc1\xc0\xe3\xf6\x08\x44\x1e\xf2\xe2\x19\xff\x1a\x30\x9a\x8f\x60\xac\x14\x8d\xd2\x8e\xe6\x63\x0f\x21\xfe\xd1\x60\x04\xc2\x13\xa7\xf4\xcb\xe0\x4b\x76\xe2\x67\x26\x30\x60\xb8\x3c\xd9\x15\xb8\x15\x80\x61\x8a\x18\x0d\x2b\x8c\x40\x94\x82\x5a\x5e\x80\x20\x05\xc7\x86\xf7\x0a\xa2\x7b\xe2\x6b\x3a\x0a\xb8\x82\x62\x27\x00\x41\x5c\xa2\x4a\x71\xed\x58\x40\xb1\xb0\xc5\xd8\xb7\x41\x82\x7e\x0f\x65\xd0\x63\x0c\x9a\x1c\xd5\x62\x82\x81\x93\xdf\x96\x46\x30\x54\xd1\xa0\x47\xa1\x67\x18\x34\x25\x09\x10\xad\x60\x1e\x23\xc9\x6a\xbe\xe6\xc4\x29\x5f\xc0\x6f\x43\xa7\x6a\x8f\x9b\x15\x18\xaf\xb8\xd4\x79\x5a\xa2\x6e\x00\x84\x2a\xe4\xf9\x15\x0c\x70\xe9\x2d\xfd\xcf\xfc\xb0\x2f\xce\x64\x10\xa4\x60\x9e\x88\x74\x1c\x20\x44\x21\xe0\x28\x5b\x04\x02\x14\x75\xf1\x85\xa0\x75\x0a\x3f\xa9\xd6\xa8\x51\x04\x61\x89\x2a\x21\xe3\x9e\x23\x10\x95\x38\x84\x40\xc1\xfc\xba\x6c\xc5\x61\x25\x9c\x02\x10\x09\x14\x07\x61\xf8\xc7\x7f\x02\xf5\x0a\x01\x4f\x8a\x7c\x8b\xca\x76\x15\x21\xd0\xb3\xc1\x08\x85\x1d\x21\xb0\x73\x02\x76\x8c\xc0\x2e\x08\x58\xb0\x36\xd4\x47\xf7\x07\xc4\x27\x8f\xd1\x0a\x1a\xc5\xe7\x8c\x1f\xcd\x17\xd1\x00\xb2\xcd\xcc\x3f\x86\x10\xfa\x90\x38\x02\x11\x07\xd9\x80\xfc\x9c\x38\x5a\x2d\xc1\xcc\x4b\xd9\x76\xbe\x38\x65\xc4\xec\x5b\xad\x7c\xf0\x24\xdd\x65\xc9\xa5\xc0\x8e\x51\xa4\xe3\x08\xcc\xad\x04\x05\x1a\x79\x66\x9b\xfa\xa0\x3b\x06\x71\x0f\x6e\x7f\x68\x58\xb0\x10\x4c\x3f\xa7\x39\xee\x58\xc7\x20\x00\xc2\x85\x89\x82\x81\xb5\x20\x3f\xcd\x82\xc2\xcd\xc1\x07\x93\x14\x75\x1b\x63\x10\x9e\x48\xff\x72\x11\xd7\x29\x30\xde\x8f\x41\x84\xe2\x93\x38\xe0\x8b\x80\x8d\x60\x00\x13\xb5\xd0\xf0\x80\xcb\x39\xfe\x82\x83\x81\x4f\x7a\x19\x0f\x20\xa0\x80\x2e\x07\x3f\x9d\x88\x9d\xdb\x18\x04\x24\xb6\x31\x5b\xac\xf1\x03\x50\x97\x3c\x46\xc1\xc1\xee\x07\x8d\xca\x8c\xe7\x7b\xf7\xec\xd0\x36\x8f\xf9\xc9\x6a\xdc\xdf\x8c\x41\x18\x62\x9b\xe2\x50\xae\x70\xe2\xf3\x19\x53\xb3\xfd\x72\xef\x1e\xd7\x49\x4b\x7c\x2b\x35\x06\x01\x87\x43\x71\x29\x89\xa3\x3d\xe3\xc9\xc8\x3d\xe3\x94\xc7\x47\x94\xe9\x20\xe2\x90\xb0\x89\x4e\xc5\x1b\xc6\x20\xde\x70\xce\x9e\x9f\xbf\xf1\xd0\xef\x27\x14\x18\xf8\x8f\x5d\x56\xb1\x85\x35\x3a\xc5\x41\xb4\x61\x9b\xd5\xbb\x02\x5d\x94\x8e\x41\xa8\x61\x5b\xef\x3a\x40\x7d\xdd\xd6\x1d\xa0\xbe\x61\x4a\x1e\x45\xb1\x3b\x8c\x3f\x63\xb3\xda\x83\x2a\x2f\x5b\x4c\xd0\xe3\x68\x9b\x40\xb8\x0e\x50\xe2\x04\x1c\x36\x02\x10\xf6\xc8\x76\x29\x5b\x7e\xe7\x39\x6a\x77\x40\xb4\xc3\xc0\xf2\x60\x40\x8d\x6b\x2f\x08\x76\xa4\xc9\x65\x27\xcf\x2d\x63\xb0\xe0\xf3\x88\xb8\x4a\x15\x0e\xb2\x8d\x41\x98\x43\xb5\x09\x84\xf2\xc6\x20\xe0\xc1\x2f\x62\x0d\x0e\xf1\x71\xcb\xa6\x01\x6e\xf1\x40\xe0\xe3\x58\x24\x71\x4e\x6f\x3a\xc6\x20\xfe\x51\x64\x38\x15\x60\xfb\x5d\xc6\xb8\xb2\x82\xc0\x47\x75\x39\x89\xc9\x8a\x2e\x76\xc6\x53\xe4\xbb\xbe\xb8\x73\x83\xc1\x8e\x7c\x58\x79\x40\x18\x03\x1e\xfb\xc0\xd6\x49\x77\xac\x05\x90\xe5\x96\x7f\xf9\x53\x9f\xec\xf1\x6f\x96\x63\x10\x09\x71\x9a\xa8\x1b\x53\x58\xab\x19\xdd\x2a\xac\x3a\x20\x4e\xe2\xb4\x24\x62\x77\x63\x70\x6e\xc3\x69\x13\x52\x3a\x10\x6d\x71\xda\x51\xc1\xc5\x31\x3c\xd4\x51\x66\x31\x93\x7e\x4a\x37\x80\xe7\x3a\x74\x03\x6a\x34\x20\x06\x63\xe0\x69\x6e\x83\xf0\x8b\x69\x41\x88\x74\x06\x17\xa7\xa7\xaa\xc0\xcd\x10\x8c\xb9\x5c\xce\x69\xa9\xae\x1a\x60\xd0\x33\xb8\x03\x08\xc0\xce\xfd\xf9\x4e\x32\x64\xe1\xc3\xd2\xdc\x5e\xfa\xc0\x44\x7c\x65\x0c\xe2\x2b\x02\x16\x5f\x02\xf2\xd8\xca\x8f\xaf\x7c\xed\xea\x06\x2e\xb5\xbc\x32\xf6\xe6\xbe\xad\xbc\xe0\x17\x9d\x9b\x1b\x51\x75\x7c\x1e\x1c\x18\x07\x73\xb1\x27\x91\x06\xa6\x7c\xde\xc6\x6f\xa3\xbe\xf8\xf7\x4e\xde\xac\xb5\x8f\x8c\xbf\xf9\xf7\x34\xff\x9c\xf2\xa9\xd4\xfb\x43\x7a\x49\xdf\xf4\xcd\xdf\xfd\x7f\x61\xfa\x96\xf7\xad\xeb\xbc\x56\xaf\x53\xd6\xab\x73\x68\x7c\x38\x1d\x2f\x67\x8b\xd1\x74\xa2\xae\x0c\x7e\x37\x99\x4c\x36\xe8\x75\x08\xf7\x3e\x22\xbc\x86\x68\xd3\xa6\x2f\x21\x36\xfd\xea\x12\xbb\x6b\x7d\x39\x31\xbe\x9a\x9e\x17\xf1\x76\xb1\x81\x77\x77\xe4\x75\xda\xb5\xb8\xc2\x67\xae\xcb\xaa\x26\xcc\x4f\x8d\x17\xbb\x4d\xe0\xba\x8f\x6c\x67\xae\xd7\xce\xce\x5f\x7b\xfc\xfa\x55\xaf\x39\x2b\xcf\x6f\x06\x96\xe2\x2b\x1b\xc7\xb9\x51\x90\xfc\x5c\x61\x95\xd6\xeb\xc1\xf8\xfc\x15\x5c\x28\x8d\xc4\x9d\x19\x70\x91\xf5\x98\x25\x09\x3f\x3d\xbf\x8b\x19\xdf\x18\x73\xe4\xf5\xbc\xa7\x61\x56\xa7\xc7\xa7\xf8\x89\x5f\xc2\xc1\xeb\x44\x0d\xfb\x1f\xfe\xb9\xec\xcc\xa6\x3e\xbf\x14\x3c\x14\x1f\x8d\x4e\x71\x96\xf7\x54\x53\x53\xc0\xff\x74\xaf\x3f\x6f\xdc\xdb\x3b\x1b\xfb\x5e\x9f\x44\xcc\x05\x99\x26\xfa\x6e\x0c\xbf\xb3\xc8\x36\x80\x73\x36\x2e\x51\x6d\x50\xe3\x77\xa5\x69\xec\xe6\x9e\x62\x40\xa9\x50\x4d\xda\xa0\x17\x65\x36\x2e\x7d\x53\x7b\x7e\xf0\x20\x93\xbc\x5c\x11\xe7\x79\x6f\x38\xae\x7a\x29\x5b\xb8\x33\x3e\xf2\x08\xe8\x66\x50\xb4\x41\x84\xab\x25\x1f\xe4\x07\x23\xc0\xa5\x59\xf4\x3d\xbf\x37\x2c\x25\x2f\x0c\xf8\x98\x4f\x5c\xf5\xb7\x72\x01\xa2\x48\xdf\xf7\xdb\x34\xd7\x98\xec\x01\xa6\x29\x53\x8e\xaa\x1c\x14\xa7\xfc\x5b\x73\x55\x24\xde\xb2\xea\x4b\x9d\x6e\x14\x87\x19\x1e\xcd\xc3\xb3\x75\x0f\x54\x5f\x41\x1c\xf0\x52\x70\xdb\x79\x23\x3e\xc4\x94\x6c\x32\x1a\x6b\xd1\xdc\x3d\xd4\x3d\x4a\x35\xe7\x17\x96\xf4\xbd\x73\xa4\x46\xce\x14\x43\x1b\x8f\x57\x65\x3b\x45\x99\x90\xb7\x2d\x7b\x73\xed\x18\x5e\x2a\x96\xf4\x08\xed\xfb\xb9\x64\x7e\x4c\xdf\x15\xbe\x82\xcb\xbe\xc3\xc3\xa8\x3f\x3c\x8c\xd9\x7f\x27\xec\xbf\x53\xf6\xdf\x19\xfb\xef\xbc\xcf\x8a\xe5\xad\x33\x56\xc6\x8a\x0e\x73\xda\xb4\xa8\x8b\x30\x33\x78\x11\x66\x38\x02\xf7\x9f\x59\x5f\xbd\xa1\x38\xf8\xd1\xe7\x3f\xf5\xaf\x71\x53\x38\x36\x85\x93\xa6\x70\x62\x0a\xa7\x4d\xe1\xd4\x14\xce\x9a\xc2\x99\x29\x9c\x37\x85\x73\x55\xd8\x74\x6e\xfa\x6e\xba\x36\x3d\x37\x1d\x9b\x7e\x9b\x6e\x4d\xaf\x4d\xa7\xa6\xcf\xa6\x4b\xdd\xe3\x35\x7c\x4d\x48\x4d\xc4\xc5\x62\xe1\x08\x41\x33\xbe\x45\xd9\xb9\xe3\x7a\x29\x43\x1f\xe5\x88\xe5\x3f\xe7\xb3\xef\x6f\x8e\xda\x68\x6d\xb1\xa8\x1f\x91\xd4\xbf\x4c\x9e\x2f\x12\x8b\xce\x8b\x20\x78\x7f\x18\x59\x85\x13\x61\x92\xb9\x0c\xc6\x76\xa9\xa4\x78\xc2\x25\x63\x25\x6d\x98\xca\x71\x30\x12\xec\x45\xc5\x52\x94\x32\x3e\xcc\xae\xae\xd3\xbf\x09\x1e\xcd\xed\x52\xee\xd8\xce\xc6\xa7\xf5\xa2\x9e\xe4\x4d\xce\xaf\x93\x22\xf6\xcd\x6a\x39\xd7\x7f\x2a\x15\x9b\x78\x13\x70\x7a\x53\x17\x86\xdf\x1e\x19\x1a\x69\x42\x16\x73\x46\xdd\xbb\xab\xec\xc0\x1a\x09\xb3\x69\xb7\x9b\x62\x95\x97\x67\x82\xf3\x89\xc7\x5b\xfb\x22\x43\x85\xb9\xa4\x3d\x4e\x8f\x98\x6b\xd9\xed\x97\xe9\xe4\x36\x14\xcb\x01\xbe\x7a\x95\xf7\x80\xa5\x83\xe6\x7f\xab\x2a\xb1\x58\xb5\xeb\x44\x81\xaa\x94\xe7\xb0\xed\x5a\x59\xa2\xaa\xd5\x49\x6a\xbb\x5e\x15\x29\x80\x53\xf1\xa5\x8c\xcf\xd7\x2f\x07\xe6\xdd\xc5\xf5\x6d\x7e\x79\x8f\x17\x69\xba\xf8\xe7\x02\xfe\x05\x1f\xe6\x5b\x30\x15\x0a\xf0\x72\x3e\xe3\x80\xa6\x42\x53\x1c\x9f\xc5\x99\xf7\xbf\x7a\x90\x4d\x8d\x02\x3d\x5e\xf8\xed\x68\xdb\x00\x88\xe2\x73\x99\x89\xb4\x2a\xce\x42\xec\x16\x3b\x95\x7a\x01\xe6\x16\xba\xab\xb1\xe5\x3c\x5a\x45\x0a\x67\x75\xd9\xed\xd2\xaa\x32\x38\x77\x8b\xf9\x24\xd1\x38\x55\xa5\x8b\x53\x17\xba\x38\xb7\xb3\xe9\x78\xa7\x70\xf2\x13\x9b\x06\xe1\x68\x11\x2d\xf7\x1a\x21\xaf\x71\xb1\x89\x12\x17\xd5\x74\x36\x9e\xaf\x14\x2a\x75\xbe\x4d\xd7\x2d\xe3\x79\x32\xd9\x6a\x6c\xaa\xd2\x45\xa8\x0b\x1d\x9c\xf3\xf9\x6c\x64\xc8\x4b\xd8\x36\xaf\xa9\x8a\x57\xd3\xe9\x74\xac\x51\xca\x3a\x17\xa3\x2a\x73\x10\x2e\xa7\x93\xd9\x64\x7a\x1b\x6e\x9f\xa1\x54\xc4\xc2\xc9\xd3\x79\x23\xab\xa6\x81\xe9\xc4\x2a\x92\x7d\xf8\xcd\xb5\xc8\x18\xa8\x16\x98\x0f\x94\xec\xf7\x51\xb2\x94\x7d\x40\xc9\x59\x45\x54\x1f\xbb\x51\x3a\xde\x4e\x44\x1f\x42\x80\x48\x07\xab\x34\xd9\xab\x41\x38\x92\xd4\x7f\x53\xa8\xe3\x3d\x6b\x9a\x0a\xd4\x5a\xa0\xa4\x59\x88\x2d\x28\xbb\x03\x57\xae\x48\xf3\x45\xba\xdb\xce\x44\x1f\x4a\xc0\x08\xcc\x98\x2d\xb0\x53\xd9\x05\x90\x74\x53\x42\x75\x90\x4e\xb7\xab\x2d\xd3\x4b\x71\x97\x5e\x7e\xfa\xd4\x96\x4e\x5b\xe0\x95\x71\x64\x6b\x9e\xca\x86\x19\x6b\x6b\xcd\x69\xa7\x07\xb2\x56\x9b\x45\xde\xbf\xe4\xb6\x3b\x8c\x30\x5f\x58\xe4\x3d\x06\xc8\xfe\xf7\xc2\xc1\x7b\xa2\x51\xaf\x69\xa7\x40\x99\x86\x88\x4b\x61\x97\x93\xb8\x8e\x6c\xf2\x5c\xc8\x18\x01\xb7\xfe\x55\x73\x53\x99\x5f\xf5\x17\x05\x72\x1f\x01\x61\x15\x66\xf1\xd7\x60\x26\xb6\x0e\x74\xe3\xa7\x3c\xc3\xb7\x25\x1a\xa9\x0c\x3c\xcc\x9a\xd5\xb2\x44\xcc\x0a\x6e\x49\x70\xf4\x9c\x81\xb7\x24\xe9\x27\x6e\xe2\x97\x66\xef\x72\x63\x35\x5e\x76\x25\xe3\x1a\xe5\x60\x02\x6e\x2e\xc9\xad\xc8\x5a\x8f\xe3\xca\x8b\xb8\x16\x6e\x48\xaf\xf6\xe7\x11\xba\x9c\x4f\xe3\x52\x82\x41\x0f\x25\x0b\x4c\x83\x34\x67\x2b\xed\x2a\xab\x36\x98\xaf\x01\xdd\xbb\x74\x8f\x96\x7c\xf0\x32\xc3\x45\x12\xd7\xf1\x80\x41\xb2\x4a\xb6\x99\x95\xf9\x2e\xfa\x76\x0e\x2a\xb5\x6e\x3f\xa4\xf9\x79\x43\xe5\xa3\xea\x49\x67\x92\x9d\x32\x71\xd5\xbc\x3a\x5a\x3e\x7c\xc5\x76\x8d\xa4\x07\x6b\x52\x5f\x18\xe7\xce\xd5\xb2\x67\x2d\x3c\xc5\xda\x04\x2e\x41\x16\xc3\x59\xa3\xff\x5a\xe2\xb6\xf6\x37\x88\x99\x4e\xaf\x73\x7e\x61\x63\x77\xc8\xf2\xa4\x6f\x55\x9c\x89\xf2\x8b\xdd\xc0\x9b\x09\x16\xa0\x5a\xb5\x58\x25\x2a\x97\x99\x55\x22\x97\x34\xee\x8e\xdd\x49\xa4\xd5\x12\x8f\xe1\x8c\xf5\xba\xd4\x97\xe8\xbd\x9e\x91\x0a\xf4\xc0\xfb\x0f\x7f\x1a\x47\xa3\x69\xef\x4f\x51\xf4\x2f\xd1\x0f\xcc\xb6\x19\xf0\x41\x99\x32\xfd\xaa\x6c\x0c\xc3\xf3\x25\xcf\xd5\xa2\xc9\x9d\x76\x23\x6f\xde\x45\xbe\xd2\xea\x0d\xb5\x9e\xa8\x96\x94\x1c\x01\x46\x18\x19\x60\xbc\x18\x84\x3b\x70\x0c\x82\x60\x99\x35\x2e\x92\xad\x36\x0c\xc5\x61\x1b\x06\x67\xf6\x0f\xa1\xa1\xc9\xa8\x63\x60\x64\x34\x80\x8d\x20\x38\xae\x10\x88\xd3\x4b\x68\x54\x6e\xde\x9a\x1f\x84\xee\xf4\x84\x1e\xfd\x70\x63\x3a\xc0\xcf\xe1\xd0\x1b\x07\x3b\x8b\x05\x61\x6f\xc3\xf9\xd2\x7e\x9f\x9e\xf2\xa2\xff\xfb\xe2\x14\xef\x8a\xfe\x6f\x45\x8c\x3c\xae\xfa\x6f\x7e\x5b\x5c\xca\x2c\x2d\x7b\x7f\x48\xbf\xbc\x69\x32\xa9\x09\x5c\xc6\xa2\xb0\x9d\x4e\x6f\xea\xd8\x0f\x6e\x93\xf4\xea\x64\x31\x9e\x4d\x53\x6c\x37\xb1\xda\x8f\xf7\x53\x3f\x2a\x75\x63\x24\x76\x43\x4d\xad\xd8\x26\x00\xe9\xc4\x0a\x75\x59\xf9\x8d\xb2\x53\x95\xd6\xcc\xf6\xf1\xa8\x0f\xfb\x3f\x2b\x20\x3c\x1c\xcf\xde\x6d\x3a\x43\x72\x82\x7b\x36\xd1\x76\xf6\x3f\x11\xd4\x03\x6e\x8e\x4a\xc3\x04\x93\x2f\x89\x9c\x77\xae\x65\xd3\x5d\xac\x84\x7d\x06\x9b\x4b\xbb\xdb\x49\xa7\x40\xf4\x17\xc6\x27\x99\x5e\x68\xad\x92\x0c\xe5\xb9\x2c\xe4\x5e\x4e\x95\xf1\xbf\x31\xf9\xcd\xf8\x3f\x24\xd6\xb8\xdb\xed\x10\xa9\xb2\xb1\xf4\x1c\xad\x89\x90\xf8\xb5\x13\x56\x72\xfc\x2e\x6b\x2e\x68\xda\x84\xd2\x3e\x82\x6e\x99\xc5\xe3\xcd\xaa\x5d\xc9\x4f\x92\xf2\xf4\x45\x3c\xd8\xaa\x18\x32\x99\xda\xab\x83\xc1\xb7\xb5\x04\xbb\x0d\xf9\x04\x8c\x33\x2b\x93\x1d\x69\x8c\x47\x8d\x0c\x14\x8c\x15\xba\x93\x20\x22\x4e\x47\x2f\x62\x9a\xbe\x54\xf9\x4c\x2c\x1c\xfc\x06\xab\xd5\x18\x6d\xb0\x5a\x10\x0d\x46\xe3\x28\x42\x5b\x8c\x46\xb2\x49\x53\x31\xd8\xe7\x97\x2c\x79\xb5\xd1\x0e\xcb\xe2\xcb\xd5\x81\x1b\xd8\x4d\xe5\xba\x94\x97\x70\x12\xf2\x41\xfe\x3c\x18\xf5\xcd\xaf\xa8\xf9\x69\x95\x8e\xcd\xcf\xe6\xd7\xc4\xfc\x9a\x9a\x5f\x33\xf3\x6b\x6e\x7e\x2d\xcc\xaf\xa5\xf9\xb5\x92\xbf\x8e\x89\xee\x9a\xff\x8a\x9a\x9f\x56\xe9\xd8\xfc\x6c\x7e\x4d\xcc\xaf\xa9\xf9\x35\x33\xbf\xe6\xe6\xd7\xc2\xfc\x5a\x9a\x5f\xaa\xeb\xea\xa8\xbb\xe6\xbf\xa2\xe6\xa7\x55\x3a\x36\x3f\x9b\x5f\x13\xf3\x6b\x6a\x7e\xcd\xcc\xaf\xb9\xf9\xb5\x30\xbf\x96\xe6\x97\xea\xfa\x6b\xa5\xbb\xe6\xbf\xa2\xe6\xa7\x55\x3a\x36\x3f\x9b\x5f\x13\xf3\x6b\x6a\x7e\xcd\xcc\xaf\xb9\xf9\xb5\x30\xbf\x96\xe6\xd7\x0a\xc9\xde\xc4\x75\xd5\x0f\xc6\x07\xd5\xef\xf6\x37\x1c\x40\xb3\xbd\x68\xa8\x18\x5f\x9b\x2f\x37\x4d\xe9\x48\xcf\xcd\xd1\x70\x2e\xff\xb3\xb0\x6a\x23\x55\xbb\x9c\x0c\x27\xea\x3f\x4d\xed\xca\xd8\x81\xa6\x6c\xa9\xca\xe6\x73\x04\xdd\x42\x55\xce\x96\x08\xb6\xb9\xae\xb4\xa8\x9b\xa9\xb2\x29\x46\xdc\x54\x55\x4e\x30\xda\x26\xaa\x72\x6c\xd1\x66\x18\x80\xd1\xa6\xf9\x80\x91\x26\x16\x3f\x8c\x7f\x4a\xda\x36\xff\x64\xd5\x48\x55\xa1\x4c\x94\x20\x91\x02\x41\x39\x29\x40\x56\x0a\xc2\x66\xa7\xa8\x58\xaa\x0a\x94\xa7\x02\x62\xa1\x20\x66\x24\xf5\x73\x0d\x01\x69\x9f\xa9\x8a\x29\x49\xfa\x54\x41\x4c\x48\xca\x27\x0a\x62\x0c\x29\x37\x2c\x23\x29\xd7\x9c\x23\x09\xd7\x7c\x93\xd6\xda\xd4\x54\x07\x2e\x10\x39\xd7\x5c\x79\xf0\x9a\x91\xac\x21\xc4\xc1\x21\x22\x09\x41\x48\x83\x41\xac\x24\x80\x2b\x0c\x56\xbe\x94\xe5\x84\x2c\x18\xc0\x42\x02\x10\xa2\x60\x00\x73\x05\x00\xa9\x9e\xc9\xf2\x29\x49\xf4\x54\x02\x4c\x48\x9a\x27\x12\x60\x0c\x69\xd6\x8c\x22\x69\x56\xfc\x22\x49\x56\xdc\x72\x64\x20\x3f\x89\x73\x29\x38\xc1\x04\x5b\x18\x1a\x64\xe4\x80\xa0\x52\xd1\xa0\x91\x03\x8a\x8a\x47\x81\xae\x1c\x48\x5b\x4e\x0a\x60\xe9\x00\xa0\x02\x53\x90\x0b\x07\x12\x95\x9c\x82\x9c\xbb\x90\xfe\x58\x67\x0e\xc0\x34\x30\xd4\xa9\x03\x39\x09\x8c\x74\xe2\x40\x8e\xfd\x91\x02\x11\x04\x46\xea\x4a\x22\x30\xd0\xa8\x73\x68\xeb\x6f\xb6\x40\xf0\x9c\x9c\xe8\xc5\x73\x72\x82\x0c\xd2\xc9\x09\x7a\x49\x27\x27\xba\x01\x4e\x8e\x13\x41\x3a\x39\x4e\x2b\xe9\xe4\xf8\x90\xa0\x93\xe3\x03\x26\x9d\x1c\xe7\x0b\xe9\xe4\x38\xfb\xa0\x93\xe3\xcc\x25\x9d\x1c\x1f\x2a\xe5\xe4\x58\x1d\xe5\xe4\x4c\x15\xed\xe4\x0c\x08\xed\xe4\x34\x88\xe7\xe4\x74\x05\xed\xe4\x34\x04\xed\xe4\x34\x84\xe7\xe4\x74\x05\xed\xe4\x34\x04\xed\xe4\x34\x84\xe7\xe4\x74\x05\xed\xe4\x0c\x5f\x28\x27\xa7\x01\x7c\x27\x27\x6a\x50\x27\x67\x6a\x48\x27\x67\x20\x48\x27\xa7\x21\xa0\x93\xd3\xe5\xa4\x93\xd3\x00\xa4\x93\xd3\x00\xd0\xc9\xe9\x72\xd2\xc9\x69\x00\xd2\xc9\x69\x00\xe8\xe4\x74\x39\xe9\xe4\x0c\x3b\x08\x27\xa7\xeb\x3d\x27\xc7\x2a\xda\x9c\x9c\x05\xd2\xe6\xe4\x2c\xd0\x36\x27\xd7\x80\x12\x4e\xae\x01\x68\x73\x72\x0d\x64\x9b\x93\x6b\x20\x09\x27\xd7\x00\xb4\x39\xb9\x06\xb2\xcd\xc9\x35\x90\x84\x93\x6b\x00\xda\x9c\x9c\xc5\xdf\xb0\x93\x6b\x00\xa1\x93\x0b\x86\x32\xfe\x46\x3b\x70\xcf\xcb\x89\x5e\x3c\x2f\x27\xc8\x20\xbd\x9c\xa0\x97\xf4\x72\xa2\x1b\xe0\xe5\x38\x11\xa4\x97\xe3\xb4\x92\x5e\x8e\x0f\x09\x7a\x39\x3e\x60\xd2\xcb\x71\xbe\x90\x5e\x8e\xb3\x0f\x7a\x39\xce\x5c\xd2\xcb\xf1\xa1\x52\x5e\x8e\xd5\x51\x5e\xce\x54\xd1\x5e\xce\x80\xd0\x5e\x4e\x83\x78\x5e\x4e\x57\xd0\x5e\x4e\x43\xd0\x5e\x4e\x43\x78\x5e\x4e\x57\xd0\x5e\x4e\x43\xd0\x5e\x4e\x43\x78\x5e\x4e\x57\xd0\x5e\xce\xf0\x85\xf2\x72\x1a\xc0\xf7\x72\xa2\x06\xf5\x72\xa6\x86\xf4\x72\x06\x82\xf4\x72\x1a\x02\x7a\x39\x5d\x4e\x7a\x39\x0d\x40\x7a\x39\x0d\x00\xbd\x9c\x2e\x27\xbd\x9c\x06\x20\xbd\x9c\x06\x80\x5e\x4e\x97\x93\x5e\xce\xb0\x83\xf0\x72\xba\xde\xf3\x72\xac\xa2\xcd\xcb\x59\x20\x6d\x5e\xce\x02\x6d\xf3\x72\x0d\x28\xe1\xe5\x1a\x80\x36\x2f\xd7\x40\xb6\x79\xb9\x06\x92\xf0\x72\x0d\x40\x9b\x97\x6b\x20\xdb\xbc\x5c\x03\x49\x78\xb9\x06\xa0\xcd\xcb\x59\xfc\x0d\x7b\xb9\x06\xb0\x83\x97\xb3\xe2\xef\x7f\xa3\x18\xb7\xe7\xe6\x44\x2f\x9e\x9b\x13\x64\x90\x6e\x4e\xd0\x4b\xba\x39\xd1\x0d\x70\x73\x9c\x08\xd2\xcd\x71\x5a\x49\x37\xc7\x87\x04\xdd\x1c\x1f\x30\xe9\xe6\x38\x5f\x48\x37\xc7\xd9\x07\xdd\x1c\x67\x2e\xe9\xe6\xf8\x50\x29\x37\xc7\xea\x28\x37\x67\xaa\x68\x37\x67\x40\x68\x37\xa7\x41\x3c\x37\xa7\x2b\x68\x37\xa7\x21\x68\x37\xa7\x21\x3c\x37\xa7\x2b\x68\x37\xa7\x21\x68\x37\xa7\x21\x3c\x37\xa7\x2b\x68\x37\x67\xf8\x42\xb9\x39\x0d\xe0\xbb\x39\x51\x83\xba\x39\x53\x43\xba\x39\x03\x41\xba\x39\x0d\x01\xdd\x9c\x2e\x27\xdd\x9c\x06\x20\xdd\x9c\x06\x80\x6e\x4e\x97\x93\x6e\x4e\x03\x90\x6e\x4e\x03\x40\x37\xa7\xcb\x49\x37\x67\xd8\x41\xb8\x39\x5d\xef\xb9\x39\x56\xd1\xe6\xe6\x2c\x90\x36\x37\x67\x81\xb6\xb9\xb9\x06\x94\x70\x73\x0d\x40\x9b\x9b\x6b\x20\xdb\xdc\x5c\x03\x49\xb8\xb9\x06\xa0\xcd\xcd\x35\x90\x6d\x6e\xae\x81\x24\xdc\x5c\x03\xd0\xe6\xe6\x2c\xfe\x86\xdd\x5c\x03\xe8\xb9\x39\xf5\x06\x50\xe8\xe1\x45\xf5\xf6\xa4\xf9\x9a\xcc\x8f\x06\x2e\xad\x6f\x79\xea\xe4\x0a\x2f\x6a\x0e\x60\x6d\xe0\x39\xf2\xfa\x80\x1c\x2d\x17\x9d\x5b\x57\xa5\xc0\xcd\x29\xe4\xf8\xa1\x6c\xf3\x54\xf3\xdb\x7d\x4f\x75\xf9\x64\x9e\x15\xb2\x8a\x0e\xa6\x88\x1f\xd4\x01\x50\xa6\xa8\x81\xe2\x07\x48\x01\x94\x29\x6a\x9e\x09\x5b\xd2\xc7\x2f\xc0\xc5\x36\xc6\x20\xe2\x4a\x53\x92\x24\x37\xa4\x0b\xf8\xc2\xa3\x18\x2f\x38\x39\x38\x46\xb1\x28\xd9\xbc\xd7\xd8\xd6\xfb\xac\xd4\xc7\xf0\xac\xf1\x84\xc1\x0c\x27\x98\xf8\xc4\x03\x58\xad\xe8\xc2\x70\x2e\x67\xdd\x3a\x0a\x65\x3b\xe8\xc1\x7a\xed\x6a\x1d\x39\x8a\xf0\x5e\xfc\xaf\x5d\x8f\x72\xab\x37\x24\xb4\x5d\xdc\xd5\x54\xaf\x53\xed\x0a\x9e\x79\xbd\x4a\x13\x44\xc7\xd0\xca\x03\x52\xe9\xe9\x1d\x5a\x89\xb5\xf4\x74\x11\xad\x6c\xb4\x72\x66\xe6\x84\x79\x57\x0b\x7f\x54\x0b\x42\x61\xc3\x43\xea\x0e\x7e\x9d\x3f\x38\xa4\x0e\x69\xe7\x0f\x0d\xa9\x3b\x74\xa5\xfe\x2e\x6c\x6a\x12\x29\xdb\x32\x6e\x78\x56\xd5\x65\x76\xb6\x06\xbc\x3e\xd5\x07\x66\x2b\x07\xfc\x11\xac\xb7\x45\x92\xbc\xbb\xa2\xa7\xdc\xd8\x3f\x8d\x41\x1c\x51\x6f\xda\x93\x47\xe2\xc5\xd1\x2a\x69\x6e\x7b\xac\xec\xe7\x1d\x4f\xb7\xf0\xe3\x4f\xdc\x3c\x7f\xf4\x6e\x10\xba\x6f\xd3\xed\xf8\xdb\x1a\xa7\x8d\x5c\xfc\x8b\x53\x64\xfa\x3d\x36\x07\x4b\x5f\xbf\xcd\x76\x17\xee\x34\xcf\x6d\xcc\xc0\x98\x0e\xe5\xdd\x47\xc4\xcc\x9a\x9a\x83\x6f\x80\x93\xa1\xbe\x32\xe9\x99\x66\x58\xa3\x14\x06\xe9\x07\xd6\x60\x56\x9d\xc0\x86\xf4\xa3\x54\x02\xe9\x07\xd6\x60\x7e\x81\xc0\xd6\xf4\x43\x4b\x1c\x55\x13\xd5\x6a\xad\x4a\x8d\x0a\x07\xa1\x0e\xfd\x80\xca\x3d\xb9\x24\x02\x98\x66\x08\x12\x9a\x82\x3a\x38\x50\xd8\xc5\x8b\x25\xff\xe7\x69\x89\xba\xcf\x82\xa9\x89\xa9\x42\xf5\x44\xd5\x62\x8a\x02\xab\xb4\x3e\x20\x7d\x79\x55\xa8\xae\x10\x08\xb1\xbe\xb4\x4e\x20\x7d\x79\x55\xa8\xbe\x10\x08\xad\xbe\xe8\x4b\x43\xb8\x2e\x38\x57\x86\x68\x95\x01\x60\x2d\x3a\xe3\x92\x89\x28\x8d\x83\x8e\xd6\x9a\xb6\xfb\x4c\x49\x94\xae\x76\x73\x4f\x6d\xf8\x45\x25\x4c\x67\x64\x39\xaa\x30\xbc\x0a\xd3\x16\xa7\x5c\xeb\x03\xc4\xef\x96\xa3\x4a\x82\xe1\xf1\xf0\x6b\x1d\x80\xf8\xdd\x72\x54\x31\x30\x3c\x1a\x3f\x7d\xcd\x0b\x97\x75\x73\xcf\x8b\xd6\x07\x1b\xa6\x45\x19\x2c\xd2\x10\x4d\x68\x10\xd1\x6a\x10\xbc\x78\xb6\x9b\xa6\x93\xfd\xc4\xd3\x01\x75\x97\x0c\x53\x03\x53\x85\x6a\x82\xaa\xc5\x94\x01\x56\x69\xb9\x23\x7d\x79\x55\xa8\x56\x10\x08\xb1\xbe\xb4\x0e\x20\x7d\x79\x55\xa8\x86\x10\x08\xad\xbe\xae\x81\x7b\xbc\x98\x0e\x38\xd7\xf5\x68\x55\x01\x60\x2d\xda\xe2\x92\x89\x28\x8c\x83\x8e\xd6\x99\xd6\xbb\x84\xf1\x7e\xbc\xdb\x79\x6a\x23\x2f\x08\x62\x5a\xa3\x6b\x50\xa5\x91\x95\x98\xce\x80\x1a\xad\x17\x7e\x3f\xb0\x06\x55\x18\x1c\x1b\xd2\x8f\xd6\x09\xbf\x1f\x58\x83\x2a\x0b\x8e\xad\xe9\x87\xbe\x78\x89\xeb\x80\x7d\xef\x92\xd6\x14\x17\xaa\x45\x51\x1c\x12\x11\x3d\xb1\x91\xd1\x6a\xd2\x76\x21\x74\xbb\xdb\x19\x2d\xb1\xf2\xc2\x5c\xad\x23\xc9\xc3\x68\xf4\x7d\x73\x3b\xe0\xab\x73\x90\x5f\x26\x7d\xef\xc5\xa7\xa4\xf7\xb6\x09\x42\x2c\xe6\x0b\x11\xf0\xf7\xb0\x92\x31\x0a\x71\xc8\xd9\xba\x81\xa0\x6e\x28\x0e\x8e\x95\xb9\x84\xa8\x2e\xf6\xf0\x22\x4e\x01\x83\x10\x8f\x86\x88\xab\x0a\xdb\xb8\xdc\x04\xf7\x3f\x0d\x0d\x4f\x6a\x33\xeb\xdd\x3a\x25\x00\xb1\xfd\x5e\x08\xe8\x10\x00\xf2\x77\x80\x21\xa0\x10\x26\x7f\x17\x17\x02\x3a\xe0\x39\x02\xf0\x76\xde\x7e\x38\x6a\x07\xb5\x99\x64\x47\x1f\xfa\xf7\xb4\x3c\xdc\xdb\xb2\x61\xe7\xc3\x2d\xef\xee\xb3\x61\xfc\xc3\x2d\x9d\x3e\xaf\xe0\x5e\xe2\x5d\x9c\xb6\xee\x94\xde\xc7\xe8\xfb\x1a\x5a\x7c\x7e\xb0\xe1\xbd\x3d\x5a\x5c\x7e\xb0\xa1\xdd\xe3\xd5\xb9\x17\x7a\x0f\x93\x2d\x24\xa1\xa9\xd6\xd2\xf0\x70\x07\xaf\xee\xef\x11\x6b\x08\xe2\x37\x3c\x60\xbd\xcf\xd2\x3c\xa9\xd2\xfa\xda\x7c\x98\x8d\xfc\xb4\x4f\x51\x93\xd0\x29\x4f\x9f\xd3\x53\x02\x2e\xdd\x59\x06\x1c\xb6\x25\x52\xb8\xf0\xa4\x2b\x58\x32\xb6\x8d\x77\x47\xb1\x49\x68\x85\x64\x19\x98\xf1\x7f\xb7\x3c\xde\xa6\xdd\x92\x87\xb9\x34\xcd\x40\x1a\x19\x7e\xc1\x5e\x24\x97\xfb\x99\x07\xac\x7e\x92\x4f\x5f\x7f\x7c\xed\x3c\x7c\x56\x0f\xe2\x0d\x09\x56\xf6\xb1\x6f\x15\xf2\xbb\x79\xc5\x47\x9d\x17\x67\x2a\x2e\x54\x1a\x6e\xaa\x08\xf8\x9f\x56\x0e\xf3\xe4\xb5\x56\x1b\x33\xcf\x72\xfd\xd1\x95\xd2\xcd\xe9\x83\xf9\xff\x8f\xa4\x14\x6f\x32\xb9\xde\xcf\xc7\x4b\x5e\x67\x67\x7e\x1f\x5f\x15\x70\xd9\x7d\xbc\xda\x09\xde\x60\x9f\x2a\xed\x04\x36\x48\xbf\x4a\x0e\xf5\x55\x32\xe4\xb1\x32\x86\x16\xbf\x0c\x2a\xb8\xb6\x70\xaf\x7f\xb6\xe7\x21\x9c\xcd\x66\xb7\x21\xcf\x16\xc0\x63\xc6\x35\x5b\x4f\xd0\x4a\x6f\x6e\x4c\x5a\x39\xd3\xe6\x6c\x18\x3c\xc5\xd1\xdd\x9d\x52\x29\xeb\x9a\xd2\xec\x18\x3f\xa7\xfa\x42\x6c\xa7\xcb\xa5\xa1\xdb\xbd\xbc\x2d\xff\xaf\x7d\x69\x37\x5a\xe0\xf7\x7b\x49\x58\x24\x51\x9e\x22\x42\x0c\xc1\x4e\x76\xd7\x1b\x8e\x66\x55\xdf\x27\xc8\x83\x01\x69\xf5\xc2\xf8\x42\x78\x5e\x03\x89\xab\x0a\x4a\x65\x6d\x6c\x3c\x15\x4f\xbc\x4f\x57\x5a\x3b\xd7\xd1\x23\x4c\xef\xf3\xab\xca\x4b\x5d\x31\x8a\xc6\xfd\xd1\x62\xd6\x1f\x4f\x26\xfd\xe1\xfc\x2e\x89\x04\x11\x81\xc1\xac\x85\x0d\x63\xaa\xbd\x4b\x0f\xe2\x49\x36\x9d\xf1\x67\xb5\x62\xc3\x61\xab\xc3\xac\xfe\xb6\x1e\x81\x46\x7c\xc5\x2d\xa6\x32\xd1\xd0\xeb\x43\x31\xe3\xae\x36\xac\x0f\xf9\x7a\xce\xb5\xc3\xad\x66\xee\xc3\xed\xf6\x3f\xb3\xf9\xca\xbd\x62\xf2\xb1\xef\x96\x97\x6c\x39\xc0\x53\x0f\x7e\xec\x6b\xf7\xd7\x80\xf6\xdc\x29\x8f\xec\x8d\xd2\x94\xe2\x89\xd5\x61\x2b\x62\x95\xd4\xe4\x54\xf0\x6f\xb8\x3c\xf3\x56\x72\xd3\xa9\x4c\x5d\x40\xc2\xd8\x42\xe7\x14\x9f\xcf\xac\x24\x3e\xed\x54\x0e\x1b\x64\x27\xa6\x41\xb9\xab\x4f\xd2\xcf\x3c\x2f\xf8\x39\xfb\x9a\xe6\x03\x91\xb4\x74\x1d\xbd\xbb\x5a\xf8\x93\xb8\x4e\x3f\x3a\x94\xd8\x86\x9b\x3f\x8e\x4c\xd7\xf2\xb6\xe2\xf9\x64\x66\x27\xe3\x9c\x86\x3b\xb2\xa2\x83\x5b\xed\xa4\xc2\x99\x88\xbc\x71\x52\x61\xc4\x17\xd2\x41\x75\xec\x41\x1a\xfb\x01\x00\x41\x66\xbf\x05\x83\x45\x69\x08\x54\x12\xdb\xf7\x58\x24\x5b\x54\x47\x9f\x3d\x58\x0d\x64\x0d\x06\xa3\xd8\xa2\xab\x5c\x96\x44\x90\x25\xf9\x73\x0b\x4b\x5c\x00\x84\x25\x3e\x06\x92\x25\x2e\x68\x98\x25\xf9\x33\xc5\x12\xb7\x06\x67\x89\x0b\xe3\xb0\x24\x7f\x76\x58\x32\x9d\x8b\xdb\xfa\x42\x8b\x04\x95\x57\x3f\x88\x70\x1b\xea\x55\x48\x7f\x28\x16\x1d\xc8\x75\x6b\x98\xd3\xb6\x3d\x8b\xa3\xc6\xd9\x13\x0b\x50\x85\x59\xfe\x61\x47\x4a\xc4\xd2\xd7\xb9\xaa\x8d\x64\xb5\x8c\x36\x30\x67\x26\x4c\x57\x6a\x7a\x43\x17\x90\xa6\x5a\xe5\xad\x22\xa0\x24\x89\xde\x12\x4c\x55\x20\x6d\xd5\x6a\xd4\x4f\x18\x6b\x31\x68\x2a\x16\xa4\x4e\xfa\x82\xb1\xc3\xa0\xf7\x90\xfd\xef\x95\x14\x2c\x24\x03\x47\x4c\x8a\x12\x97\xae\x80\xd0\xd0\xd4\x5c\xdd\x79\x8d\xe6\x52\x26\x25\xa0\xc8\x79\x1f\xa6\xf6\xbd\x4b\x3b\x96\x09\x4c\x1d\x06\x13\x09\x9e\x3d\x7f\x81\x0a\x30\x0c\xa7\x84\x89\xb5\x1c\xea\x06\x68\xad\xe5\xb9\x3c\x74\x68\x4b\x59\xd5\x34\xc3\xfc\x19\x64\x4f\x83\xc8\xe1\x4c\x53\x8c\x39\x4d\xc8\x62\x0c\xc6\xe1\x73\x88\x10\xd3\x95\x33\x63\x61\x69\x88\x8c\x00\x88\x3d\xfb\x31\x22\x6c\x37\x37\x90\xa7\x07\x6c\x2b\x61\x6f\x1e\xcc\x86\x05\x1c\x0a\x5b\xf8\xaa\x8c\xe2\x6d\x6c\x68\xa0\x96\x79\x16\x37\xa5\x44\x04\x32\x69\xdd\x1a\x40\xcb\xfb\x6c\xac\xb3\x32\x5e\x52\x9d\xb1\xb7\xc1\x99\xf9\xa9\x86\xd4\xee\x12\xc7\xee\x39\x3b\xb8\x15\x6d\x5c\xa6\x59\x2f\x41\x44\xf2\x04\x66\xe3\x10\xb8\x27\x47\x17\x55\xaf\x34\x1e\xd0\x95\x1a\x1e\xd9\xa1\xef\xcf\xb1\xf6\xd6\x88\x9d\xf5\x13\x00\x6e\x5f\x34\x86\x18\xa1\xf5\xd0\x26\xcf\xd6\xc9\x31\xdc\xd0\xb6\xf3\xe7\xd6\x78\x69\xcb\x41\x6f\x9c\xe4\x7a\x4d\x02\x5f\x93\x35\x18\xa0\x51\x27\x2e\x37\x7e\xca\x78\x47\x79\x40\x27\xde\xb2\x80\x50\x1e\x36\x31\x80\xf2\x34\x88\x3c\x9e\xb1\x35\x0f\xca\xdc\xd7\x1d\x16\xe8\x31\xa0\x43\xf8\x48\xd1\xf6\x5d\x74\x88\x01\xdf\xa7\x43\x90\x1f\x40\x87\x04\x79\xb6\x0e\x2d\x6d\x36\x8d\xee\x61\xd3\x6d\x78\x88\xab\xc1\x3e\x4d\x13\xbe\x0d\xf3\xbd\xbf\x5b\x0f\xa4\xe4\xda\xb6\xe9\x78\x38\x33\x5c\xd2\x84\xfb\x98\xcd\xea\x46\xba\x69\x6d\x17\xff\xca\x9c\x4b\x92\x7e\x5d\x8f\x37\x58\x08\x48\x58\x6e\xdb\x8a\xc3\x3d\xcc\xc6\xcb\xe5\xbc\x51\x6b\x8a\x41\xfa\x99\xfd\x5d\xa9\xc3\x62\x01\x26\xbf\xc7\x29\x87\xab\xf3\x16\x30\x12\x40\xdf\x20\x99\x37\x23\x69\x57\x33\x68\x4c\x3a\xd1\x58\x1d\x5b\xc0\x48\x00\x7d\x91\x25\xb2\xb8\x8d\x9b\x53\xae\x15\xea\x0c\x4d\xcf\x5a\x79\xa2\xc5\x66\xe9\xe6\xd6\xaa\xbe\xd5\x32\xc1\xa9\xe3\x79\x48\xe5\x42\x13\x54\x88\x05\x00\x56\x86\xf5\xe1\x6d\x1e\xb0\x3a\xbd\x1e\x47\x40\xac\xd5\x06\x52\xe1\x34\x04\x19\xb0\x5d\x02\xdd\x68\x87\x13\xcf\x92\xf0\xbf\x74\xe8\x30\x40\x0f\x1a\x65\x93\xf9\xb8\x1f\x8e\xad\x71\x2f\xf6\xdd\x7c\xb1\x1d\xcd\x97\x9b\x17\xb4\x05\x54\xdb\x1a\xce\xec\x4e\x71\x72\x79\xbe\xa1\x4e\xa7\x6d\x30\x8e\x07\x38\xd2\x4c\x06\xa4\x85\x3a\xfb\x01\x55\xde\x2b\x76\xd4\xb1\xa9\xf5\x55\xde\xd4\x41\x95\x37\x15\x96\xca\xbb\x65\x58\x1f\xa8\xca\xc3\x3a\x44\xe5\x35\x88\xa7\xf2\x4e\x05\xaa\xf2\x2a\xab\xba\x4b\x60\x40\xe5\x25\xfc\xaf\xa3\xf2\x28\x3d\x44\x60\x99\xe7\x78\x7f\x99\xca\xef\xa2\x78\x34\xdf\x6e\x5e\xd0\x16\x50\x4d\xaa\xbc\xe2\x21\x75\xaa\x6a\x83\x71\x3c\xc0\x11\x4f\xe5\xed\x16\x69\x59\x16\x25\x54\x78\x50\xe8\xa8\xa2\xae\xf3\x95\x5d\xd5\x40\x55\x57\xc5\x96\xa2\xdb\x25\x3e\x6e\x54\xc9\xdd\x1a\x44\xc5\x25\x80\xa7\xe0\x56\x31\xaa\xde\x2a\xc3\xbf\x4d\x56\x40\xb9\x25\xf4\xaf\xa3\xdc\x08\x35\xa8\x6a\xcb\xd7\x06\x5e\xa8\xda\xe9\x72\xba\x9c\x6c\x5e\xd0\xd6\xa1\x99\x54\x6c\xc5\x3f\xea\x0c\xd8\x06\xe3\x36\xc9\x0d\x4f\xad\x6d\x78\xb3\xa4\x15\xd2\xfe\x3f\x44\x43\x71\x33\x67\xa6\x17\x3c\x6e\x1b\xfd\xba\x52\xa8\x2d\xdb\xe0\x37\xda\x7e\x25\xc3\x9f\x33\x34\xfa\x69\x2e\xa7\x4d\xf8\xbf\x40\xae\x2e\xd1\xbf\x52\x5e\x3b\x48\x4b\x7c\xae\x77\xe3\x74\xc4\x1b\x67\x3e\x4e\xf8\x89\xd6\xc1\x6a\xbd\x20\x75\x2f\x42\xbd\xb9\xc1\xf0\x82\x66\x96\xde\x40\x70\x71\x38\xa3\x53\xdf\x16\x92\x1e\xb6\x67\x43\xe1\x3c\x8d\xed\x0a\xbc\xad\x4f\xd7\x86\x39\x34\x29\x4f\x2e\x93\xed\x1b\xf2\x4e\x13\xc7\xac\x5e\x1f\x11\x66\x63\xc9\x9d\x62\x19\x2d\x0e\xa9\x0c\xf6\xc2\xc2\x7d\x5d\x6a\xc3\xeb\x77\xac\x8c\x2e\x8c\x8e\xe1\x58\xf0\xb8\x3b\x82\x34\x10\x64\x6f\x52\xc0\x3a\xd7\x4b\x5d\x2c\xf4\xde\x17\x4e\x73\xd5\xd0\x7a\x8e\x01\xf2\x19\xab\x32\xbe\xcd\x83\x50\xce\x10\x2f\x87\x8f\x6e\xe8\x50\x66\x48\x4e\xf7\x11\xa8\x3e\x1d\x58\x1f\x58\x16\x66\x67\x6a\xc3\xf9\x5f\x84\xc2\xd9\x96\x5b\x6c\x98\x43\x9d\xa3\xe8\xc4\x50\x8d\x16\x7a\x0f\x3d\x21\xc4\x76\x91\x66\x93\xf9\xf7\x1e\x5a\x41\x54\x81\x26\x9d\x07\x67\x40\x5c\xe6\xf6\x60\x47\x3c\x34\x40\x77\x34\xf7\x82\x88\x8c\x23\xdc\x16\x85\xbe\xe6\x34\x07\x6a\xd0\x8f\x39\xcd\x01\x9b\xe0\xdb\x73\xcd\x81\x1b\x3f\x26\xe3\x9f\x86\x25\xbe\x0b\xf1\x63\x09\x75\x71\xd9\x1d\x06\xfc\x7e\x17\x9b\xaf\xc7\xf8\x94\x9d\x2f\xb9\x78\xfb\x73\x43\xd7\xb8\xdf\x93\xcc\xa2\xe7\x52\xb1\x55\x83\x0c\xd8\xc9\x43\x3d\xe2\x38\x06\x52\x5a\xf9\x85\x5e\x41\xc7\x63\x42\x74\x4e\x78\xf1\xcd\x9d\x09\x43\xdd\x5d\x1b\xca\x43\x5b\x56\xc9\xda\x2a\x69\x7e\xae\x3d\xf0\xb5\x07\xfe\x2a\x07\xbd\x60\xbf\xd6\x4f\xe7\x1d\x2b\x1e\x66\x45\x5f\x72\xb5\x86\x62\xd3\x79\xc5\x39\xd7\xe9\x34\xcf\x84\x8d\x64\x06\xd6\x99\x23\xe2\x89\x04\x0a\x56\xd2\xd5\x7c\x22\x63\x7f\xb5\x9c\x27\xe1\x33\xc6\xff\xd0\xb4\xd9\x67\xb9\x78\x4e\x23\x3f\x1f\xe2\xb7\xea\xa4\xca\x4f\x73\xeb\x8c\x56\xcb\xa3\x0a\xe6\x74\xcb\x70\x3e\xe3\x0f\x4a\xd9\x54\x21\x64\x08\x88\x2b\x1a\xcf\x64\x15\x8c\xfb\xfb\xf8\x92\xd7\xd7\xb6\xc7\x7d\xc1\xea\x59\x5c\x3f\xb0\xda\x5b\x12\xd7\x45\x4a\x9b\x82\x88\xd3\x39\xff\x07\xb7\x9c\x3b\xfe\xcf\x41\xef\xab\x4e\x47\x5c\x71\xc2\xff\xb9\xa4\x5a\xba\x65\xf0\xeb\xb2\xe2\x9c\x9e\x9e\x86\x49\x59\x9c\xf9\x1b\xd4\xcc\x8a\x3c\x3f\xe7\x69\x77\x46\xdd\x49\x03\xc2\x35\x7f\x12\xc3\x1a\x75\x75\xc4\xa7\x1e\x93\x01\x89\x6d\xed\x62\x6b\x1d\xb7\x46\xde\x0a\xb8\xee\x0c\xd8\x41\xa6\xc9\x94\xff\x6b\xd7\x8f\x17\xca\x14\x35\x2d\x6e\x07\x7a\x42\x61\x22\xd3\x75\xeb\x50\x9d\x2f\xb6\x66\x92\x22\x48\x9b\xca\x75\xb0\x52\xa2\x25\xcc\x0f\x10\x5e\x0b\xd4\xba\x1b\x14\x79\x75\xad\xd5\x48\x30\x3c\x71\xf2\x9c\xb6\xbd\x57\x38\x91\x8d\xee\x78\xdd\x10\xf4\x3b\x4e\xe7\x49\x3c\x75\xb0\xd8\x1c\x76\x5e\x41\x0c\xa3\x97\xaf\x1f\x02\xf4\xa3\xf1\x78\x3b\x8d\x1c\xf4\xae\x2e\xdf\x81\x6b\x1c\x4d\x93\x05\x20\xd5\xd6\x65\x8d\xbf\x5d\x97\x3b\xb1\xeb\x4e\x1a\x10\xae\x21\xf6\x09\xd4\x58\x8a\xee\x52\x8f\xc9\x80\xc4\xd6\xd9\x3e\x01\x01\xb7\x02\xae\x3b\x03\x76\x91\xa9\x60\x5d\xbb\x7e\xbc\x50\xa6\x01\xfb\xa4\x3b\xc0\xec\x13\xac\x5b\x87\xea\x7c\xb1\xe1\xf6\xc9\xab\x5c\x07\x2b\xc3\xf6\xc9\x15\x5e\x0b\xd4\xba\x1b\x14\x65\x9f\xba\x9a\x0a\x60\xa5\x74\x33\x3c\x8b\x0e\x6f\x07\x1e\xb2\xc5\x35\x65\xb6\xdb\x2e\x67\x3b\xd0\xfb\x74\x17\xa7\xd3\x9d\x83\xc5\x66\xb5\xf3\x94\x6a\x18\xfd\x74\xba\x4a\xa6\x50\x11\xc7\xb3\xd9\x7c\x3c\x73\xd0\x77\x51\x6a\x14\xd7\x64\xb5\x9c\x4e\x56\x2e\xa9\xb6\x52\x6b\xfc\xed\x4a\xdd\x89\x5d\x77\xd2\x80\x70\x0d\x31\x54\xa0\xc6\xd2\x78\x97\x7a\x4c\x06\x24\xb6\xce\x86\x0a\x08\xb8\x15\x70\xdd\x19\xb0\x83\x4c\x25\xeb\xda\xf5\xe3\x85\x32\x0d\x18\x2a\xdd\x01\x66\xa8\x60\xdd\x3a\x54\xe7\x8b\x0d\x37\x54\x5e\xe5\x3a\x58\x19\x36\x54\xae\xf0\x5a\xa0\xd6\xdd\xa0\x28\x43\xd5\xd5\x54\x00\x43\xa5\x9b\xd1\x86\xca\x7e\x1d\x9b\xb0\x52\xdb\x5d\xe4\x7d\x29\x99\xce\xb7\xcb\x24\x6e\x50\xd8\x4c\x6e\x1e\x63\x6e\xd1\xbf\xd1\x36\x4a\x66\xd0\x51\x6e\xe7\xc9\x72\xd6\x20\xee\xa4\xc8\x18\xa2\xf1\x7c\x15\x6f\x77\x16\x85\xb6\x16\x0b\xcc\xed\x2a\xdc\xce\x9c\x7b\xba\x86\x3c\x42\xac\x91\x5d\x6c\xe9\xb4\x45\xae\xc7\x68\x1c\x49\x67\x0b\x64\x0b\x2f\x0c\xb5\xee\x06\xd5\x65\x85\x24\xf8\xd3\x22\xf8\x87\xe5\x15\xb0\x37\x02\x2f\x66\x6c\x9c\x8a\x35\x59\x01\x44\x82\xdb\x18\xb7\x66\x4d\xd7\x84\xad\x8b\x25\x98\x10\xc8\xba\x03\x08\x69\x54\xba\xcc\x6c\x68\x51\x54\x1b\xda\xa2\xe8\x14\x2d\x41\x15\xd8\x47\x71\x32\x85\x5d\xa7\x69\x3c\x9e\xcc\x1d\x2c\x36\x63\x9d\x67\xd8\xc3\xe8\xd3\xdd\x6a\x31\x82\x5b\xcf\xd5\x72\xb6\x8f\x12\x07\x7d\x17\x6d\x45\x71\x25\xb3\xe5\x6c\x34\x76\x49\xb5\x15\x56\xe3\x6f\xd7\xd9\x4e\xec\xba\x93\x06\x84\x6b\x88\xb1\x01\x35\x96\x72\xbb\xd4\x63\x32\x20\xb1\x75\x36\x3c\x40\xc0\xad\x80\xeb\xce\x80\x1d\x64\x2a\x59\xd7\xae\x1f\x2f\x94\x69\xc0\x14\xe9\x0e\x30\x6b\x04\xeb\xd6\xa1\x3a\x5f\x6c\xb8\x59\xf2\x2a\xd7\xc1\xca\xb0\x71\x72\x85\xd7\x02\xb5\xee\x06\x45\xc6\x90\x3a\x9a\x0a\x18\x49\x52\xcd\x68\x43\xa5\xf2\x03\x85\x15\x65\x35\x9b\x4c\xbd\x89\x37\x9d\xec\x27\xb1\x8d\xc4\x09\xd6\xc9\x3c\x3d\x1d\xac\xd4\x6e\x35\x89\xc6\xd0\x0f\x2e\xe6\xa3\xdd\x68\x65\x23\xef\xa2\xd0\x28\xaa\x78\x37\x5e\xe9\xb5\xbc\xa2\xd3\x89\x89\x4a\xec\x1d\x42\xa2\x1d\x18\x75\x1f\x01\x3e\xbf\xb0\x18\xb7\x53\x61\xc7\x4a\x6d\xc2\x11\xd6\x53\xa8\xba\xc7\xb7\x1d\xa1\xb6\xc1\xad\xbb\xc2\x75\x90\xa3\xe4\x58\xab\x4a\xbc\x48\x8e\xa1\xc8\xb6\xc4\x8e\x06\xb6\xdd\xaa\x75\xa0\xca\x13\x15\x11\xd5\x06\x75\xeb\x50\x5d\x4b\x4c\xdb\x16\x58\x18\x68\xdd\x09\x88\x4c\xe3\xd8\xcd\x1e\x00\x5b\xa4\x5b\xd1\xb6\x28\xcf\x4e\x9f\xae\xde\x6d\x52\x2c\x48\xd5\x3c\x03\xae\xdb\xf5\xcd\x2f\x47\x2d\x78\xc1\x1a\x16\xb4\x7f\x9e\x94\xa4\x04\xaf\xee\x77\x7d\xe9\x1d\xa1\xd0\x23\xc8\x16\xba\xf8\x5b\x71\xde\x66\xb0\x9d\x8a\xbd\xad\xa1\xde\x62\x4c\x66\xe3\xc5\xce\xfb\x98\xcc\xc6\x93\x96\xfc\xdb\x70\xf0\xc5\xf5\x1b\xc2\x2e\xd8\x5d\x67\xd5\xb4\x68\x0d\x82\x38\xe4\x9b\xf4\xf1\xd8\x87\x70\x73\x30\xe4\x89\xff\xa5\x48\x7a\xbe\xbe\xde\xb5\xa6\xa6\x8f\xea\x68\xf5\xd1\x5c\x3e\x7c\xc9\xbd\xbb\x06\xf9\xd7\xca\x42\xfe\xb5\x6a\x06\x20\xbf\xb6\x3f\x88\x1b\x3b\x19\x69\x1f\x7e\x33\x30\xef\x2d\x70\xf7\xe8\xa4\x9d\xb3\x61\x7b\xa9\xeb\xe2\xf4\xb1\x81\x75\x6e\xd4\xa6\x4c\x9a\x44\x5d\x75\xd9\x1e\x33\xbb\xd2\x3d\x82\x17\x27\xe9\x55\x7f\xb4\x8f\xb0\x54\x2c\xaa\x52\x24\x32\xe9\xf1\xb1\xc7\x25\x48\xae\x82\x41\x84\xab\x65\xbf\xc3\xec\x74\xb5\xb2\x61\x30\x95\xcb\xe3\x73\x95\x1a\x9e\x49\x45\xd3\xc5\x1c\xda\xcd\x49\x54\x97\x68\xa5\xca\x6f\x55\x7c\xb9\x89\xd4\x59\x61\x18\xa9\x02\xa6\x17\xbe\x58\xf6\x4f\xd2\x29\x61\x47\x26\x9d\xa0\x49\x26\xe8\xb1\x6b\x50\x67\x47\x7e\x06\x65\x7f\x39\xc9\x43\x3c\x3c\x17\x8c\xcb\x2f\x1c\xa4\x15\x85\xdf\x55\x72\x51\x33\x72\x38\x81\x09\x6f\x40\x1d\xdd\xc8\xc7\x7a\x66\x0e\x3b\x2d\x99\x48\xe4\xa8\xfb\x9f\xb3\x2a\xdb\x66\x39\x13\x12\xe8\x22\x00\xd8\x09\x8a\x31\x9d\x59\xb9\x3a\x74\xec\x36\x6a\x58\xef\x3c\xd0\xc1\x66\x21\x7e\xba\xca\x7a\x77\x80\x27\xbf\x4a\xe2\xea\x90\x26\xb0\x54\x9c\x62\xfa\x93\x09\x38\xab\x4b\x7e\xa1\xe3\x4d\xf2\xa9\x11\x0c\xe2\x66\x16\x38\x7d\xf1\xeb\x72\xc6\xae\x1a\x82\x45\x10\x38\xcb\x14\x59\x00\xc7\xf4\x74\x21\xae\x14\x8a\x6c\x51\xf2\x34\xa7\xb9\x54\xc8\xca\xa2\x8d\x3d\x5f\x36\xcd\xbb\x54\x1b\xeb\xbd\xac\x39\xbc\xac\x6c\xd2\xb3\x8d\x55\x8e\x30\x70\x10\x0e\x3c\x10\xc2\x8c\x5e\x55\xab\x74\x99\xf0\xa8\x98\xb5\x8e\x34\x0e\xd9\xaa\xcd\xb3\xf3\xba\xb9\x8a\xfe\x75\x13\xac\x0b\x64\xa4\xb2\x4a\x9d\xe3\x4f\xe2\xa4\x54\x87\x94\x55\xf2\xd0\x3e\xb7\xe0\x6e\x7b\x70\x45\x21\x00\x06\xc4\x34\x14\xaf\x27\x09\xed\xb9\xea\xcb\x9e\xd6\x23\x3e\x0e\x6c\x8f\x2d\x4a\x3f\x67\x3c\x61\x91\x76\x1c\xe6\x2c\xe2\x7a\x25\xc4\x01\x4d\x0b\x12\x7b\x91\x09\xeb\x5c\xc4\x4f\x79\xf6\x14\xe3\x99\xcb\xb8\x2b\xea\x89\x0c\x16\xbb\x9c\xd9\x5c\xfe\x96\xc9\xa1\xeb\x01\x47\xeb\xe0\x0b\x96\xe7\xd3\x27\x41\x2f\x4b\x90\x1a\x77\x3d\x34\xe7\xff\xd0\x35\xc5\x86\x4e\xdc\xef\x62\xd5\xef\x03\xc4\x7d\xaa\x02\xa7\xa6\xa9\xf6\x36\x40\x1d\xe9\x51\x2b\x60\x6a\xe2\x3e\x99\xad\x07\x42\x5b\x53\x45\x50\x67\x01\xc0\x35\xd8\xed\xe5\xc8\xd0\x11\x22\x27\x00\xc3\xb9\xb2\xd0\x63\x8e\xea\xd0\x20\xb3\xf4\xcf\x59\xb2\xfe\xd7\xff\xf1\x3b\x5e\xf5\x47\xde\x8c\x9f\xea\x1d\xfe\x3e\xdb\x95\x45\x55\xec\xeb\xe1\x33\x9f\xa1\x0c\xcf\xdb\xf4\x24\x88\xfb\x69\x1f\xe7\x55\xca\xe6\x15\xd8\x2a\x0a\x23\xe8\xfa\x7a\x09\x12\x93\x36\xb3\xe3\x3c\x14\x96\xdc\x7a\xef\x6c\xa3\x4f\xc7\x1b\x28\x9e\xee\x93\xb1\xab\x65\x46\x05\x97\x84\x70\x16\xf1\x45\x74\x70\x16\x71\xb6\xf2\x3f\x1a\xc3\xbf\xcf\xbe\x32\x59\xb8\x17\xc9\xcd\x99\x65\xe0\x03\x56\x2b\x46\x7f\x63\x8b\x20\x1f\x09\x96\xf0\xeb\x1c\xc2\xff\xf6\x87\xa7\xf8\xf3\x36\x2e\x07\xa2\x4f\x75\x32\xba\x67\x90\x28\xa8\x2b\x3f\x91\xcd\x44\xb7\x7e\xf3\xc6\x76\xa7\x30\xbf\xa6\xef\x74\xad\x0a\xe5\x77\x9b\xfe\x1d\x42\x5b\xe9\x70\x87\xc5\x7b\x17\x12\x34\xf7\x8a\xfc\x57\xaa\x82\x67\xdd\x55\x6f\x82\x3d\xbd\x76\x9e\x05\xc0\x29\xa5\xb2\xf6\x18\x7d\x6b\xbb\xa1\x57\x2e\x5d\x33\x12\x11\xf7\x50\x7c\x84\xd6\x2e\x46\xdd\xbc\x91\xa7\x6b\x61\x2f\xf6\xd3\x95\x04\x16\x67\x03\x8f\xd4\xaf\xdb\xea\xad\x5d\x2a\x56\x6d\x45\x66\x1a\x52\xfd\x4e\x89\xbe\xf0\x2e\x2c\xcc\x57\x93\x74\xc1\x1a\xa1\xd8\xe3\xbe\x07\x3c\x6a\x0a\x3d\x41\xf5\x9a\x9f\x68\x2b\xab\xca\xbd\x29\xa2\xae\x85\xe8\x8d\x60\x5d\x14\x3c\x9b\xb8\x5b\x3b\x03\xb5\xbd\xa6\x07\xbb\xc4\x26\xca\x94\xdb\x97\xc5\xa0\x2c\x15\xd0\x93\x87\xee\x89\x40\xf7\xe4\xa0\x73\xde\xde\x73\x76\xc9\x92\xbb\xcc\x53\xbc\xb5\x73\x4d\xbf\x93\x25\x4d\xa2\x60\x59\x00\x17\xbc\xef\xae\x68\xd0\xc8\x16\xa6\x95\xbf\x1a\x5c\x5c\xa2\x21\xef\xec\x9c\x99\x0c\x39\x7f\x0d\x19\xae\x91\x02\x95\x5e\xcf\x4d\x47\x3e\x1f\x1c\x3d\x84\xcb\x7d\x0f\xda\xa6\x88\x0f\x93\x22\xc8\xa9\x83\xf4\x10\x1a\x00\x01\x3a\xc8\x4c\x1a\x8a\x16\x11\x29\x6c\x21\xf6\x43\x36\xf5\x1f\x41\x01\x98\xf7\x4a\xc2\x53\x5d\x87\x44\xe8\x2b\xe2\x0b\xa5\xd4\xf3\x14\xc1\xb3\x64\x62\x59\xe3\xc1\xd9\xab\x1c\x77\x18\xef\x3d\x50\x37\x55\xcd\x12\x66\x0b\x5c\xfa\x93\x58\x64\x72\x09\xa3\x19\x8d\x21\x9e\xd1\xd8\x41\x44\xd0\xfd\x6b\x5c\x73\x09\x11\xd0\xc4\x8d\xef\x09\x0d\xeb\x25\x0e\x62\x77\xc4\xa5\x37\x51\xa9\x44\xae\x5e\x50\x56\x04\x42\x4d\x50\x51\x8b\x66\x85\x13\xc0\x11\xf5\x14\x96\x2e\xae\xdc\xad\xa0\xd7\x14\x4f\xd0\xfb\xbb\xeb\xd8\xe6\x75\xb9\x0d\xf9\xd4\xe7\xad\x0b\x5a\xfb\x99\x3a\x02\xfc\x7d\x70\x08\xef\x3b\x8f\xe3\x7d\x07\x56\x20\x4e\x58\xa4\x85\x1c\x81\xbb\x9a\x11\x49\x6d\x07\x2b\x19\x30\x90\x70\xf1\x13\x30\x70\x94\x51\xe1\x51\x0b\xca\xd4\x59\x75\xa8\xb1\xeb\x68\x8f\x00\x95\x21\x5b\xd8\x6a\xfa\xee\x33\xc7\xfe\x00\xc0\xd0\x5b\x94\xee\x15\x7c\x18\x8a\xf6\x41\x67\xf6\x00\x2e\xc2\xab\xbd\x9e\x38\x7f\x11\x07\xe7\x4b\xd9\xee\xff\xcf\x97\xaa\xce\xf6\x59\x9a\xb8\x61\x75\xdb\xb4\xc8\x38\x3b\xab\x62\x1e\x4d\x6d\x6a\x9b\x2f\x6a\x22\x2a\xbf\xae\xd2\x73\x5c\xc6\x75\x8a\x62\xf6\xec\xa0\x5b\x03\x32\x26\xa0\xef\x68\x6a\x72\xbe\xbf\xb5\xa0\x91\x97\x22\x71\x43\x88\xc3\xbb\x9b\xc6\x66\xb3\xf8\x73\x12\xd7\xb1\x92\xb4\xfa\x70\x53\x7d\x14\x2d\xf1\x1b\xfe\xdd\xe0\x55\x82\x56\x1a\xd8\x32\xd0\xf7\xf6\x43\x34\x25\x13\xf8\x8a\xc8\x6d\x99\xee\x6a\xe5\x9e\xa3\x77\x78\x56\x3a\x7b\x6f\x41\x6f\x77\xa5\xda\xd0\x8a\x61\x61\x71\x1f\x54\xb5\xa4\xdc\x2d\x1d\x29\x92\x1c\x03\xa1\xab\x49\xd9\x67\x45\xd3\xc9\x97\x40\x42\xc8\x55\xa4\x5f\x23\x9c\xc0\xf4\xdb\x4f\x20\x4f\x07\xac\x45\xb2\x73\x84\x40\x98\xf8\xa4\x1d\xfc\xe5\xb3\x67\x12\x23\x20\x60\xfc\x71\x74\x00\x44\x47\xd3\x35\x4d\x67\x90\xbe\x00\x34\x45\x69\xc7\x26\x9a\x66\x98\x23\x94\x20\x87\x84\xf2\xc9\xe8\x04\x0a\x59\x26\x63\x57\x6e\x0e\xc5\x90\xce\xf1\xda\x16\x9d\x83\x20\xb0\xcb\x5f\x22\xcf\x2f\x41\x3a\x01\xd3\x49\xd9\x3a\x0d\xa3\x6b\x42\xe1\x20\x7d\x01\xe8\x3b\x95\x8d\xa2\x19\xd7\x0d\x8f\x1c\x12\xaa\xa3\xb2\xb5\xb1\xcc\x53\xb6\x5e\x40\xd3\x10\xcd\xb2\xf7\x2b\x8d\x2b\x0f\x59\xd7\xf6\x65\x21\xd2\xe9\xdd\xad\x1e\xdd\x1e\x74\x18\xae\x5e\x9b\x74\xce\x1f\x82\x60\xbd\xb6\xbd\x1a\x14\xfc\xe6\x67\x3f\x1e\xe4\xa7\x35\xc1\x9f\x30\xe9\xf8\x70\x10\x42\xab\x97\x3e\x9c\xb4\x10\xc8\xa9\x1a\x0a\x5b\x97\x33\x47\xc8\xe9\x22\x0f\x1d\x91\x7e\x29\x08\xe7\x3c\xb8\x35\x50\x99\xd1\x02\xcb\x01\xfb\x81\x3f\x5f\x33\xc9\x6a\xb0\x70\x57\xcb\xe2\x36\x08\x6b\x55\xd7\x02\x0c\xb6\x27\x3e\xb4\xf5\x14\x1c\xdc\x96\xc1\x3d\x53\x6b\xe3\x5f\x3a\x6c\x1b\xe4\xab\xf7\x72\x1f\x2d\x2c\x7b\xef\xe7\xe3\xa4\x6a\x69\x41\x10\x3b\xb2\xf6\xe6\x58\xb8\xb9\x85\xc7\x1d\x25\xd8\x01\x36\x10\x8f\xbd\x23\x28\x1a\xe2\x1f\x7c\xb0\x12\x1a\x49\xe4\x0b\x96\x99\xdc\x11\xfa\x65\x15\xf5\x52\xc8\xb9\x1c\x0c\x4e\xc4\x92\x90\x4f\x39\x18\xac\x09\xe8\xa2\x95\xea\x03\x15\x5a\xe7\x7d\xa6\x7a\xad\xd9\x0e\xd3\x95\x21\xa4\x3f\xa8\x2b\x0d\xb5\x1b\x9f\x3b\xa7\xf8\x33\xc8\x71\xe7\xa5\xda\x02\x47\x88\x44\x9b\xa7\x3c\x6b\x7b\x4d\x47\xc3\x3d\xc5\xad\xef\xee\xb8\x5e\x60\xa6\xe8\x72\xce\xa7\x98\xbf\x03\xa7\x22\x50\xa7\xa7\x51\x59\x27\x2b\x9c\x03\x1a\x5e\xad\xdb\x63\xe8\x74\xc7\xe6\x35\xce\x66\x08\x0a\x7a\xea\x98\x44\xdf\xfe\xc3\x22\xc4\x14\x91\x8f\x37\xa7\xf0\x82\x8b\x3c\xf2\xa2\xb0\xb3\xff\x19\xbc\xde\x41\x26\x2d\x8b\xa7\xec\xf8\x7c\x6d\x82\xd0\x46\x39\x78\xea\xcb\xea\x4a\x3e\xe0\x29\xde\x62\xd6\x60\x5c\x91\xec\xa3\x6f\x8e\xee\x19\x15\xd5\xa0\x4c\x76\xce\x34\x09\x9c\xe1\xb8\x2f\xfb\x59\x4f\x3d\xb9\x09\x7a\xc3\x0e\x93\x73\x5e\xf7\xe4\xff\xc0\x91\x58\x07\x9c\xb0\x52\x4b\xa0\x7e\x9d\xa3\x5c\x7c\x51\xa7\xf4\x48\xa5\xb9\x09\x26\xd0\x02\xdc\x05\x16\x1d\x57\x39\x41\x81\xf8\xd1\x84\x03\xad\x50\x09\x7c\x34\x96\x68\xd2\x88\xcf\x95\xbe\x07\xd5\x48\xce\x7a\x87\xd5\x5b\xb7\x92\x08\xda\x8f\x93\x34\x51\xbc\xe0\xd1\x11\x62\x0c\x48\x24\xb2\x09\x3f\x76\x1f\x14\x3f\x3b\xd2\x01\xba\x39\x19\xe4\xad\xbc\xa9\xf1\xfb\x9a\x45\x41\x40\x2d\x23\xe1\x6c\xdd\x86\xf3\xf3\x01\x1e\xb2\xe1\x85\xa6\x7c\x97\x39\xf7\x2b\x0f\xda\x9d\x20\xe2\x2e\x8d\xa4\xe5\x9c\xe5\x39\xb0\x4c\x6e\x45\x33\x56\x28\x3b\x0d\xf1\x9e\xb5\x06\x47\x9f\x5d\x00\x30\x38\xaf\xd8\x1e\x91\x5f\xd9\x25\xa9\x41\xe3\x00\x78\x42\xe3\xdd\x27\x7c\xb6\x36\x55\x16\xc9\x22\xcb\xb4\xff\x0d\x8e\xb2\x16\xb7\x76\xa3\xf0\xa8\x2d\xf8\x25\x4c\xc0\x7d\x33\xbf\xf3\x84\xb7\x58\x43\x5a\xcf\xc7\x0d\x42\x78\x5e\x74\x99\x13\xbf\x80\x11\x78\x6d\x03\xf0\x2b\x0c\x12\x9d\xf4\xac\xe5\x40\x1d\x94\x14\xaf\xaa\x0f\xce\xf1\x09\xde\x67\x71\x60\x54\xfa\x50\x7f\xc1\x2b\x88\x80\x1a\x0a\x3f\x6d\x3f\xf2\xf9\x4e\x9e\x63\xc4\xd2\x45\x37\x39\x91\x67\xfe\xb3\x87\xe2\xd4\x6b\x68\x2d\xd4\x7a\xd2\x12\xb1\x74\xed\xa7\x33\xd5\x69\x5c\xcb\x7e\x9a\x93\x98\xe6\xba\x10\x38\xba\x32\xf3\x8e\xae\xf0\x12\xbd\x2a\x1d\x7c\x5d\x8b\x1b\x28\x79\x73\xa3\xc6\x54\x55\x3b\xb6\xeb\xcf\xf9\x06\x42\xa4\xe1\xb5\xcf\xb9\xe2\xcb\xbf\x96\x97\x07\x22\x79\x80\x65\x3c\x9b\xf5\xf5\x7f\x87\x23\xf2\x3d\x04\x1c\xda\x1b\xae\xb8\x34\xa5\x29\xfe\xd6\xc1\x4c\x39\xbc\xb2\xb2\xd9\x3b\xa7\x78\x3b\x1f\x97\x81\xd4\x78\x97\xb6\x84\x0a\xff\x26\x3b\x9e\x8b\xb2\x8e\x19\x8f\xac\xd8\xb0\x55\x0a\xde\x26\xb4\x76\x0d\x4a\x3a\x0d\x6c\x1b\x07\x54\x83\x1b\x7e\x7c\x18\xb4\x05\x87\x8c\xd9\xe0\x69\x10\x99\xbd\x1f\x85\x69\x7b\xfc\xf0\xd5\x88\x11\xdb\x22\xf3\x1a\x58\x64\x1f\x68\x66\x15\xea\x21\x66\xf5\xfe\xd6\x92\x55\xbf\x93\xaf\x35\x17\x25\x3f\x66\x2f\x37\x93\x39\x2b\xa9\x76\xf1\x39\x6d\xd4\xe1\x35\xa9\x1a\x47\x91\x48\xc1\xcd\x4d\x5a\xcc\xb6\x50\xac\x5d\x7e\xc9\x98\xd5\xf4\xd0\x52\x10\x72\x8e\x5b\xf5\xa1\xb6\xa0\xd5\x4b\x93\xb3\xff\x3d\x50\x1d\x81\xe5\xd1\xcd\x57\xc0\xab\x73\xdf\x0b\x1c\x23\x13\xe6\xa3\xdd\x06\x58\xd8\xe0\x97\x0a\x54\x5d\x7d\x3d\x80\xd7\x13\x9c\x53\xf2\xf6\x9d\xb4\x49\xd4\x4e\x4e\x4b\x57\x2d\x24\x72\x10\xe7\xfe\x81\xcb\x0c\xac\x93\xab\xb1\x35\x30\x30\xe5\xa0\x18\x59\x6b\x09\x8e\x62\x5b\xf2\x17\xe4\xad\xd0\x82\xed\x23\x4d\xc0\x69\xa6\x02\x4e\xa1\x4f\xeb\xf2\x59\x63\x1b\xad\xb5\xe8\x68\xca\xe8\xc0\x94\xdb\x58\x44\x4d\xdc\x85\x43\x1b\xd3\x9f\x1a\x8d\xec\x39\xb8\xfa\x3e\x80\x54\x77\x17\xec\xea\x4f\x2d\x43\x93\x3e\x47\x4a\xdc\x36\x10\xba\x62\xf8\xb5\xd2\x1f\x7d\xac\x15\xcd\xb2\xf9\xd3\xf2\xe4\xae\xac\xc4\x97\x9c\xfb\x2f\x28\xdd\x9b\xc1\xde\x19\x92\x7f\x59\xd3\xa9\xee\x0d\x33\xc6\xb3\x01\x5f\xe0\x60\x77\xbd\xc7\xe3\xe6\xc5\x41\xff\xb3\xd6\xc8\xeb\xad\x41\xf7\xbe\x41\xec\x3e\x97\xdd\x3e\xb7\x94\x2c\x9c\x75\xa7\xe9\x87\x47\x6a\x55\xc0\x6e\xc1\xdf\xb5\xec\x0d\x4c\xa4\x54\x55\xab\x60\xab\xfd\xba\x43\xe4\xbf\x27\x3c\x8a\x30\xfd\xb6\xdd\x93\xa6\x6d\x61\xd3\x66\xa2\x90\x3d\xea\xd2\xab\x34\x54\xfe\x09\x2e\xb1\x6a\x71\x5e\x02\x09\xab\x82\x14\xfa\xfd\x6b\x1b\x8a\xc2\x1e\xbc\x24\xd6\x6f\x6b\xe1\x30\x72\xad\x6d\x84\x38\xf7\x3b\x06\x3c\x0f\xb4\x27\x6d\x48\xb0\x19\xb0\x2d\x61\x58\x2f\x1c\x6c\xe5\x63\x69\x57\x37\xae\x51\x5e\xec\xd5\x9a\x28\x2a\xce\x0f\x82\x20\xb4\xb2\xcd\x10\x65\x73\x6c\x0d\xff\x4a\x77\xf5\x82\xfd\x41\x63\x32\x20\xac\x89\x67\xd1\xda\x57\xfd\xd4\xe6\xf4\xc5\x3b\x83\xfe\xeb\x6d\x1b\x82\xa8\x3a\x38\x67\xc6\xe0\x57\x7b\x70\xcb\xc7\xf9\xc2\x07\xb7\x48\x84\xe1\x07\xb7\x9c\x66\x8f\x3e\xb8\x45\x21\x81\x47\x5d\x68\x38\xe4\x54\x48\x37\x60\xf8\xe0\x16\xd5\x2a\xf0\xe0\x96\xd3\xe4\xa1\x07\xb7\x5c\x0c\xe6\x9d\x25\xa7\xf8\x95\x1f\xdc\x42\xbb\xd4\x0f\x6e\xf9\x1d\x13\x0f\x6e\xe1\x58\xf0\x13\x1f\x08\xd2\x07\x1e\xdc\x72\xb0\xdc\xf1\xe0\x56\xab\x0b\xf5\x66\xa7\x17\x05\xbd\x51\xa0\xf6\x57\x77\x2f\x02\xd9\xc9\x2c\xd8\x21\x04\xdb\x6a\x47\xfe\x9e\x3e\xb4\xcb\xb9\xdf\x3d\x43\xa7\x41\xc7\xc6\xa2\x17\x04\xc6\xf0\xbd\x71\xb0\x4b\xb8\x8f\xf8\xf5\x6e\x76\xe8\xb5\x79\x73\x5e\x01\xf8\xbe\xc6\xd1\xd9\xc0\x3a\x89\x92\xd5\x66\x14\xa1\x0f\x35\x7a\xad\xbe\x56\x4e\xab\xa9\xdf\xca\x59\x40\xb3\x4d\x8c\x03\x8f\x3c\x07\x39\xeb\xb4\xa0\xe5\x88\xfc\xcf\xbb\xfe\x3e\xc1\x04\xfc\x3a\x68\xb3\xb8\xb5\xdd\x20\x45\x22\x4e\x32\xa7\x80\xb5\x81\xb1\x22\x58\xfe\xea\xc2\x6d\xf6\x7f\x5c\x24\xee\x5c\x68\x94\x19\x79\xe5\x45\x47\x94\x97\xfc\x1f\x4c\x37\xb9\xe0\xff\x60\x6b\xb0\x4b\x03\xc7\x12\x48\x40\xb0\x44\xc4\x61\xdc\xef\xc9\xe2\xd3\x7d\xfb\x39\x04\x0c\x9d\x90\x61\x07\xd2\xcc\x9a\xf0\x0e\xd8\x96\x91\x80\x43\x1f\xa1\x57\x7d\xba\x8c\x84\xa3\x73\x3f\x2b\xb4\x42\x75\x20\x30\xf0\x09\x1f\x39\x42\x11\xd6\x03\x81\xcf\xce\x45\xd2\x0d\xae\x0b\x95\xd4\x39\x16\x71\x02\xf4\x21\xbd\x70\x8e\x95\xe9\x44\x85\xea\x44\x04\xdd\xa0\x85\x56\x05\x44\xa6\x4f\x6c\xc5\x6f\xed\xeb\xfd\xe6\xcb\xe5\x92\x6c\xee\xc5\x51\x21\x80\xf0\xa2\x77\x4d\x6b\xc1\x78\xeb\x64\x4f\x0b\x4c\x17\x31\x3a\xe7\x80\xba\xa8\x5a\xeb\xaa\x04\xe9\x26\xb0\xa9\xed\x36\xb9\x3b\x6f\x6f\xef\x6b\xfb\x6a\x66\x00\xef\xa3\x93\x6d\x68\x69\xfa\xe8\xf8\x5e\xd9\x8a\x10\x9d\x74\x33\x2d\xad\x8d\x1f\x1e\xe4\xc3\x46\x88\x1c\xab\xb8\x34\xde\x41\x29\xfd\x14\x99\xe2\x4d\x30\x08\x6d\xee\xa1\x87\x50\xc2\x0c\xa2\x64\x7d\xf7\xfe\xfc\x3c\xa1\x1d\x20\xe9\xbc\xa1\x2d\x14\xdf\xd5\x04\x0a\xca\x0c\x22\x3b\xb1\x8a\x2a\x45\xcc\xec\x78\x0c\x53\xa3\x47\x4b\xfe\x0f\x36\xc5\xd7\x3f\xab\x84\xff\x0b\xc3\x02\x2e\xe1\x30\xed\x87\x64\x30\x73\x01\x71\xd9\xeb\x9f\x16\xd2\xe0\x12\xa8\x23\x78\xcb\x60\xf0\x55\xd0\xc3\xe3\xc1\x57\x41\x41\xa8\x0e\x04\xde\x75\x34\xa9\x45\x1b\xa8\x55\x50\x0b\x5c\x17\x2a\x29\x03\x34\xe5\xef\x42\x3d\xa4\x1d\xd8\x2a\xc8\x9e\xea\x78\x83\x16\x5a\x5b\x56\x41\xed\xf8\x83\xab\x20\x91\x34\x9a\x68\xee\xad\x82\x20\x00\xb2\x0a\x1a\x45\xfc\x5f\x58\x9c\x60\x15\x14\x80\xe9\x22\x46\x6c\x15\x14\x54\xb5\xd6\x55\x10\xd2\x0d\xe5\xc3\x40\xc6\xb9\xbb\x0c\x5d\xe0\x1b\x85\x3a\x9e\xfd\xc8\x6c\x69\x5f\xaf\xb5\x5b\xa2\xce\x4b\xb6\xfb\xda\xbe\x9a\xcd\xea\xba\x64\xbb\xbf\xe9\xa3\xe3\x7b\x65\x93\xd7\x7d\xc9\xf6\x48\xe3\x87\x07\xf9\xb0\xc5\x24\xc7\x6a\xaf\xaf\x5a\xf4\xd2\x5f\x80\xa0\xb6\x0b\xae\xda\x28\xac\xc4\xc2\xcd\xaf\xef\xde\x25\xb9\x70\x0b\x41\xb6\x2e\xdc\x28\x8a\xef\x6a\x02\xc5\x75\x1b\x6e\x4b\x66\xb2\x76\xe5\xe5\xb8\x35\x5f\xe1\x96\xe0\x23\x9c\x7d\x6c\xb0\x43\xee\x60\x91\xe7\x15\x7d\x4c\xde\x74\x65\x1f\xb2\x75\x3f\xec\x38\x30\xef\xf3\x6c\xbd\x4d\x99\x77\x31\x87\xb4\x64\xa6\xa5\x8d\xb5\x45\x30\xb9\x2c\x3f\xfc\x29\x8a\xe2\xe8\x8d\x83\x42\x9f\xc9\xb4\x57\xee\xe7\x98\x8d\x49\x1c\xc4\xc0\x3f\x6b\xa0\x37\x9f\xc4\xe0\x7b\xe8\x61\xdc\x06\x9f\x3f\x2a\x50\xcb\xe7\xab\x5b\xc0\x26\x46\x38\xab\xe3\xc6\xbb\x10\x0c\xef\x6b\xb5\xa6\xfd\x15\x69\x6e\xbb\x26\xe9\xa5\xee\x8f\x80\x91\x38\x17\xd6\xe0\xa8\x9c\x4a\x31\x42\xec\x73\x41\x28\xb6\x4e\x25\x16\x72\xbb\xb1\x6e\xb6\x79\x24\x58\x75\x82\x82\x07\x83\xf6\x7e\xaf\x8d\xd9\x84\xc5\x2a\x0f\xa6\x2f\x60\xbc\x81\xa8\x01\x57\x07\x37\xee\x4b\x13\x5d\xee\x74\x41\xd9\x58\xde\x0f\x2b\x45\x68\x01\x3e\x0b\xad\xe3\xc4\x92\x15\x01\x9c\xc8\x20\x8d\x66\x72\x5d\x6b\xbd\xd0\x84\x3e\xa6\xab\xaf\x31\xd8\x1d\xda\x3e\x11\x2f\xc7\xc8\x84\x9e\x8c\xa8\xf5\x87\xef\x54\x05\x31\x5b\x2c\xb0\xae\x09\x76\xb9\x11\x88\xbc\x74\x0e\xa4\xcd\x73\x86\x40\xb3\xa2\xca\xa4\x65\x79\x28\x5d\x8c\xdf\x03\x3d\xdd\x91\x7a\x38\xdf\xec\x59\x3c\x0f\xce\xf1\xb9\x3b\xdb\x14\x6e\x6a\x9a\xfb\xd5\xc1\x99\x3e\x0f\xcf\x74\xd8\x77\x75\xf4\x39\x2b\xcb\x1c\xce\x76\x4c\x8a\xe2\xa3\x0e\xb0\xd4\xaf\x0f\xb1\x74\x12\x64\xe9\x04\x1b\x16\xcd\x52\xaf\x3a\xc8\xd2\x49\x98\xa5\xba\x6f\xa6\xf9\x41\xa7\xea\xa7\xaa\xf0\xae\x17\x0b\x2c\x3d\xdc\xbf\x8a\x0a\x35\x0e\xf9\x5b\x10\x1d\x72\xee\x52\x6c\x53\xec\x04\x62\xfb\x15\x4a\x7d\xde\x6f\x66\x86\xd7\x03\x6e\x41\x97\xdc\x7f\x2f\x59\x36\x1e\x9e\x58\x93\x66\x44\xf2\x4f\x31\x28\xeb\xfb\xa5\x01\x3e\x97\xe9\xe7\xac\xb8\x54\x56\x03\x53\x64\x35\x92\x87\xb5\x14\x00\xb0\x96\x6e\x91\x3b\x12\xdc\x46\x3a\x15\xa2\x97\x07\x8c\xdb\x6d\x28\xcf\x77\xb8\xa2\x32\x42\x1a\x8e\xd3\x63\x6f\x38\xe7\xff\x33\x49\x8f\xd6\x0c\x5b\xcc\xbe\x77\x12\xa1\x2c\xa8\x44\x28\xe6\x39\x00\x47\xbb\xda\xf3\xb3\x6c\xe3\x2a\x95\x2f\x3a\x39\x22\x1f\x8e\x67\xe9\xf1\x16\x4b\xaa\x15\x97\xf4\x5f\xdd\x9e\x21\x50\x9c\x51\xf9\xd5\xd4\xf8\xd7\xe9\xf1\x5c\x7f\x03\x57\x8b\x44\x2e\x53\x75\xfc\xc5\x5b\x1f\xea\x5b\x43\x0a\x41\xe0\xcb\xb3\x58\xf2\x3a\x40\x3f\x1f\xca\x74\x6f\x76\x26\x58\x15\xf9\xb4\xab\xf8\x62\xac\xd1\xa9\x57\xec\xaf\xf4\x2d\x43\x07\x0e\xeb\xd6\xad\xa2\xba\x1d\x2f\xe7\x11\x4f\x8b\x2f\xdb\x20\x2f\x80\x3b\xef\x52\x03\x38\xac\x5b\xb7\x8a\xea\x56\xbe\x0c\xaf\xd1\xc1\x57\x80\x9d\x97\x6b\x6d\x20\xac\x43\xab\x9c\x0c\xdf\x89\x37\x9f\x35\x22\xe4\xad\x4f\xe7\x05\x4a\x00\x87\xf5\xe9\x56\x91\x39\x02\xc4\x1b\xb0\x46\x43\xbc\xb7\xfc\x9c\xb7\xe6\x5c\x30\x54\x8f\xec\x1a\xaa\x4f\xf9\xaa\xe3\x4d\xbd\x66\x87\x1f\x2d\x6b\x5e\xbb\xb1\x8f\xe6\xf3\x5c\xc2\x0b\xdf\xd7\xfe\xa2\xa6\x40\x3f\x46\x84\xcd\x2c\xe8\x11\xc4\x29\x1b\x31\x2c\x7a\x4a\xcb\x51\x87\xa6\xb4\xf7\x98\x99\x6a\xa4\xdf\x34\xd3\x38\x9c\x93\x5a\xfa\x81\x33\x66\x9d\x24\x01\xda\x3a\xc9\xbf\x1e\xb4\x4e\xdc\x0d\x4b\x52\x18\x9b\x8e\x7a\x51\xaf\xc9\x69\xae\x21\x9b\x2d\xc4\x93\xfb\x46\xa1\x5e\xbd\x13\xc6\xdf\x45\xaf\xdb\x3a\x3e\x0e\x87\x79\xaf\x40\x9d\x93\x38\x33\x78\x9d\xba\x21\xc7\xde\x7f\x8a\x55\xc9\x9f\x2f\x47\xb6\x62\x29\x9b\x84\x5b\xe2\x4c\xd3\x04\x39\x1a\x3f\xf1\x4f\x53\x89\x22\x39\x90\xec\x74\x48\xcb\xac\xa6\xdc\xb9\xe9\xa6\x37\x3c\x8c\xfa\xd6\x9f\x87\xd1\xd5\x41\x60\x83\xc2\x33\x80\xe0\x2e\xca\x78\x04\x54\x7e\x1c\x45\x56\xf3\xa7\x43\x69\xaf\xd7\xcc\xdb\xc4\xfc\xdf\xcd\xbe\x33\x62\x5a\xf4\xfd\x8b\x22\x3e\x7b\x82\x17\x32\x91\x84\x5c\xd6\xc8\x0d\xf6\x2b\x48\x4e\xad\x02\xf0\xd5\xae\x4c\xd3\x93\xbc\xec\xe6\x1f\xf0\xc2\x25\x35\x5d\xfa\x92\x9a\x8a\xa3\x71\x2f\x1c\xa1\xfd\xb8\x96\x1c\xe1\x3c\x02\xe3\xf1\x24\xd9\xc8\x66\x3e\x11\x47\xdb\xeb\x03\xab\x3e\xc5\x59\x4e\x3c\x3d\x33\xa5\x62\x6b\x78\xd6\x92\x87\x97\xa8\xf6\xeb\x59\xd6\x23\x7e\x12\xa8\x37\x1c\x57\x3d\xfe\x1c\x1d\x73\x4f\x83\xe2\x52\x83\x87\x00\x09\xa0\x56\x08\x6b\xf4\x3d\x91\x0d\xa6\xdf\x14\xa8\xe4\x30\xd6\x9c\xb5\xef\x81\x34\x57\xf5\xe3\xa6\x89\x7e\x47\xc4\x2a\x32\xa6\xad\x29\xc1\x52\xb2\xe8\x55\x48\x43\xce\x70\x17\x9f\x45\xdc\xcf\xba\xba\xb4\xb1\x3f\x9f\xc5\x39\xb3\xfd\x57\xfb\x22\xd8\xbd\x37\xa7\xb1\x00\xa1\xc0\xda\x3b\x4c\xdd\x63\xad\x60\xfa\x4b\x20\xf9\x7f\xfe\xc3\xad\x0b\x3e\xc5\x45\xdd\xd3\xb9\xaf\x7e\x5c\xbc\x73\xde\x06\xe4\xfd\x19\x3e\x3e\xa9\x10\x33\x75\x3c\x66\x95\x58\xb5\xf7\xdd\x22\x7e\xed\x16\x4c\x85\x09\xde\x90\xb1\x31\x2f\x2a\xac\xbd\xaa\xa1\x9c\x1b\x77\xd5\xea\x88\xa5\xb0\x61\x18\x07\xcc\xf2\x4e\x8b\x65\xb7\x98\x4f\xb0\xdd\x43\xb2\xdf\x47\x09\x3c\x51\x99\xcc\xd3\xd5\x6e\x0e\x50\xf5\x50\x83\xb8\x5b\xa5\xe3\xed\x04\x82\xda\xfc\xd7\xab\xcf\xed\x6c\xca\x57\x2b\xb2\x46\x2c\x03\xcd\x92\x6d\x11\x2d\xf1\xf7\xbd\xd3\x64\x0f\x63\x56\xdb\x5d\xba\xdc\x8f\x6c\x3c\x38\x61\xf1\x3c\x1d\xa5\x4e\x7f\x28\x55\xd3\xd9\x78\xbe\xd2\x50\x7a\xb9\xa8\x4f\xb4\xc5\xf3\x64\xb2\xc5\xec\xc6\x6e\xbf\x4c\x27\x80\xb0\x7d\x9c\x6e\x77\x3b\x80\x0a\xa7\x6d\xbf\x48\x47\xdb\x19\x04\x45\xc8\x9b\xcf\x67\xa3\x86\x69\xee\x53\xdf\xf1\x6a\x3a\x9d\x8e\x31\xea\xc6\x49\x9a\x78\x6f\xb2\x33\xda\x92\x91\x8b\x09\x27\x2e\x9d\x6e\x57\xbb\x08\x40\x22\xb4\x2d\xa7\x13\xb6\x98\xbd\xfd\xb3\x36\x8c\x9f\xd2\x6f\xfb\x32\x3e\xa6\x55\x8f\x3f\x7a\x56\x32\x35\x18\xc8\x2b\xb6\x65\x76\x4e\xab\xeb\xbe\xe4\xd7\x4d\x1b\x62\x8d\x72\x4f\x45\xf0\xe2\x56\x17\x68\x2d\xbf\x76\xca\xfa\x28\x7e\x51\xf4\xbf\x20\xee\xa1\xc6\x78\xb5\x6e\xab\x61\xd6\xb0\x3d\x45\x18\xf5\x41\xa8\xed\x62\x95\xf7\x6c\x23\x79\x6f\xca\x87\x6c\xe8\x17\xe7\x1d\xbc\xd4\xdf\xe6\x31\x52\x91\xd8\x2a\x10\xbb\x1b\x37\x6b\x3d\x62\x0b\x41\xc6\xac\x03\xc3\x1b\x58\x17\xba\x9c\xb7\x2f\xbb\x42\x22\x5e\x5d\x0c\xac\x37\x9c\x4b\x6f\x0c\xbc\x39\xa8\x24\x6b\x5c\xbe\x29\x4d\x4a\xfa\x4d\xa9\x2a\xe9\xb9\xfc\xf5\x6e\x1b\x6a\xfa\xe4\x13\xc1\x03\xf3\x58\xe0\x74\x96\xa4\xcf\x7d\xe4\x22\xdb\xec\x5d\x6f\x3c\xfb\xbe\x6f\xb9\x52\xef\xef\x59\xf4\x3d\xd1\x92\xae\x59\x00\x1c\xe0\xef\x77\xfe\x25\x63\xc6\xb7\xff\xf7\x88\xfe\xbb\xa7\x18\x79\x4c\x56\xcc\x37\x61\x89\xa6\x91\x1b\x88\x75\x6b\x5c\x95\x34\xef\xca\xe9\x42\x55\x00\x14\x52\xf7\x17\x9f\x18\x83\x84\x9e\x63\x16\xb2\x37\xd6\x8f\x58\xf7\x98\xa3\xcd\x4e\x6c\x87\xc9\xe7\xcd\xfd\x8d\xee\x6e\x01\xe7\x59\x6b\x50\x2b\x3c\x01\x31\x04\xff\x98\x88\xff\x98\x88\x1e\xc5\x40\xef\x5a\xa2\x9a\x2d\x4a\x07\x5b\xff\x43\xe3\xfe\xa1\x71\x6d\x1a\xd7\x1e\xd9\x6e\x51\x3a\x04\xc1\x3f\xf4\xee\x1f\x7a\xd7\xa6\x77\xad\x9f\x36\x5a\xd4\xce\x6f\xff\x0f\xad\xfb\x87\xd6\x21\x5a\x27\x62\xda\xf0\xa2\xb4\x2a\xc6\x5e\xc6\x55\xcf\x4c\x88\xfa\xbe\xfc\x3f\xb6\x63\x4c\xbe\x5d\xe1\xc6\xfa\xaf\x05\xff\x16\x70\xb3\x41\x4c\x2c\x5d\xe4\x6d\x53\x35\xc5\xf6\xcf\xe9\xae\x86\x69\x28\xed\xba\x61\x76\x7c\x1e\x34\x31\x6a\x98\xad\x5b\x82\x8a\x48\x9d\x22\xe8\xc9\x7a\x92\xdc\x3d\xca\x31\xb2\x3a\xe6\x05\x6e\x03\x71\x2b\x1b\x7c\x41\xb0\x1a\xf0\x31\xf4\xfd\xc6\xaa\x1f\x24\x09\x2b\xf8\x34\xc7\x58\xa7\x51\xc9\xaf\x74\x57\x22\xeb\x84\xee\x4e\xa4\x0e\x83\x9f\xfa\x45\xa9\x86\xe1\xe7\xf7\xb9\x77\x09\xe4\xb6\x98\x59\x23\xce\xaa\x1a\x1e\x6d\xf1\x8e\xae\x34\x5f\xb0\xc2\x49\xf5\x65\x3a\x20\xf0\xbd\xeb\xfe\x34\xf9\x48\xba\xf4\xfb\xce\x79\x02\x0a\xba\xbc\x31\xd8\x92\x15\xc1\xc7\x49\x67\xae\x78\xd9\xcb\x93\x31\xec\xa9\x2f\x5f\xc6\xf3\xd8\xda\x5c\xa5\xf4\xdb\xf4\x60\x81\xd6\x0a\x02\x19\x09\x6f\xdf\xf2\x8b\x7d\xbe\xea\xe3\x23\xa0\x5c\x9e\xb1\xc1\xbb\x52\x8d\x88\x4a\xef\x96\x68\xd7\x43\xbf\x22\x40\x77\x23\x58\x65\xbf\x00\xd9\x04\xbf\xe4\x69\x22\xf8\x81\x58\x9f\x08\xea\x93\x35\xe6\xbc\x00\x55\xff\xd8\x49\x49\xf1\xb5\x95\xc2\x49\x0b\xb4\x85\xcc\x47\x1a\x0a\xfa\xdb\x54\xc2\x7c\xf4\xe8\x4e\x32\xe7\xfd\xfd\xf4\xb6\xb4\x22\x88\xf5\xd2\x3b\xe0\x07\x01\xfa\x44\x39\x25\x63\x55\x4b\x9c\x79\x0e\x65\x2c\x27\xce\xff\xe2\x1d\xdc\x21\xb5\x96\x06\x4f\xc3\xea\xc8\x74\xee\xfe\x76\xc1\x66\x77\xeb\x56\xa7\x66\x2d\xb4\xb6\xb5\x0e\x37\x0e\x2b\xf5\x83\xcd\xda\x28\x6e\x69\x2d\x1a\xb7\xcd\x27\x4a\x50\xf8\xbc\x08\xb3\x2a\xd8\xa6\xc3\x4c\xda\x2d\xd8\x3a\xce\x3f\xf6\x72\xe7\x27\x4f\xdf\x93\x68\x04\x84\x5b\xc0\xf1\xd3\x68\xee\x75\x7f\xad\xed\x80\x8c\xc8\x8e\x29\x8f\x68\xea\x43\x9e\x11\x20\x69\x01\x72\x2f\xe1\x93\x0c\x8f\xc4\xe7\x64\x92\xa0\xe6\x6c\x42\x18\xa0\x6d\x60\x8e\x0e\xb5\x90\xae\xfb\xec\x04\xd5\x89\x19\xae\x59\x0e\x1b\x63\xc5\x29\xd7\x18\x4b\x7d\xf2\xd0\xdf\xf1\xa9\x1c\x61\x31\x6f\x4d\x11\xee\x63\x26\x10\xdc\xad\xca\xc1\x46\xad\x7a\xcc\x5b\x93\xb2\x16\x95\x41\x09\x5b\xcd\x43\x10\xae\xee\x52\x8c\xdd\x4d\xd3\xc9\x7e\x42\x10\x49\x2b\xae\x55\x1b\x1c\x49\x27\x95\xb5\xbb\x6a\x07\x69\x1f\xfa\x3d\x9a\xaa\xf8\xe2\x6a\xaa\xd4\x15\x0f\xf7\x7d\xc7\x27\x10\x9e\x2a\x04\x14\xed\x28\x7e\x1a\xcd\xdd\x5a\xdb\xd6\xae\x55\x71\x15\x02\x52\xe2\xba\x3e\x28\x6d\x17\x49\x0b\x90\x23\x42\x9a\xe1\xf1\x7e\xbc\xdb\xd1\x04\xd3\x7a\xec\x02\xb4\x0d\xac\x93\x36\x83\x3e\x3b\x41\x75\x62\xc6\x1d\x6a\xad\x39\xe5\xa8\xb5\xd2\x27\x0f\xfd\x5d\xc7\x6e\x10\x26\xcb\xf6\x14\xf1\x18\x76\x12\xc9\xdd\x2a\xdd\xd2\xac\x55\xa3\x65\x7b\x52\xee\xaa\x3a\x28\x6f\x07\x45\x18\xc6\x91\x1c\xc9\x68\x7e\x94\x09\xd5\x66\x89\x85\x56\x66\xa7\xbe\x65\x4c\x9d\x54\xd9\xed\xb0\x0b\x50\x17\x36\xdc\xa1\xc7\x9a\x47\x8e\x1e\x2b\x1d\x22\x65\xde\x16\x93\x43\xd7\xde\xfe\xc3\x95\xce\x85\x47\x7e\x21\xea\xe4\x65\xcc\x1d\x47\xdd\x83\x66\xc1\xc3\x9f\xd8\x19\x20\x79\x64\x69\x04\x8e\x2c\x45\xee\xf1\x1f\x12\x48\x11\x2c\xe3\xbf\xf6\x49\x55\x5d\xa1\xb9\xe5\x07\x06\xbb\xe4\xba\x6e\xbf\x63\x88\x5d\x02\x04\x7d\xa3\xcf\x72\xa9\xa4\x38\x60\xbf\x26\xdb\xd5\x59\x9d\xa7\xd7\x60\x3e\x61\xeb\x88\xd6\xdc\x3f\x3b\x6a\xa1\x31\xbb\x4a\xbf\x4c\x5e\x76\x6b\x4a\xc1\x9f\x48\x3b\xd5\x0c\xa7\x7a\x5f\x14\x75\x73\xb5\xd1\x66\x74\xcb\x11\x38\x37\x1d\x39\xf2\x3a\x63\xcb\x25\x4b\xe4\x7e\x27\xa3\xe7\xc9\x9a\x00\x7d\x5d\x24\x29\xd5\x09\x7c\x6c\x10\xff\xf0\xb0\x87\xc5\x33\xbf\x1d\xd0\x7a\x6d\xae\xfe\x8b\x1c\x1b\xef\x75\x75\xaf\x6b\x3b\xea\xdc\x0b\x85\xa4\x3b\x90\xd4\x19\xd7\xd5\x79\x46\xe9\x45\x53\xc1\xe9\xbf\x89\x76\xf7\x02\x91\xf0\x2e\x23\xe9\x86\x09\x3e\x35\xf7\x3a\xda\xe5\x4c\xf1\xf7\xf7\xa8\x40\x97\x4f\x08\xc1\x74\xce\xa0\xeb\x07\xba\x52\xa7\x3e\x6d\x27\xf1\xde\x9d\xc6\x18\x30\x21\x90\x5a\x9e\x9f\x57\xb5\xc8\x5f\x83\x32\xad\xce\xc5\xa9\x12\x97\xa5\x44\x09\x39\xdb\x50\xdc\x3d\x75\x51\xc1\xc5\x8a\x97\xfa\x7d\xf5\xc0\x35\x87\xe0\xdd\x9d\x1b\x85\xcc\x7d\x92\x5a\xd4\xa2\xf3\xce\xab\xb9\xbe\x70\xe2\xdc\x49\xc8\x53\xcd\x5d\xa1\x5b\x52\xd2\x94\xde\x83\x98\x2b\x5c\x67\xc4\x2f\xa0\xe9\xae\x5e\xff\xde\xd8\xdb\xab\x93\xd7\xe1\x76\x6b\x3f\x87\x5f\x45\xaa\xaf\x36\x9e\xd6\x7e\x0e\xaf\xa4\x4c\x41\x8a\x5f\x8f\xc7\xaf\xc7\xc5\x97\xf0\x29\xa0\xfe\xbf\xa8\x8a\x23\x7e\xfa\x97\xd0\xf0\xd7\xe8\xa6\x83\x68\x7e\x95\x6e\xe8\xd1\xbc\x1a\xf7\x5f\x8d\xbf\xaf\xc6\xc1\x17\xf0\xe8\xfa\x90\xf9\xb6\x72\xb5\x28\x0a\x48\xd2\xa8\x15\xe2\xa3\x2b\xc2\xbb\x68\x51\xe2\xb1\x0b\xca\x4e\x7a\x18\x46\xca\x57\x6f\x1d\x91\x3e\x4a\x4c\xf7\xfe\xfe\xfe\xd8\xda\xd9\x9b\xbd\xa8\x8f\xc3\x6b\xf4\x11\xe4\xec\x2b\x8d\xa3\xa5\x8f\x90\xf7\x7b\x1d\x96\xbf\x12\x57\x5f\x89\x71\x0f\xf3\xe6\xfa\xb7\xd1\xe4\x97\x9b\x8b\x56\x96\xff\xc2\x16\xe9\x75\x46\xd1\x2a\xaa\x97\x9b\xc0\xa0\x8f\x7b\x15\x8e\xbe\x0e\xd3\x1e\xe5\xcb\xb5\x3d\x6f\x99\xb5\x3f\xe7\x83\x7c\x0f\x76\xfa\x5e\x8d\x25\x3f\x97\xc6\xf7\x16\x2c\x29\x72\x1b\xe8\x4a\x06\x0a\xdd\xa9\xd5\x65\xed\xd4\xbf\xb7\xc5\xc1\x09\x83\x81\xb9\x2c\xab\xd2\xa4\x2d\x06\x61\x00\xaf\xfa\x6d\x30\x02\x91\x24\x88\x11\xf1\x14\xb4\xf0\x08\xf8\xa1\x13\x38\x57\x89\x3b\xb0\x1b\xf0\x6e\xd8\xf9\xfa\xee\x0e\xec\x06\xbc\x9b\xc3\x7c\x90\x57\x9d\x10\x3c\x4a\x41\x27\x7e\x76\x42\xf0\x28\x05\x9d\x78\xde\x09\x01\xea\xd2\xf4\x8b\x7f\xed\xda\x4a\xda\x71\x94\xdd\x1d\xa0\x2d\xde\xde\x03\xdd\x09\xb7\xc5\xb5\x7b\xa0\x3b\x39\xc4\xc7\xb8\xd4\x51\x4d\x1f\x6a\xdf\x85\x93\x1d\x95\xf4\xa1\xf6\x5d\xb8\xdd\x51\x45\x7d\x97\xa5\xdf\x9d\x6b\x51\x3a\xd7\xc8\xb7\xea\x28\xd8\xb8\xb6\xa9\x46\x57\xec\x28\xf8\xe1\x7e\x55\x08\xf7\x77\x37\x82\xc3\xfd\xc2\x78\x9c\x02\x9c\x07\xf0\x2b\x4d\xab\x3c\xed\x85\x4d\xbb\x38\x6d\xe8\x43\x9b\x11\xe9\x88\x1b\x83\x7e\x44\x96\xa1\xde\xee\x6d\x7f\xb8\x7f\x5a\x3f\xdc\x3f\x3a\xfe\x16\x39\x36\xf8\x88\xab\x2e\xcd\x07\x2e\xec\xbb\xac\xbc\x15\x64\xd5\xf7\xd0\x13\x0b\x44\x66\x7a\xd8\xea\xbd\xdb\xd8\x64\x87\xf2\x21\xcd\x39\x02\x7c\x74\x28\x6c\xe8\xa3\x60\xff\xae\x76\x77\x2c\x85\x5d\x8c\xde\xc7\x3c\x82\x62\x09\x07\x3b\xee\x21\x1d\x7b\xc7\x25\xec\xbe\x4d\xde\x55\x2c\x49\xb7\x05\x00\xbe\x9e\x06\x9f\xab\x03\x87\x05\xba\xe2\xec\xc8\xc4\xce\xf8\x7a\x6e\x26\x47\x4d\x14\xfe\xee\x10\x8a\x08\xe7\xf1\x13\xc9\x63\x84\x34\x93\x63\x96\x48\xff\x6e\xc1\x10\x2c\x7e\xe0\x56\x49\x08\xed\x9d\x5c\xee\x82\xb2\x77\x4f\xca\x4c\x14\xd1\xa3\x8c\x76\xa9\x33\x29\x44\xd0\x94\x6a\x0e\x0c\xa5\xce\x0f\x25\x6b\x0b\x61\xbe\x57\xa9\x3b\xa0\x04\xec\xd6\xa4\x11\x67\xdd\x09\x5c\x0f\xab\xb6\x43\xa0\xcc\x9f\x81\x66\x89\x6b\x00\x28\x5e\x3f\x94\x7c\x8e\x44\x7b\x27\xa3\x5b\xf1\x41\x2e\x2b\xa2\x88\x73\xda\x18\xa2\x47\x59\xec\x92\x66\xb2\x45\xa0\x29\xef\x1c\x18\x82\xd1\x8f\x25\xd3\x0b\x61\xbe\x93\xd7\x5d\x50\x42\x63\xad\x48\x23\xce\x0f\x13\xb8\x1e\xe5\xb8\x4b\xa0\x4e\x94\x80\x66\xf1\xb3\x41\x08\x7e\x3f\x96\x1e\x30\x80\xf8\x4e\x76\x77\xc0\x08\xb9\xad\x08\x23\x4e\xb9\xe2\xa8\x1e\x65\xb6\x26\x2f\x3d\x6e\xd3\xc4\x5e\x5c\xb6\x5d\x14\x57\x67\x5e\x9b\x84\xd1\x11\x4c\xdd\xe7\x23\xed\x79\x25\xea\x18\xa0\x07\x28\x0a\x90\xf2\x4c\xe4\x27\x44\x2a\x64\x26\x02\xa4\x82\xbf\x78\x57\x34\xa3\x89\xb7\x6c\xd5\x75\xa9\x65\x0a\x51\xbe\xca\xd5\xe7\x78\xe5\x15\x7a\xeb\x72\xb2\x9d\xd0\xaf\x59\x59\x7b\x03\x18\xcd\xb7\xdf\x56\x57\x90\x3a\x78\x36\x1f\x8e\x67\xdf\x23\xd0\xd3\xed\xb7\x09\x04\x5e\x70\xc8\x2f\x69\xce\x96\xd1\xd9\xc9\xc9\x13\x68\x8e\x82\xae\x88\xcc\xb1\xe1\xb5\x9e\xbd\xc8\x4c\x27\xfc\xdf\xfd\x49\x13\x5b\xce\x15\xb7\x80\xca\x71\xf5\x84\xc6\xfc\xe5\xc2\x34\xd4\x5f\xd9\xba\x13\x10\xe4\x25\x94\xed\x07\x79\x73\xfc\x78\x3c\xc5\xf3\x43\x0b\xb8\xea\xe8\xa4\xe2\x75\xc1\x44\x14\x5e\x26\x94\xb5\x32\x82\x87\x12\x61\x93\xb9\xdf\xa3\x48\x3d\x32\xe2\x9c\xae\x8e\x7a\x62\x75\xb8\xcf\x72\x36\x13\xd7\x71\x7e\x3e\xc4\x6f\x8b\x73\xbc\xcb\xea\x6f\x3f\x8d\xa3\x77\x1b\xf5\x7b\x3d\x1c\x2b\x3a\xf4\xbd\x66\xf9\x87\x73\xcc\xdd\xf4\x10\x4e\xa9\x8e\x77\x36\xb3\x3b\x33\xf7\xf0\xe5\xd0\x4d\x22\xbb\xf3\x39\x8d\xcb\xf8\xb4\x53\xef\xae\x35\xb3\x18\xf4\xd0\xe8\x18\x4f\xe4\x69\xcd\x84\x63\x91\xc4\xf9\x80\xbf\xe7\x77\xf5\x26\xbe\xa8\x6b\x26\xdd\x3e\xfb\x9a\x26\x6a\xc6\xa9\x68\x93\x37\xf3\xf4\xfd\xed\x51\x34\x8b\x36\x76\x9e\x7b\x2f\x23\xa8\x1e\x82\x2e\x1f\x54\xbb\x92\xd9\x3b\x4e\x7d\x5d\x5c\x76\x87\x4d\x71\xa9\xb9\xd8\x0c\x91\xc3\x7d\x9c\x30\xdb\x23\x09\x4e\xb2\x38\x2f\x9e\xaf\x48\xc2\x4b\xa7\x88\x3f\x41\xda\x1b\x4e\x54\x2a\x6a\x3f\x9d\xb5\xfe\xcb\x87\xb3\x80\x28\x4c\xb0\x23\x09\xc8\xcc\x6c\xca\x74\x7f\xc0\x2c\xc7\xbb\xcd\xe0\x58\x85\xeb\x8b\x60\x75\xa0\x4e\x33\x25\x3b\x85\x58\xe2\xb5\x8d\x42\x34\x45\x01\x82\x22\x8a\x9a\xe8\x9d\xad\x44\x8a\x18\xa3\x4b\x83\xaf\x5a\xde\xa6\xe4\x9b\xcc\xe6\xed\x52\xed\xfb\x29\x69\xc3\xad\x4c\xe0\x3a\x49\x8c\x68\xa6\xde\xf7\x43\xda\xe1\x17\x34\x90\x4c\x93\xbb\x3c\x3b\xaf\x1b\x23\xee\xda\x61\xaf\xce\x33\xc5\xab\xd5\xca\x2f\xb5\x0d\xdf\xf8\x9d\x6f\xe1\x1a\xa5\xc6\xef\x80\xf0\xa7\x2c\x56\xc0\x00\xc3\x2b\x20\x38\x8c\x66\x0c\x1f\x04\xbf\x5d\xf1\xe8\xb4\x9d\x46\xd8\x93\xa4\x51\x04\xf1\x8b\xd9\x78\x45\x0d\x97\x65\xb7\xfc\x66\xd9\xe9\xda\xc5\xda\xa9\x66\xea\xe1\x5c\x27\xf7\x3a\x19\x81\x11\xef\xd0\x80\xa6\x3a\xf9\xb8\x15\xda\xe2\x19\xc7\x35\x90\x7d\xc5\xc4\xbb\x06\xa4\x53\xed\x9b\x31\x88\xcb\x35\x9e\xc2\xb9\xd7\x6d\x24\x28\xbc\x04\xc2\xe9\xb6\xd2\xa4\x48\x9f\x85\xc7\xb0\xdc\x51\x48\x44\xe2\xa9\xce\xf7\xfc\x7f\xbc\x00\x9f\x9d\x25\xdf\x23\xa0\xd7\xbc\x1a\xe2\xa3\x30\x4f\x45\x62\x6d\x84\xaf\x7f\xdf\xfc\x74\xdf\x6a\xd4\x2d\xa4\xc9\xe6\x89\xd0\x8e\xcc\x2c\x5e\xca\x94\x58\xa1\x0d\xd8\x64\xe1\xae\x5c\xce\xe9\x19\x5f\xf4\x28\x2e\xcf\x9c\x64\xd1\x12\x5f\xf3\x04\xb3\xf7\xec\x83\x63\x33\x64\xd5\x3c\x6a\x52\x50\x8b\x37\x38\x7a\xb6\x79\xd1\x76\x02\x9d\x6d\x33\x75\x35\x27\x38\xdd\x08\x20\xc3\x02\x9d\x0a\x67\x22\xb2\x6d\x21\xb4\xaf\x56\x63\x8b\xf6\x5c\xd3\xbd\x92\xf0\xc3\xba\x28\xf2\x3a\x3b\x23\x9c\x6b\x26\xe5\x22\x02\xcb\x76\xb1\xbe\xd9\xc7\xc7\x2c\xff\xb6\x7e\xf3\xef\x69\xfe\x39\xe5\xc9\xab\x7a\x7f\x48\x2f\xe9\x9b\xbe\xf9\xbb\xff\x2f\x25\xe3\x54\xbf\x62\xc6\x7a\x50\xa5\x65\xb6\x47\x9f\xc8\xd1\x59\xa9\xca\x63\x9c\x3b\x0b\xa7\x69\x44\xcd\x08\x98\xf2\xc7\xfe\xbb\xaa\xe3\xb2\xc6\x57\x3d\xf6\x62\xab\x29\x68\xdc\x8a\x28\xcb\xd3\x9a\x69\xa1\x78\x7c\x87\x4f\x1d\x45\xd7\x17\x36\x57\x06\xfc\x09\xd7\x4f\x4e\x09\x06\xc5\x9f\xeb\x31\x05\xce\x43\x3e\x72\x84\x6d\x26\x4b\x8e\x59\xf6\x25\x55\x49\x89\x88\xb4\x5d\x2b\xdb\x76\xad\x1a\xf8\xba\x38\x3b\x2f\x02\x9a\xd9\x2a\xe6\x84\x58\xc9\x6a\x50\x27\x89\x9a\x7a\xce\xd6\x7b\x9e\x46\x03\xab\x94\x65\x24\x6a\x07\xd8\xce\xb6\x86\x20\x76\xc8\x60\x0a\xe7\x3e\xc8\x32\x8e\xe0\x43\x4b\xcb\xfb\x13\x9f\xf3\x55\x30\xf2\x1d\x42\xf7\x19\x97\x65\xf1\x05\x51\x7f\x90\x92\x3d\x72\xb7\x18\xc8\xf5\x44\xa9\xc8\xc2\x8c\x3a\x32\xe8\x81\xae\x5c\xe7\x37\x63\x1b\x43\x87\x21\x96\x8f\x51\xf6\x4a\xbe\xa1\xd4\x73\x2e\x23\xd9\x9e\xd1\xea\x4b\xe0\x80\x1d\x9a\x87\x88\x36\xd0\x6c\xeb\x74\x6c\x2f\xeb\x53\xe0\x6f\x19\xa5\x9f\x02\xee\xf1\x3e\xd1\xfe\xc4\x57\x23\xc6\x4b\x37\x8b\x9d\xd0\x74\xb2\x27\xa7\x37\x79\xd8\x0a\xeb\x0f\xe3\xa9\xee\x4e\xaf\x68\x5a\xfb\x8b\x74\x8f\x1b\xeb\x48\x07\xda\x9d\x64\x10\xd6\x61\x67\x95\xf1\x3a\x73\xc3\x35\x48\x77\x03\x6a\x90\x7a\xd1\x66\x49\x10\x1f\xe4\x03\x7d\x92\x82\xf4\xb5\xe6\xf1\x3e\xcf\xc5\x59\xec\x89\xa9\xe0\x8d\xb7\x04\x9d\x83\x9d\xa3\x65\x8e\x16\x73\x3b\x9e\xa2\x3d\xd8\xcb\x1c\xe1\xf4\xff\x53\x47\xf8\x6b\x6d\x94\xf8\x73\xec\xf7\x6f\x94\xe8\x05\x5b\x04\xd6\x62\x63\x6c\xc1\x86\x00\x79\x6e\x5d\x29\xa6\x70\xd3\xb6\x96\xcb\x7d\xa6\xae\x95\x9e\xd9\x9e\xee\x6e\xbd\x72\xc6\x76\x6a\x57\xa7\x5e\xf8\x5f\x77\xc9\x6d\xd7\xab\x2d\x88\xd6\xe9\xa5\x7e\x81\xd6\xec\x49\x80\xa6\x22\x52\x5b\xf0\x7f\x81\x6d\xd1\x96\xff\x03\x2c\x36\xd6\xb7\xd7\xcc\xd0\x66\x53\xdd\x44\xdf\x04\x31\x06\xe2\x69\x28\x4c\x44\x1f\xfc\xbd\x8e\xf7\x35\x3a\xc1\xdd\x45\xeb\xcb\x1c\xba\xdb\x25\xb8\x3f\x3e\xf2\x89\x54\x44\xa9\x31\xad\xdf\xbc\x71\x2d\x97\x2b\x24\x26\xb6\x06\xb1\xca\x90\xca\xcd\x0b\x6e\xee\x45\x95\xef\x23\x9b\x88\x80\x55\xea\x28\xe1\xec\x1d\xb0\x93\xcd\x9d\x62\x8f\x10\x45\x7f\x23\xcf\x8d\xa7\x44\x1b\x33\xb8\xde\x1b\x84\x1e\xeb\x9d\x70\xaa\x3b\xa1\xdc\x7a\xe4\x8e\x07\x97\x83\x74\xa6\x85\x35\x6a\xc7\x57\x5b\xe3\xb6\xcb\x89\x91\x0b\xb7\x1b\x24\xc4\x1d\xb9\x1c\xa9\x9c\x78\xf8\x90\x1d\x62\xac\x41\xe3\x3d\x49\xb4\xf6\x98\xef\x11\xb5\xd6\x61\xd4\xdd\x59\x8c\x70\x2a\x00\x27\x08\x5a\xd4\xb0\x55\x38\xa0\xbb\xb4\x83\x24\xc9\xe3\x01\x96\x29\x82\xc2\x56\x4f\xaf\x75\x90\x36\xe8\xc7\x5e\x3e\x59\x03\xb7\x8a\xc9\x61\x5b\x74\xa8\x41\xab\xbb\xe8\xcd\x0a\x99\x1c\x71\x2b\x2d\x62\xc4\xbb\x98\x99\xc8\x0a\x7b\x24\xb9\xa9\x53\x9b\x1d\x2a\xf2\x28\xbe\x18\x79\x21\x71\xb7\xf1\xd3\xb0\x25\x1b\xb3\x70\xe4\x48\x90\x5a\x3f\xac\xa4\x5e\x47\xec\x89\xb5\x83\x1b\x9a\x46\x41\x5a\xea\x71\xfa\x9e\xd4\x83\x8b\x68\x1d\x7f\x7b\xd1\x59\xdb\xe8\x08\x46\x9c\xe7\xf2\xf1\x4d\xb3\x2c\x19\x4c\x92\x77\xfd\xb7\x5e\x7c\x99\x17\x5f\x71\xc6\x74\x0b\xcf\xcf\x43\x0f\x4e\xba\x11\xfa\x39\xf9\xec\x64\x00\x9f\xb5\xa2\xd9\xb3\xf5\xcf\xe0\x73\x56\x65\xdb\x2c\xe7\xbb\x75\xeb\x75\x32\xa2\x4a\xb7\x3e\xa7\x65\x75\x4e\x65\x2a\xa3\x51\x24\xb7\xc5\x5e\x11\xce\x7f\x95\x01\x69\xa8\x72\xa7\xa3\x20\xfc\x2d\xf7\xab\x5a\x09\x07\x22\xf8\x93\xe4\x2d\xd7\xcb\x3e\x15\x8f\xb7\xeb\xc3\xc4\xc8\xcc\xea\x28\x04\x7f\x26\xbe\x1b\x2d\x83\x36\x62\x06\xdd\xa8\x09\x30\xa5\x8d\x50\xb5\x3e\xeb\x44\x6e\x14\x22\x55\x56\xde\x7c\x3a\x29\x12\x4f\x22\xf1\x2b\x2c\x15\xcc\x03\x59\xf6\x71\x8c\x57\x1d\x4b\xbd\x03\x31\xb5\x87\xb2\x9e\xcf\x45\xd1\x5d\xd5\xc2\x15\xab\x6f\xc4\x3d\xa0\x11\x10\x62\xf0\x24\x40\x0d\x56\x2e\x83\x43\xbd\xd8\x93\x04\x25\x97\x3b\x83\xb2\xc8\xef\x3d\x04\x30\xb3\x1f\xf2\x6b\x7f\xb7\xcf\xff\x20\x0c\x9f\x11\x9c\xbf\xf3\x17\xe1\x56\x3d\x53\xb0\x4e\x1f\x38\xe0\xb8\x24\x87\x3a\xbf\x24\x22\xe4\xe1\x86\xa4\x7b\x91\x53\xc0\x2c\xd2\xe8\x5d\x8f\x33\xb1\xdb\x33\x1f\x2f\xc5\xa8\x08\x6d\xf0\x09\xfc\x7d\x11\xd7\x60\x12\xea\xcb\x60\x03\xff\xc5\x5f\xa0\x7c\xeb\xf6\xf4\xae\x5f\x17\x6f\xbd\xbe\xde\x75\x78\xeb\xa3\x2e\x7a\xd2\xb0\x76\x26\x5d\x89\x87\x3f\xe4\x92\x25\xeb\x7f\xfd\x1f\xbf\xe3\x78\xff\xa8\x8d\xc2\xf0\xf7\xd9\xae\x2c\xaa\x62\x5f\x0f\x4d\x1f\x62\x2b\xff\x5b\x2e\xe9\xaa\x2e\x7f\xfa\xe1\xbb\x65\x24\xff\xf3\x43\xbf\x97\x9e\x12\xab\x22\x6a\x2a\xfe\x8b\x6a\xfc\xc7\x6f\xe7\xf4\xa7\x91\x33\x90\x32\x3d\xa7\xfc\xe4\x82\xf8\xbf\xc1\x57\x44\x17\xe4\x2c\xd0\xb1\x2d\xf3\x04\xf2\xe6\x71\xf5\x90\x0c\x88\x20\x97\x5e\xa0\x1e\x77\x62\x7c\x81\x7a\x48\x5d\x80\x1a\x32\x7b\x5c\x3d\x82\xa4\xbf\x5c\x3d\x22\x4a\x3d\x96\xaf\xa3\x1e\xe6\xc4\x09\x2c\xf7\x72\x2c\xa2\xd1\x27\xfa\x1b\x86\xf9\x38\x6d\x7f\xcd\x80\xbd\xf4\x86\xcf\xf9\xb7\xf3\x21\x63\x7f\x0e\x76\x87\xf4\x73\x59\x9c\x06\xc0\x3b\x04\x20\xe1\x22\xc8\x80\x0a\x28\xe0\xfa\xdc\xca\x80\x07\xe4\x3b\x19\x1d\x40\x9c\x99\xd5\x77\x76\x92\x31\x1f\x11\x7d\xf0\x23\x3c\x2f\x19\x58\x43\x10\xb1\x6f\xec\xdc\x43\x3b\x43\xf4\xf7\x83\xa6\x0f\xbd\x69\x23\x3a\xe9\xc8\x4a\xfd\x89\xa7\xf9\x1a\x2b\x7e\xdb\x21\x55\x19\x2c\x75\xf7\x07\x21\x9c\xeb\x6d\xca\x26\x49\x6a\xa2\x2e\x3f\xfc\x69\x1c\x4d\x56\x3f\x04\x89\x44\xdb\xc4\x3f\x38\x2b\x84\x24\xdb\xc5\x35\x9b\x45\x88\xf8\x75\x80\x24\xb2\xf7\xf0\x26\x98\x3c\xdb\xe8\xaf\xc4\xdf\x6f\xf0\x77\x76\xd4\x57\x30\xf7\x1d\x13\xb5\x18\xf0\x5e\xed\x41\x48\xea\xe5\xd9\x15\x55\xb8\x26\xce\xd4\x1c\x42\x6c\x02\x7b\x23\x7d\x22\x80\x13\xca\x46\x3d\x90\x5f\xc8\xc9\x63\x5b\x56\x48\xfd\x4f\xab\xb6\x05\x88\x1f\x84\xb5\xc2\x22\x2a\x02\x38\x82\xfb\x15\x33\x20\xbd\x3e\x55\x03\x18\x5b\x03\x18\xdb\x91\x49\xe2\x32\x42\x23\x6a\x9d\x70\xce\x93\x99\x4e\x3d\xf7\xfd\xc6\x3e\x07\xa9\xb2\xcf\x59\xd2\x33\xc7\x52\xc5\xb4\xb5\x0f\x53\x3a\x07\x28\x5f\xbc\xa0\xf3\x89\x16\xa7\x20\xae\x30\x4e\xaf\xf7\xc6\xd5\xae\x4c\xd3\x93\xdc\x1e\xfb\x87\x14\xfe\xbe\x0c\xa6\x3e\x9d\xd0\x48\x71\x12\xc1\x58\x8f\x99\xf6\x62\x91\x3c\x79\x45\xeb\xf8\xcb\x19\xc5\xb0\x29\x54\xaa\x57\x2a\xb3\xa6\x82\x9a\x63\xcb\x0a\x28\x05\x9a\x50\xd3\xe0\x6a\x5f\xb5\xbb\x35\x07\x69\x06\xfa\xa5\xb0\xa7\xa6\x4c\x46\xb1\xfa\x2d\x40\xd2\xce\x49\x28\xfe\xc9\x6d\x1b\x97\x76\x43\x5d\xa4\xc1\x76\x39\x5b\xc8\xec\xb3\xaf\x1a\xc6\xfc\x6d\x00\x18\x53\x62\x66\x6f\xca\xc1\x3e\xbf\xb0\xa5\x8a\x86\x03\xc5\x1e\xb8\x07\x68\x40\x92\x7c\x70\x28\xca\xec\xaf\xbc\x22\xef\x25\x06\xa5\x57\xae\x1b\x88\xf8\x8f\x55\x25\x0b\x1c\x9e\x84\x40\x34\x1a\xfb\xfc\x91\x6e\xe7\x94\xb9\x80\xf2\x68\x97\x0b\xa8\xca\x34\xe0\x29\xfe\xac\xeb\xf9\x4f\xab\x98\x9f\x56\xd2\x07\xeb\x2d\x10\xa7\x18\x80\xbb\xfd\xb9\x85\x2e\xa8\x0b\x63\x2a\xcf\xf1\x73\xd3\x5e\xfe\xd1\x54\xe9\x93\xfd\x4d\xbd\x29\xd1\x40\x26\x50\x2a\x7f\x2a\x87\xe9\x3c\x85\x67\xc7\x4a\xef\xd0\x56\xa0\x87\x9e\xce\xb5\xa8\x18\xa9\x20\x1d\x84\x8f\xc9\x19\x13\xa9\x25\x46\x42\x5c\xa8\x60\x5c\x49\x38\xbc\xf7\x19\x6d\x31\x57\x7d\x36\xe2\x8c\xe0\xaf\x00\x1e\x98\x71\x10\x8e\x44\x9d\x83\x73\x3f\x69\x39\x46\xc8\x3a\xab\x3a\x30\x5b\xb6\x9b\xfd\x48\xa2\x75\x62\xfd\x37\xd9\xf1\x5c\x94\x75\x7c\xaa\x6f\xd6\xab\x88\x12\x80\xff\xb4\xeb\x0f\x59\xd2\x48\x9b\xfb\x21\xbb\xb2\x3a\x14\x5f\x5c\xaa\xec\xda\xec\x24\x42\x9a\xfc\x11\x44\x18\xda\xbc\x0d\x85\x7b\x13\xc8\xb9\xf1\x5f\x47\x1f\xa2\x5e\xbc\xf1\xbf\xc6\x79\x5f\xab\x3d\xc7\xef\x7f\xbb\xe3\x91\x20\xd9\x0d\x49\x78\xbc\x67\x8a\x06\x8e\xa9\xde\xfe\x99\x9f\x4e\xfe\x9c\xa5\x5f\x38\x98\xf2\x5d\x49\xfa\x39\xdb\xa5\xd2\xc9\xde\x86\x6a\x3c\x83\xfc\xb9\x6f\x7e\x1f\x93\xe6\x77\x75\x6c\x7e\x7f\xad\xc8\xde\x1b\x34\x52\xb0\x7d\xbb\x44\xae\xe3\x90\x22\x08\x7b\x4c\x90\x12\xd8\xda\x14\x41\xd8\xea\x88\x94\xc0\xd6\xa6\x08\xc2\x7e\xad\x90\x12\xd8\xda\x14\x01\xf5\x05\xec\x30\xa7\x16\xcd\x51\x8b\xc5\x7c\x21\x16\x33\x08\x2b\xa1\x92\x09\xfb\x83\x01\x8a\x0a\x1b\xb0\x24\xa1\x06\x6c\xea\xd9\x90\x89\x05\xd9\xaf\x0f\x74\x3b\xfe\x02\xa8\xd5\xb0\xd3\x48\xb0\xa9\x7c\x37\x0e\xc9\x56\xb0\xf4\x7f\x10\x0b\x20\xc8\x2e\x44\x31\x82\x25\xa7\x5a\x88\x9a\x7e\x56\xab\x91\xd3\x4f\x75\xec\x28\x3b\x0b\x30\x20\x3b\x08\x45\xcb\x8e\xcd\x45\x4b\x76\x5e\x3b\x52\x76\x77\x8f\xaf\xb3\x44\xef\xc7\xdc\x5d\xce\x8f\xe2\x7e\x58\xfa\xf2\x64\x31\xec\x67\x34\xe2\x3b\x49\xab\xa3\x63\xd2\x51\xfc\x16\x60\x40\xfc\x10\x8a\x16\x3f\x33\xcb\x96\xf8\xbd\x76\xed\xe2\xef\x3c\xc0\xfb\xe5\xdf\x1d\xf5\x03\x0a\x70\x2f\xf2\x87\x35\x60\x24\x0e\xe8\x5a\x28\xf3\xe7\x8e\xb2\xb6\x00\x03\xb2\x86\x50\xb4\xac\x99\x3b\xb6\x64\xed\xb5\x6b\x97\x35\x32\x94\xfb\xa5\x8a\x21\x79\x40\x7e\x34\x9a\x7b\x25\xe5\xd9\x7e\xb9\x28\x0a\xac\x4c\xee\xb6\x27\x0a\xa3\x65\x5d\xdb\x31\xb6\x28\xa8\x42\x69\xcd\xd8\x76\x94\x86\x67\xaa\xb1\xa5\x02\xb0\xb1\x61\xeb\xb9\xcc\x4e\x75\xcb\x9a\x44\xc2\x10\x4d\xc2\x3a\xee\xc2\x06\xd4\x1c\x01\xa4\x35\x5d\x00\xdb\xca\x8e\xb5\x86\xfa\xee\x02\x77\x5a\x8c\x61\x03\x6f\x9b\x11\x00\x1a\xa8\xfe\x1d\xfd\xb4\x4e\x1a\x14\xfe\xf1\x71\x3d\x32\xbb\x14\x22\xa5\x6f\x41\x5d\xba\xfd\xd3\x87\x1f\xbf\xeb\x55\xc5\xa5\xdc\xa5\xbf\x8f\xcf\xe7\xec\xf4\xfc\xdf\xff\xeb\x7f\xfc\xb4\x65\xbb\xce\x8a\x6d\x5e\xce\x43\xa6\xc7\xc3\x5d\x55\x0d\x8f\xf1\xb9\xf7\xe3\x87\x7f\xfa\xbf\x29\xa0\x21\x57\x71\xd9\x01\x00")
 
 func staticBootstrapMinCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +222,27 @@ func staticBootstrapMinCss() (*asset, error) {
 	return a, nil
 }
 
-var _staticLicenseTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x8f\xdb\x38\x0c\xbd\xeb\x57\x3c\xcc\xa9\x2d\x8c\xec\xc7\x65\x81\xde\x3c\x89\x93\x08\x75\xe4\xc0\x56\x3a\x9b\xa3\x6c\x33\xb1\xb6\x8a\x15\x48\xf2\x0c\xf2\xef\x17\x92\x93\xd9\x16\xd8\xa2\x37\xda\x22\xdf\x7b\x7c\x24\x97\xf6\x7a\x73\xfa\x3c\x04\x7c\xe8\x3e\xe2\xcf\xdf\xff\xf8\x0b\x5f\xe8\x55\x8f\x78\x9e\xdc\x37\x5a\x20\x37\x06\xe9\xdd\xc3\x91\x27\xf7\x4a\xfd\x82\xb1\xa3\x9d\xa0\x1c\xe1\xd3\xc9\xba\x56\xf7\x3d\x8d\x9f\x70\x72\xf6\x82\xc9\xeb\xf1\x9c\xa1\xb3\xd7\x5b\x0a\x2e\xb6\xd7\xa7\x7b\x48\xee\x9c\x82\xeb\xd4\x1a\xed\x87\x18\xb3\x5e\xfb\xe0\x74\x3b\x85\xf4\xe2\xe3\x4b\x47\xe3\x0c\xa2\xc6\xfe\x37\xeb\xe0\xc9\x18\x3d\x9e\xa1\x12\x2a\xec\x09\x61\xd0\x1e\xde\x9e\xc2\x5b\xd4\xa0\xc6\x9e\x29\xef\x6d\xa7\x55\xa0\x1e\xbd\xed\xa6\x0b\x8d\x41\x05\x6d\x47\x9c\xb4\x21\x8f\x0f\x61\x20\x3c\x35\xf7\x8a\xa7\x8f\x50\x1e\x57\xe5\x42\x04\x53\xe3\x0d\x74\x3a\x59\x17\x10\x2c\x7c\xb0\x57\xa6\x70\x75\xf6\x6a\x3d\xf5\x18\x6c\xea\x08\x3d\xbd\x92\xb1\xd7\x08\x9c\xc5\x3c\x47\xfd\xd4\x11\x22\xee\x38\x5d\x5a\x72\x11\xea\x91\x3c\x8d\x3a\x78\xe8\x11\x8a\xfd\x12\xa8\x35\xb6\xfb\x06\xeb\x60\x6c\xdb\xde\xa0\xce\x4a\x8f\x3e\xc0\xd0\x59\x7b\x33\xf7\x10\x06\x15\xf0\x66\x27\xd3\x33\x3d\x76\x8e\x94\x9f\x89\x83\x0d\xca\xfc\x94\xbe\xa5\x18\xb7\x93\x36\x21\x8b\xf8\xc1\x3e\x28\x46\x1b\x06\x72\xec\x4a\xce\x47\x74\x8b\xa0\xbe\x51\xf2\x21\x79\x4b\x9e\xa0\xba\xc8\xec\x17\x8c\xc9\x81\xa0\x5a\xfb\x4a\xc9\xfd\x79\x53\x46\x1b\x74\x97\x8c\x9f\x47\x71\x25\x77\xd1\xde\x47\xad\xf7\x27\x3f\x28\x63\xd0\x52\x14\x6c\xa6\x9e\xfa\xe4\x86\x31\x11\x44\x93\x8f\x7a\xfc\xd4\xfa\xa0\xc6\xa0\x95\xc1\xd5\xba\xc4\x77\x17\x80\xc7\xa4\x16\x8c\x35\x51\x76\x9c\xbe\xc7\xcd\x4e\xb0\x2d\xdd\xe6\xe6\xc9\x5d\xfc\xac\xec\x33\x63\xfb\xff\x14\x68\x8f\x81\x1c\xb5\x37\x9c\x9d\x1a\x03\xf5\x19\x4e\x8e\x28\x42\x77\x83\x72\x67\x4a\xb6\xc7\x6e\x67\x03\x98\x6d\x83\xd2\xe3\x2f\x36\x0c\x3f\xdb\x30\xf6\xff\x1b\x96\x48\x7a\x52\x26\x36\xfe\x7d\x4b\x78\xd3\x61\xb0\x53\x60\x8e\xe2\xe6\x27\x9f\x33\xcc\x36\x45\x0d\xf7\x67\x18\x7d\xd1\xe1\x31\x7f\x7a\x9c\x60\xb0\x98\x3c\x65\x2c\xea\x7c\x1c\xd7\x7c\x59\xf4\x7e\x57\x19\xde\x8f\x8a\xbe\x3b\x29\xfa\xe1\xa0\xd8\x63\x10\x3f\x1a\x9e\xcd\x43\xb5\xf3\x48\xc3\xdd\xa2\xc4\xfb\x36\xd8\x4b\xcc\x65\xef\x9d\x68\x8f\xd3\xe4\x46\xed\x07\x4a\x35\xbd\x85\xb7\x89\xf1\x1f\xea\xd2\x35\x45\xe8\x93\x35\xc6\xbe\xe9\xf1\xcc\x3a\x3b\xf6\x3a\xcd\xf9\x33\x63\x72\x5b\xa0\xa9\xd6\xf2\x25\xaf\x0b\xf0\x06\xfb\xba\xfa\xca\x57\xc5\x0a\x4f\x79\x03\xde\x3c\x65\x78\xe1\x72\x5b\x1d\x24\x5e\xf2\xba\xce\x85\x3c\xa2\x5a\x23\x17\x47\x7c\xe1\x62\x95\xb1\xe2\xef\x7d\x5d\x34\x0d\xaa\x1a\x7c\xb7\x2f\x79\xb1\xca\xc0\xc5\xb2\x3c\xac\xb8\xd8\xe0\xf9\x20\x21\x2a\x89\x92\xef\xb8\x2c\x56\x90\x15\x22\xe1\x1d\x8a\x17\x0d\xab\xd6\xd8\x15\xf5\x72\x9b\x0b\x99\x3f\xf3\x92\xcb\x63\x86\x35\x97\x22\x62\xae\xab\x1a\x39\xf6\x79\x2d\xf9\xf2\x50\xe6\x35\xf6\x87\x7a\x5f\x35\x05\x72\xb1\x62\xa2\x12\x5c\xac\x6b\x2e\x36\xc5\xae\x10\x72\x01\x2e\x20\x2a\x14\x5f\x0b\x21\xd1\x6c\xf3\xb2\x4c\x54\xf9\x41\x6e\xab\x3a\xe9\x5b\x56\xfb\x63\xcd\x37\x5b\xc9\xb6\x55\xb9\x2a\xea\x06\xcf\x05\x4a\x9e\x3f\x97\xc5\x4c\x25\x8e\x58\x96\x39\xdf\x65\x58\xe5\xbb\x7c\x53\xa4\xaa\x4a\x6e\x8b\x3a\xa5\xcd\xea\xd8\xcb\xb6\x48\xbf\xb8\x40\x2e\x90\x2f\x25\xaf\x44\xf4\x64\x59\x09\x59\xe7\x4b\x99\x41\x56\xb5\x7c\x2f\x7d\xe1\x4d\x91\x21\xaf\x79\xc3\xc5\x86\xad\xeb\x6a\x97\x21\xda\x59\xad\x93\x67\x22\xd6\x89\x62\x46\x89\x56\xe3\x87\x89\x54\x75\xfa\x3e\x34\x31\x64\xb3\x96\x55\x91\x97\x5c\x6c\x9a\x58\xfc\x7d\xf2\x82\xfd\x1b\x00\x00\xff\xff\xc3\x61\x16\xf5\x45\x06\x00\x00")
+var _staticEmbedJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x54\xdf\x6f\x1b\x37\x0c\x7e\xcf\x5f\xc1\x06\xdb\x74\x46\xdd\x3b\xf7\x65\x0f\x6e\x9d\xa2\x1b\xf2\x50\x60\xeb\x80\x65\xc3\x30\x34\xc5\x20\xdf\xd1\x77\x4a\x74\x92\x20\xe9\x9c\x78\xad\xff\xf7\x91\x92\x2e\x3f\xd0\x36\xd8\x8b\x4f\xa6\xc8\x8f\xe4\xc7\x4f\x6c\x1a\x38\x1f\xb7\xd8\x05\x88\x03\xc2\x38\xe9\xa8\x5e\xe0\x28\x95\x46\x0f\x3b\xeb\x47\xfe\x01\x09\xbd\xb7\x93\x03\x6b\x40\x1a\x4b\x8e\x1e\x82\x8a\x58\xc3\xdb\xae\xa3\x38\x15\xe0\x86\x6c\x78\xd2\x34\x09\x25\xc5\x85\xc1\x4e\xba\x03\xe9\x1c\x4a\xbf\xa6\x2b\xbe\x05\x78\x1d\x5a\xaf\x5c\x84\xe0\xdb\xcd\xe9\x10\xa3\x0b\xeb\xa6\xc1\x5b\x39\x3a\x8d\x75\x6b\xc7\x26\x44\x19\x55\xdb\x20\x17\x55\x5f\x85\x53\xe8\x64\x94\x2f\x52\xfe\xcd\xe9\x78\xc8\xa7\x53\x90\xe1\x60\xda\xb3\xd7\x4d\x86\x3b\x2b\xf8\xbf\xb9\xa8\xac\x91\x1a\x64\x8c\x5e\x6d\xa7\x88\x61\x9d\x01\xc2\xb4\xbd\xc2\x36\x52\xfd\x5d\x36\x6c\x6d\x77\x00\xe7\x71\xa7\xb4\x4e\x55\x6b\x8c\x11\xfd\x92\x61\x92\x83\x96\xa6\x07\xa7\xda\xeb\x40\xfd\xf3\x9f\x49\xf6\xb8\xbc\x07\x18\x50\xf5\x03\x35\x82\x31\x73\xa7\x8c\x8a\x8a\x52\x17\xbb\x32\x8c\xe4\xd4\x2d\xea\x50\xc3\xdf\x76\xca\x9c\x89\x00\xd6\xab\x5e\x19\xe2\x3a\x44\xd8\x52\x5e\x15\x22\x76\xe4\x0f\xa9\xe7\x7f\xf2\x75\x60\x03\xc1\x32\xc8\xe3\xa9\xb4\xd6\xec\x54\x5f\x9f\x54\xbb\xc9\xb4\xdc\x6e\xb5\x80\x4f\x27\x00\x7b\x49\x19\x32\xb9\x1b\xe8\x6c\x3b\x8d\x68\x62\xdd\x4e\xde\xd3\xf7\x22\x5d\xbc\x22\x37\xb5\x83\xea\x59\xf1\xfb\xfc\x19\xca\xb1\xee\x31\xbe\x9d\x39\xab\xc4\x3d\xe7\x62\x91\xd1\x01\x3c\xc6\xc9\x1b\x86\x38\x96\x6c\x5a\x99\xeb\x47\xb9\x3c\xca\x88\xe7\x1a\xf9\x5f\x25\xa4\x58\xb0\x37\x7b\xd5\x03\x11\x4d\xae\x25\x19\x0d\xff\x55\xc1\x28\x64\x6c\xb2\x9b\xf3\x36\xda\xd6\x6a\x78\x0e\xa2\x69\x04\x7d\x72\xb4\x0d\xa9\x76\xe2\xe2\x0f\x62\x9a\x79\x84\x51\x1e\x98\xbd\xd1\x4e\x86\xe9\x9b\x4c\x87\x2c\x54\x27\xe3\xb0\xa4\xa8\x6b\x84\xaf\xa9\x2b\x0f\x39\xd4\x25\xfb\x56\x06\xa4\xdc\xa5\x88\x92\x8d\x21\x8c\x1c\xb1\xf6\xe8\xb4\x6c\xb1\x6a\x2e\x8b\x2a\x2f\xb3\x2c\x2f\x49\x97\xdf\x35\x4b\x10\xdc\x60\x41\x72\xd2\xcb\x31\x10\xd6\x87\x8f\x73\x6b\xac\x41\xb6\x7c\x2a\xda\x5b\x83\x78\x28\x45\xb1\x04\xd6\xe0\x6c\xe5\x33\x99\x58\x68\xb3\x89\xcf\xe2\xc8\x70\xfc\x08\x2b\xc6\xbc\xc6\x03\x0b\x23\x41\xcf\x83\x61\xfb\x9e\x84\x77\x47\xef\xa3\x59\x26\xd7\x0f\x14\xf7\x31\x4d\x23\x0b\x80\xdc\xe7\x68\x28\xa5\xd7\x6e\x0a\x43\xc5\xf8\xc4\xfd\x86\xa9\x47\xd3\xda\x0e\xff\xfc\xfd\xdd\xcf\x76\x74\xd6\xf0\x4c\x39\xae\xc0\x1c\x1f\x08\x41\xed\x08\x01\x9f\x90\x42\x76\xc8\x7a\xc8\x67\xd6\x00\x05\xa4\x01\xf0\xb0\x13\xb1\xcd\x37\xd2\xfe\x0f\x8d\x3e\x4f\x45\x55\xa5\x17\x8d\xa6\x8f\x03\x9c\xc1\x0a\xde\x80\x78\xc3\xb0\xe5\xe6\xca\x2a\x53\x89\x1f\xc4\x02\xd6\x79\x7e\x77\x05\x45\x15\x35\x7e\x83\xc4\x9c\x2c\x79\x50\x24\x3d\x1b\x71\x81\xb4\x07\x64\x59\x1a\xe2\x61\x5f\xf1\x40\x5a\xbb\x51\x1d\xe5\xdf\x80\x78\xb9\x5a\x7d\xff\xe5\xf5\xd6\x7a\x96\x2b\xdd\xaf\xbe\xbc\x2c\x0b\x64\x93\xba\x09\xf8\xee\x49\x02\xb2\xb3\x58\x2c\xe1\xe5\x2a\x55\xf6\xe3\x8a\xbe\xc4\xa8\xbb\x4d\xc8\x25\x92\x90\x88\xc8\xf7\x44\x6c\x4d\xbb\x05\x7d\xfc\x09\x49\x53\x58\xe5\xc4\xcb\xd9\xcd\xe0\x6d\xbc\x50\x5b\x7a\x06\x7d\x96\xf6\x8d\x32\x9d\xbd\xa9\x65\xd7\x9d\xef\x09\xe0\x17\xde\x55\x06\x7d\x25\x46\x0c\x81\xf6\x21\x29\xf6\x6e\x0d\xe1\x7e\xd6\x14\x4b\x0c\xf7\x75\x79\x56\xcf\x36\x77\x2f\x8c\xea\x23\x7b\xa0\x65\xd8\x62\xb2\x97\xc6\x69\xa7\x11\x6e\xfc\x2b\x65\xbb\x57\xe6\xfd\xca\xc9\x5a\x2b\xdb\x8b\x20\xb8\xf7\x82\xc6\xc7\x3a\x1e\x5c\x06\x14\x8f\x96\xe5\xda\x63\x50\xff\xf2\xcc\x9e\x82\xfc\x1a\xf7\xbf\xd2\x12\xa8\x5b\x54\xba\x9a\x53\xe4\xab\x87\xdc\x1e\x89\xa3\xe3\xa2\xa2\xdf\xff\x00\xc5\x9c\x91\xb5\x46\x07\x00\x00")
+
+func staticEmbedJsBytes() ([]byte, error) {
+	return bindataRead(
+		_staticEmbedJs,
+		"static/embed.js",
+	)
+}
+
+func staticEmbedJs() (*asset, error) {
+	bytes, err := staticEmbedJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/embed.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf7, 0x15, 0xdf, 0x27, 0x26, 0x61, 0xe, 0x23, 0xcd, 0x60, 0x41, 0x8f, 0x65, 0x72, 0x5f, 0x25, 0xdb, 0x3a, 0xd4, 0x6f, 0xff, 0x96, 0xc, 0x50, 0xbd, 0xe2, 0xe, 0x55, 0x9a, 0x11, 0x15, 0x95}}
+	return a, nil
+}
+
+var _staticLicenseTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x85\x53\xc1\x8e\xdb\x38\x0c\xbd\xeb\x2b\x88\x39\xb5\x85\x31\xbb\xdb\x4b\x81\xbd\x39\xb1\x32\x11\xea\xd8\x81\xed\x74\x9a\xa3\x6c\xd3\xb1\x76\x1c\xcb\x90\xe4\x09\xf2\xf7\x4b\xc9\xc9\xb4\x03\xb4\x28\xe0\x83\x64\x91\xef\x91\xef\x91\x6b\x3d\x5d\x8d\x3a\xf5\x0e\x3e\x34\x1f\xe1\xf3\xdf\xff\x7c\x81\xaf\xf8\xaa\x46\x58\xcd\xe6\x05\x1f\x21\x1e\x06\x08\xef\x16\x0c\x5a\x34\xaf\xd8\x3e\x32\x76\xd4\x33\x48\x83\xf0\xa9\xd3\xa6\x56\x6d\x8b\xe3\x27\xe8\x8c\x3e\xc3\x6c\xd5\x78\x8a\xa0\x21\xd4\x70\x38\xeb\x56\x75\xb7\x23\x9a\x53\x38\x4c\x73\x3d\x28\xdb\xfb\x33\x6b\x95\x75\x46\xd5\xb3\x0b\x2f\xd6\xbf\x34\x38\x2e\x20\x72\x6c\xff\xd2\x06\x2c\x0e\x03\xdd\x41\x06\x54\xd0\x1d\xb8\x5e\x59\xb0\xba\x73\x17\x5f\x03\x85\x31\x69\xad\x6e\x94\x74\xd8\x42\xab\x9b\xf9\x8c\xa3\x93\x4e\xe9\x11\x3a\x35\xa0\x85\x0f\xae\x47\x78\x28\x6f\x19\x0f\x1f\x41\x5a\x98\xa4\x71\x1e\x4c\x8e\x57\xc0\x8e\xfa\x70\xe0\x34\x58\xa7\x27\x26\x61\x32\x7a\xd2\x96\xd0\x7a\x1d\x3a\x82\x16\x5f\x71\xd0\x93\x07\x8e\x7c\x9c\xc1\x76\x6e\x10\x3c\xee\x38\x9f\x6b\x34\x1e\xea\x1e\x3c\x8f\x8a\xe4\x22\x0d\x25\xfb\x23\x50\x3d\xe8\xe6\x05\xa8\xcd\x41\xd7\xf5\x15\xe4\x49\xaa\xd1\x3a\x18\xf0\xa4\xec\xb0\xf4\xe0\x7a\xe9\xe0\xa2\xe7\xa1\x65\x6a\x6c\x0c\x4a\xbb\x10\x3b\xed\xe4\xf0\x5b\xfa\x1a\xfd\xb9\x9e\xd5\x40\x44\x84\x4f\x5c\x37\x8a\x51\x53\xb6\x61\x13\x1a\xeb\xd1\x35\x38\xf9\x82\x41\x87\xa0\x2d\xb9\x0c\xb2\xf1\xcc\x96\x9c\xae\x88\x48\xd6\xfa\x15\x83\xfa\xcb\xa4\x10\x00\xb9\xe4\x85\x5f\xac\x20\xa4\xb3\xb2\xd6\xd7\x7a\x7b\xb2\xbd\xa4\xb9\xa9\xd1\x17\x3c\xcc\x2d\xf5\xef\xd5\xa0\x5f\x04\xa2\xc8\x10\x6f\xeb\x5c\x5b\x27\x47\xa7\xa8\x87\x89\xd4\xf7\x7c\xb7\x02\xe0\xee\x14\xf1\x97\xbe\x6c\xef\xbe\x85\x2b\x0d\x9d\xae\xf1\xba\x34\x4f\x9c\x76\xa9\xec\x5f\xc6\xf6\x3f\x2a\xa0\x7a\xa8\x3b\xa4\x46\x4f\x86\xe0\xb1\x8d\x68\x32\x11\x3d\x74\xd3\x4b\x73\xc2\x20\xbb\xef\x76\x11\x80\xe9\xda\x91\xe6\x7f\x98\x30\xf8\xdd\x84\xb1\x5f\x4f\x58\x20\x69\x91\x7a\x53\xe3\xbb\x96\xe0\xa2\x1c\x19\xe5\x18\x6d\x13\x4d\x7e\xd0\x39\x82\x45\x26\x5f\xc3\xed\x19\x06\x75\x56\xee\xee\x3f\xde\x57\x90\x40\x67\x8b\x11\xf3\x75\xde\x97\x6b\xd9\x2c\x7c\xdb\xab\x08\xde\x96\x0a\x7f\x5a\x29\x7c\xb7\x50\xec\x6e\xc4\x7b\xc1\xa3\xc5\x54\xbd\x58\xea\x6e\x12\x05\xde\x4b\x4f\xeb\x4d\xb1\xec\xad\x13\x92\xa8\x9b\xcd\x48\x94\x18\x72\x5a\x5a\x1f\x1d\x18\xff\xc3\x26\x6c\x93\x87\xee\xf4\x30\xe8\x0b\xb5\x46\x94\x63\xab\x82\xcf\xe4\x58\xb5\xe5\x50\xe6\x9b\xea\x39\x2e\x38\x88\x12\xf6\x45\xfe\x4d\x24\x3c\x81\x87\xb8\xa4\xfb\x43\x04\xcf\xa2\xda\xe6\x87\x0a\x28\xa2\x88\xb3\xea\x08\xf9\x06\xe2\xec\x08\x5f\x45\x96\x44\x8c\x7f\xdf\x17\xbc\x2c\x21\x2f\x40\xec\xf6\xa9\xe0\x49\x04\x22\x5b\xa7\x87\x44\x64\x4f\xb0\xa2\xbc\x2c\xaf\x20\x15\x3b\x51\x11\x68\x95\x83\x27\xbc\x41\x09\x5e\x32\x02\xdb\xf1\x62\xbd\xa5\x6b\xbc\x12\xa9\xa8\x8e\x11\x6c\x44\x95\x79\xcc\x0d\x81\xc6\xb0\x8f\x8b\x4a\xac\x0f\x69\x5c\xc0\xfe\x50\xec\xf3\x92\x13\x7d\xc2\xb2\x3c\x13\xd9\xa6\x20\x16\xbe\xe3\x59\xf5\x48\xac\x44\x05\xfc\x1b\x5d\xa0\xdc\xc6\x69\x1a\xa8\xe2\x03\x55\x5f\x84\xfa\xd6\xf9\xfe\x58\x88\xa7\x6d\xc5\xb6\x79\x9a\x70\xfa\xb9\xe2\x54\x59\xbc\x4a\xf9\x42\x45\x4d\xad\xd3\x58\xec\x22\x48\xe2\x5d\xfc\xc4\x43\x56\x4e\x28\x45\x08\x5b\xaa\x63\xcf\x5b\x1e\x7e\x11\x5f\x4c\xdf\xba\x12\x79\xe6\x35\x59\xe7\x59\x55\xd0\x35\xa2\x2e\x8b\xea\x2d\xf5\x59\x94\x3c\x82\xb8\x10\x25\x95\xca\x36\x45\x4e\xf0\x5e\x4e\xca\xc8\x03\x08\xe5\x65\x7c\x41\xf1\x52\xc3\x3b\x47\x28\xc4\xdf\x0f\xa5\x3f\xb2\xa5\x96\x84\xc7\x29\x61\x95\x3e\xf9\xe7\xe0\x47\xf6\x3f\xc3\x61\x16\xf5\x45\x06\x00\x00")
 
 func staticLicenseTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +262,27 @@ func staticLicenseTxt() (*asset, error) {
 	return a, nil
 }
 
-var _staticPrivacyHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x54\x4d\x8f\xe4\x34\x10\xbd\xe7\x57\x14\x7d\x60\x17\x69\x92\x30\x82\x03\x82\xde\x96\x38\xad\xe6\x80\xb4\x12\x48\x2b\x8e\x15\xbb\x12\x9b\x38\xae\xac\xab\xdc\x21\xff\x1e\xd9\xe9\xe9\x5e\x0d\x70\x8b\xba\x5f\xea\xd5\xfb\xa8\x9c\x83\x8f\x33\xb8\x44\xe3\x87\x93\x53\x5d\xe5\xe7\xbe\x9f\xe9\xea\xe3\x90\xd3\x4c\xdd\xe0\x75\xc8\x66\x26\xed\x3c\xf7\x0b\xa6\xd9\xf2\x16\x8d\xc8\xfd\xb9\x33\x22\x27\x48\x14\x3e\x9c\x44\xf7\x40\xe2\x88\xf4\x74\x39\xf7\x65\xee\xa5\x39\xbb\xe7\xcb\xa7\xe4\xaf\x68\x76\xf8\xc4\xc1\x9b\xfd\xdc\xbb\xe7\x4b\x73\x5e\x2f\x9f\x09\x22\x91\x85\x9d\x73\x82\x95\xd2\xe2\x45\x3c\x47\x50\x06\xa1\x68\x01\x23\xd0\x82\x3e\x74\xf0\x87\x23\x18\xd1\x28\xa8\x43\x2d\xf8\x02\xd0\x3b\xe0\x09\x30\xda\x46\x1d\x41\x22\xe3\x57\x4f\x51\x05\x78\x3c\xd0\x37\xc4\x82\x3b\x0c\x04\x5b\xf2\xaa\x54\x39\xac\x97\xf9\x18\x6d\x38\x6a\x7d\x07\xa3\x05\xc9\xc3\x5f\x64\xb4\x09\x3e\x52\x19\xf2\x4a\x02\x98\xca\xbe\x57\x4a\x10\x78\x9a\xc8\x76\xe7\x7e\xad\x3a\xca\x08\x8e\x61\x3f\x70\x02\x1b\xbd\x0b\x01\x2a\xb2\xea\xe0\x78\x48\x1c\xc8\x61\x18\xeb\x1c\x8e\x24\xb0\x39\x4a\x54\xd5\xe8\xbe\x12\xf8\x08\xea\xa8\xf1\x51\x29\x8d\x68\xe8\x09\x8c\x63\x16\x02\x7c\xc8\xaa\x1b\x9a\xe0\xcd\x0c\xdf\x7e\xc9\xac\xbf\xfc\x4e\xd1\x76\xc7\x23\x7c\xae\xbc\xf1\x46\x1c\xc2\xc1\x5a\x97\x6a\xd0\xda\x44\x22\xc0\xb7\x9d\x5e\x57\xf5\xea\x38\xeb\xdb\x08\xee\xd2\x5e\x62\xf5\xfd\x09\x36\x02\xcb\xf1\x9d\x82\x28\x27\x2a\x8b\x82\x8f\x23\xa7\x05\xb5\x46\x56\x9c\xde\x38\x07\x0b\x18\x02\x6f\x90\xe5\x1e\xe3\x41\xd5\xfc\x1f\x15\xbc\xe8\x3b\x39\xc6\xda\xe2\x01\x82\x61\x9e\x7d\xf5\xe3\xb0\x2d\xf1\x26\x94\x3a\x78\x19\xab\x59\x96\x02\x29\x35\x07\x4a\x9e\xea\x6f\x89\x16\xbe\x12\x14\x38\x0e\x3e\x78\xdd\xdf\xd0\xbf\x09\xa1\x83\x3f\x39\x83\xc1\x08\x18\x84\x9b\x33\xbe\x39\x80\x8d\x06\x5c\x57\xe9\x44\xd1\xcc\xf4\xb7\x71\x18\x27\xea\x0c\x2f\xfd\x97\x4c\x52\x24\x4b\xff\xc3\xf7\x3f\xfd\xf8\xdc\x3b\xde\x5a\xcb\xad\x6f\x8f\x15\xda\x87\xb2\x76\x4c\xbc\xb4\x18\x5b\x5c\xd7\xb6\x18\xd4\xfa\x76\xc2\x2b\xb5\x13\xf3\x14\xa8\x65\xcc\xea\x5a\x34\x86\x44\x5a\xe5\xd3\x65\xe4\x6a\x9d\x3a\x2f\x30\x65\x6f\xa9\x68\xf8\x5a\x59\x85\x9e\x7b\xbc\xdc\xf3\xf9\xb5\x14\xed\xb5\x75\xff\xd9\x36\x71\x47\x2a\xeb\x4a\x98\xee\x9e\xde\xcb\xa3\xb7\xee\x8c\x1c\x2c\xdd\xff\x6f\x3e\xfe\x56\x1b\x6f\x0c\xe7\x52\xba\x51\x29\x01\xc2\xe2\x63\x56\xaa\x1d\xe2\x0e\xde\xbf\x94\x0b\xa3\xfd\x28\x46\x69\x2b\x99\xb9\x94\x5a\x1d\xa5\x9a\x8a\xc3\x2b\x01\xc6\x06\xb3\xf2\x82\x4a\x16\x52\x0e\x74\x94\xa5\xa8\xaa\x75\x3c\xe2\x94\x9b\x8c\xee\xbb\xaf\xae\x2a\xd1\x71\x74\x5c\x3c\x49\xb6\x5d\x31\xe9\x0e\x18\x31\xec\xea\x8d\x80\x98\xe4\x57\x15\x48\x39\x46\x1f\x27\xa8\x45\x24\x58\x71\xa2\x1a\x70\x02\x9b\x70\xd4\xe6\xe6\xd0\xe3\x80\x13\xa1\x85\xa1\x8c\xda\x39\xd2\x53\x59\xe3\xcd\x4d\xbf\x7f\xed\xc7\xbf\xaa\x31\x79\x75\x79\xa8\x5d\x78\x7c\x26\xfb\x25\x07\xf5\x6d\x25\xa2\x74\xba\x54\x02\x75\x89\xf3\xe4\xea\x4e\x86\x2d\x95\xe4\x4a\xa4\x57\x4a\x7e\xdc\x8f\x9c\x8b\xdb\x42\x61\xfc\xe6\xae\xfb\x23\x3f\x38\xfb\xd3\x65\x40\x33\x97\x97\xca\x10\xc7\x0b\x15\x71\x8f\x0a\xfc\x13\x00\x00\xff\xff\xb9\x7e\xb6\xb8\xbd\x05\x00\x00")
+var _staticOpenapiJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5a\x4b\x73\xdb\x36\x10\xbe\xfb\x57\x60\xd8\xce\xe4\x50\x59\x92\x5d\x67\xd2\x69\x4e\x49\x9a\xa6\xce\x38\x4d\x46\x71\x2f\x4d\x32\x1e\x88\x5c\x49\x88\x49\x80\x05\x40\xab\x8a\x47\xff\xbd\xbb\x00\x49\x91\x14\x25\x51\x96\x93\xf4\x75\x69\x45\x10\x5c\x2c\x76\xbf\xfd\xf6\xe1\xdc\x1e\x31\x16\xa8\x14\x24\x4f\x45\xf0\x23\x0b\xbe\xef\x0f\xfb\xc3\xa0\x47\xab\x42\x4e\x14\x2e\xdd\xe2\x6f\x7c\xb2\xc2\xc6\x40\x3b\x92\x2c\xb6\xe2\x18\x12\x2e\x62\xd0\xec\xc9\x9b\x73\xb7\x1b\x77\xdc\x80\x36\x42\x49\xda\x73\x52\xac\x45\x60\x42\x2d\x52\x9b\xaf\x5f\x08\x63\xd9\x54\xab\x2c\x35\x8c\xcb\x88\x69\x08\x45\x2a\x40\x5a\xd3\x63\xa9\x86\x1b\x01\x73\x96\xa2\x18\x25\x79\x2c\x3e\x41\xc4\x62\xb0\x16\x9f\xdd\x66\x03\xf8\x1f\x3b\x83\x84\x4d\xb4\x4a\xe8\x17\x8b\xd5\x74\x0a\xd1\xb1\x90\x2c\x33\xa0\x1f\x18\xf6\x82\xd4\x62\x3c\x0c\x55\x26\x6d\x9f\x3d\x97\x51\xaa\x04\x8a\xc7\xdd\xdc\x7a\x09\x4a\x97\x47\x95\xd2\x35\x30\x9e\xa1\x40\x69\x45\xc8\x2d\x9e\x3b\x17\x76\xe6\x4e\x30\x3c\x01\xf6\x42\xa9\x69\xec\x4e\xc3\x93\x42\xa5\xae\x05\xee\x37\xee\xfd\x1c\xc6\x46\x58\xe8\xe5\xd7\xf9\x23\x13\x24\x8b\xbd\x7c\xfb\xfa\x57\xf7\x08\x78\xe1\xb1\x8a\x16\x8f\x59\xa8\x95\x31\xc7\x4a\x0b\x12\x92\xbf\xf2\x47\x6b\xf8\x08\x21\x9e\xda\x0f\xd0\x68\x4b\x67\xfb\x94\xdb\x99\x59\x19\x7f\x70\x73\x32\xf0\x66\x2b\xd7\x70\x75\x0a\xb6\xf2\x88\x0b\x26\x4b\x12\xae\x17\xa5\xa5\x49\xc1\xdc\xda\x66\xa6\xe6\x92\x29\xe9\xd6\x66\x2a\x81\x94\x4f\x51\x6b\x54\x25\x12\x26\x8d\xf9\x02\xed\x12\x81\xce\xfd\xe6\xa4\x69\x30\xa9\x92\x06\x4c\xed\x10\x7c\x71\x3a\x1c\x36\x96\xd6\x3d\x7d\x49\xde\x41\x1d\xd0\x96\xb9\xe2\xbd\xfa\xfe\x50\x49\x8b\xf6\x5e\x13\x84\xaf\x78\x9a\xc6\xe4\x07\x14\x35\xf8\x68\x9c\xbc\xe6\x1e\xba\x6c\x88\x50\xe0\xad\xef\x08\xae\x8b\xd4\xa1\x55\x8d\xc9\xb6\x8d\xc3\xf3\x3d\xa9\x46\xe0\x6b\x2b\xd6\x6e\x58\xd9\xb3\xb2\x7a\x29\x92\x6b\xcd\x17\x41\x0f\x03\xc4\x42\xe2\x5f\x7d\xab\x61\x42\xaf\xbe\x19\x84\x2a\x41\xa3\x11\xa2\x07\x5e\x41\x33\x78\x41\x22\x82\xe5\xb2\xe5\x80\xf5\xb5\xe6\x4a\xfd\xb9\xfa\xb4\xfa\x5d\xfc\xf2\xff\x5f\xf6\x9a\x98\x19\xdc\x8a\x68\xd9\x15\x38\x23\xb0\x5a\xc0\x0d\x81\xd8\x08\x49\xb0\x77\x42\x08\x2a\x61\x9c\x45\xb8\xc4\x32\xb9\xc9\xb3\x88\x5b\x8d\x01\x43\x41\x85\xb2\xde\x55\xb4\xbd\x0d\x24\xbe\xa0\x03\x44\xe4\x6c\xe7\x50\x42\x30\xa7\xa7\x3c\x70\x22\x5c\xb3\x3a\x43\x5c\x56\xbc\x5b\xda\xdd\xa0\x62\x72\x5a\x35\xe4\x87\xfb\x44\xab\xbb\xcc\x16\x94\xb6\xc2\xb2\xaa\x67\x27\x10\xd4\x3c\x58\x3d\x2c\x38\x1b\x9e\x6d\x16\x53\xde\x6e\xf0\x5c\x6b\xa5\x83\x2e\xce\xcf\x59\xae\xea\xf8\x54\x99\x6d\x9e\x97\xc8\x00\x9e\x57\x1d\x31\x32\xe0\xe1\x6c\xc5\xd1\x44\x16\xdc\x9b\x89\xcd\x55\x16\x3b\xfa\x06\x71\x83\xee\x22\xba\x54\x99\x27\x58\x42\x88\xb0\x75\x22\x71\x64\xf7\x14\x69\x70\xdb\x0d\x8b\x4d\x18\x8f\x83\xb7\x28\x68\xe4\x57\x82\xe5\x7d\x7a\xb9\x96\x5d\x12\x30\x06\x69\xf0\x9f\xca\x4d\xa5\xfa\x87\xb0\xd3\x2b\x2f\xe4\x33\xf0\x53\x03\xdd\xc3\x3d\xd0\xdd\xf8\xf4\xe4\xee\x9f\x7e\x7f\xe7\x4f\x4f\x1e\xde\x6f\x38\x56\xbd\xd5\x29\x1e\x29\x06\x30\xe0\x5a\xea\x21\x66\x15\x43\x8a\xd6\x8b\xd6\xe0\xac\x86\x5e\x23\x02\x9e\x2e\x58\x04\x13\x8e\x15\x9c\x0b\xf3\xa2\x40\x99\x73\x81\xa5\x08\x56\x4c\x58\x3b\x79\xb9\xb9\xb2\x6c\x86\x65\xce\x18\x40\x52\x64\x5b\x2a\x9d\x68\x61\x42\xa5\x5f\xd4\x67\x6f\xc1\xb2\xf7\x01\x7d\xfc\x3e\x20\x95\x26\x3c\x36\x40\x3f\x34\xd8\x4c\x4b\x26\x92\x04\x22\x81\xf5\x54\xbc\x70\xe5\x51\xaa\xe2\xd8\x9d\xfb\x51\x8d\x51\x5f\x4c\x21\x1c\x6b\x9e\xbf\x29\x51\xd0\x89\x68\x6d\xb4\x39\x6a\xeb\x19\x0e\x0d\x8c\x62\xc9\x78\x13\xd5\x24\xc7\xcf\x96\x38\x5e\xaa\xf1\xd6\xb4\x71\x3a\x3c\xed\x74\x1f\xb2\xf9\x1c\x9d\x17\x6a\x70\x15\x2e\x39\x44\x60\x5d\x88\x3e\x8f\x99\xce\xa4\xa4\xdc\xda\xb8\xc4\x0c\x1d\xe4\x53\xf9\x1a\x0f\x5e\x28\x7f\x21\x77\x81\x96\xe3\x7e\x1b\x5d\x30\x35\x29\xbc\x1d\x74\xcd\xe8\x6b\xf7\xfb\x92\xa6\xfc\x9f\xa3\xd0\x55\xfb\x55\x8b\xcf\x66\x10\x5e\x53\x5b\xc1\x1d\xc0\x0a\x70\x8d\x17\x2d\xed\xd9\x16\x5e\x42\xcf\xf8\x56\xe8\x1a\x52\x47\x65\x09\x24\x0a\x69\x88\xe2\xec\xf4\x0c\xfb\x95\x8c\xba\xb4\x89\xf5\xe5\xc9\xa2\x0c\xce\x5e\xd1\xd1\xa0\xf8\x1b\xf7\x12\xbb\xbc\x42\x09\x6a\x15\xfb\xff\x92\xe2\xd4\xc5\xd0\x57\x0b\x8b\x03\xb0\x7d\x1f\x35\x6d\xd9\x15\xaf\x3e\x5e\xb5\xc6\xb5\x74\x50\x85\x6d\x35\x33\xd4\xe0\xdb\xf4\xe7\xd1\x8e\xba\x6f\x77\xcd\xb7\xb9\xde\xdb\x55\xeb\x55\x95\x79\xe7\x9b\xcd\x2b\x8f\x41\x93\xe5\x5f\xb0\x80\xe6\x07\xc1\x87\xb5\x4f\x77\x94\x88\x2b\x69\x2d\x38\xed\xad\xa1\xec\x49\x5e\xd9\x9f\xff\xd4\xa3\x3c\xff\x3e\xb0\x68\x38\x9f\xd7\x8b\xc1\x4b\xa5\xfa\x58\x50\x44\x42\x3c\x09\x96\xbd\x96\xea\x37\x57\xbd\x2d\x3e\x5a\xb6\x8f\x8b\x74\xbf\x53\xc7\xcb\x52\x07\x37\xb9\x78\xc5\xf5\x75\xa4\xe6\xb2\xcf\x2e\x5d\x31\x93\xa7\xe2\x07\x86\xd1\x40\x8b\xfa\x90\x58\x48\xa0\x2c\xc7\xa3\x88\x52\x5e\x66\x55\xc2\x69\xc4\x13\xc7\x8b\x7e\xbb\xea\xae\x07\x3a\xa4\x70\x6f\x51\xd9\x0b\x45\xb5\x22\xb0\x58\x3a\x99\x5e\xae\x0f\xda\x91\xac\xea\x86\x52\x93\x8a\x81\xfb\x6c\x94\xa3\x82\x09\xbf\x4e\x23\x26\xc6\xcd\xb5\x71\x8c\x48\xc4\xf6\x98\x4d\x04\xc4\x91\x59\xbd\x8e\x14\x18\xf9\xc0\xd2\x36\xb7\x8b\xe8\x54\x4c\xa5\xd2\x50\x2b\xb2\xf6\xec\x31\x72\x62\xec\xe2\x48\x1f\x2b\x51\x84\x71\x6d\xf6\xf9\xe4\x93\x48\xbb\x42\xf4\x21\x8b\xc4\x54\xb8\x42\xf4\xf7\xf3\x37\xdf\x9d\x61\x22\x88\x60\xa3\xe0\x74\x86\x64\xd1\x4d\xf4\x79\x39\xdc\x20\x7b\xa2\xe9\x78\x2e\xba\x5b\x53\xd4\x82\x24\x2a\x8b\x6b\x67\x8f\x95\x8a\x81\x4b\x7f\xb8\xab\xc1\x73\x02\xba\xbf\x91\x4f\x5b\x9a\x09\x3c\xb9\xd6\x18\xb0\x69\x57\xc9\x80\x36\xf5\x08\x37\x18\x20\x94\x3c\x11\x18\x6c\xf4\xf3\x33\xf6\xe8\x87\xe1\xa3\xa0\x9d\x22\x0f\xcb\x3a\x39\xe7\x2f\xdb\x6f\x92\xef\xaa\xde\xe3\xc9\x0a\x59\x47\xbb\x23\x72\x33\xb8\xbb\x41\x3a\x70\x03\xee\x6e\xe0\x79\x3d\x9e\x64\xc6\xcf\x8d\x11\x98\x31\x4c\x10\xa0\x99\x2d\x62\xd7\xd1\x2a\x06\x7f\xc9\x4f\x57\x79\x8c\x00\x56\xdf\x18\xef\x6e\x5e\x82\x94\x55\x08\x71\x5d\x96\x88\xa0\xdf\x96\x10\x0b\x15\x83\x51\xd9\x78\xfc\x87\xec\x51\x53\x28\x0c\x0f\x9a\x7b\x14\x70\x5a\xd6\xa5\xe6\x99\xe3\x8a\x32\x47\xab\x51\xb6\xf8\xc4\xcf\xf8\x0e\xf6\x47\x7b\xc6\xae\xab\xd9\xcd\x67\x75\xbf\x74\xf0\xdc\x2f\x97\xaf\x2e\xd0\x31\x94\xae\xd0\x79\xe5\x9f\x59\x0a\x97\x15\x09\x97\x55\x3f\x6b\x78\x05\x3d\x36\x55\x7a\xb1\x5b\x39\xff\xf7\x86\xea\x36\x81\xd4\x32\x85\x66\x0d\x49\x1e\x31\x57\xdc\x6e\xb8\x81\xa7\x2c\x5a\x8b\xf0\xe8\x63\x2b\x12\x68\xaa\x14\x2b\xc4\xd6\xc1\x12\xa2\x56\x36\xaf\x6f\x5c\xfd\x3d\xeb\x20\x6c\xae\x82\x7b\xb9\x0d\x6f\xc5\xe8\xee\x60\xc4\x59\xb5\x47\xbc\x7c\x81\x20\xec\x5a\x40\x62\x8d\xfa\x67\x87\x5d\x33\x9b\xc4\xfb\xc6\xf2\xc8\x0d\x7a\xbe\xa6\x69\x8d\xe5\x36\x33\x1b\x30\x0b\x32\x4b\x5c\xcb\x90\xfa\x89\xbb\xeb\x18\xdc\x18\x0a\xf1\xec\xc6\x73\xc1\x87\x06\x7f\x17\x65\xc0\x3e\x56\xa0\xd6\xf0\x8b\xf0\xd9\xb6\x5e\xe5\x40\xb3\x94\x83\xbc\xa6\x45\xf2\x39\xc1\x41\xc4\x50\xc8\x3e\x44\x88\x29\xea\xa9\xed\x3c\x98\xbb\x75\xf7\x46\x3f\xa3\x3c\x94\x81\x1c\xfc\xb7\xd2\x4f\x4b\x61\x79\xa7\x08\xc9\xff\x61\xc1\x0e\xbf\x77\xc1\x86\xef\xaf\x3a\xc8\x92\x88\x22\x19\xc2\x9d\xd0\x56\x1a\x7d\xdb\xb8\xe2\x68\x79\xf4\x17\x07\x72\xb0\x51\x5a\x21\x00\x00")
+
+func staticOpenapiJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticOpenapiJson,
+		"static/openapi.json",
+	)
+}
+
+func staticOpenapiJson() (*asset, error) {
+	bytes, err := staticOpenapiJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/openapi.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5, 0xcf, 0xad, 0x92, 0x82, 0x63, 0xff, 0xc, 0x87, 0x2d, 0x7b, 0x84, 0x21, 0x8a, 0xf5, 0x30, 0x50, 0xd1, 0xc1, 0x75, 0x27, 0x98, 0x7c, 0xc, 0x98, 0xc8, 0x53, 0xb8, 0xce, 0x7d, 0x9d, 0x61}}
+	return a, nil
+}
+
+var _staticPrivacyHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x75\x55\x4b\x8f\xdb\x36\x10\xbe\xeb\x57\x4c\x7d\xe8\x26\x80\x25\x25\x68\x0f\x45\xea\x18\xe8\x29\xd8\x43\x81\x00\x09\xb0\xe8\x91\x22\x47\x22\x6b\x8a\xa3\xf0\xb1\xae\xfe\x7d\x86\xa4\x56\xde\x3a\xed\x8d\x96\x46\x33\xf3\xbd\xe8\x93\x35\xee\x02\xda\xe3\xf8\xf1\xa0\x63\x5c\xc2\x87\xbe\xbf\xe0\xb3\x71\x43\xf2\x17\xec\x06\x13\x87\x24\x2f\x18\x3b\x43\xfd\x2c\xfc\x45\xd1\xd5\xc9\x10\xf6\x73\xc7\x3f\x0e\xe0\xd1\x7e\x3c\x84\xb8\x5a\x0c\x1a\x31\x1e\xce\xa7\x3e\xf7\x3d\x37\x27\xfd\xfe\xfc\xd9\x9b\x67\x21\x57\xf8\x4c\xd6\xc8\xf5\xd4\xf3\xa3\xe6\xb4\x9c\x9f\x10\x1c\xa2\x82\x95\x92\x87\x05\xfd\x6c\x42\x30\xe4\x20\x12\x04\x74\x0a\x84\x03\x9c\x85\xb1\x1d\x7c\xd5\x08\xa3\x90\x11\xa2\x16\x31\xd7\xe7\x82\xb8\x17\x1c\xf9\xa4\x9a\xc8\x45\x1e\xa5\x59\x0c\xbf\x0b\x40\x63\xad\xde\x2a\x66\xb1\xc2\x80\x70\xf5\x26\x46\x2c\x33\x94\x09\x97\xda\x5a\x92\x8b\xe5\x1b\x6e\x03\x21\x0d\x7f\xa3\x8c\x0d\xaf\x8f\xb9\xc9\xcb\x10\x10\x3e\xef\xfb\x8c\x1e\x2c\x4d\x13\xaa\xee\xd4\x2f\x05\x47\x6e\x41\xce\xae\xb5\x2e\xc0\x15\x1f\xac\x85\x52\x59\x70\x30\xa4\x02\x71\x40\x2d\xec\x58\xfa\x90\x43\xae\xd3\xc8\xc7\x8c\x26\xae\x0b\x82\xe1\xad\x34\x36\x86\x77\xf1\x0c\x16\x8f\x20\x35\x51\x40\x10\x37\x58\x65\x43\xc9\x2c\x5e\xe0\xe7\x6f\x89\xe2\xef\x5f\x78\x40\x57\x8f\xf0\x54\xe6\xba\x6d\x30\x1f\xcb\xd4\xb2\x54\x23\x94\xf2\x18\x98\x95\x6d\xa7\x97\x55\x4d\xd4\x94\xe2\xbd\x04\x3b\xb4\x47\x57\x78\x3f\x32\x26\x50\xe4\x1e\x22\x84\x48\xbc\x74\xe6\xda\xb8\x91\xfc\x2c\x62\x91\x2c\x33\x7d\xa5\x64\x59\x35\x6b\xe9\x0a\x29\xec\x32\xd6\x51\xcd\xff\x8d\x82\xc7\xf8\x10\x6a\x5b\x95\x39\x10\x2c\x07\x5d\x4c\xe1\xa3\xd2\xe6\xe9\x1a\xd0\x73\xe1\x58\xc8\x52\x68\x31\x62\x53\xab\xc2\xb1\x3c\xf3\x38\xd3\x33\xd3\xca\xe5\x62\x30\xd6\xc4\xf5\x6e\xfc\x9d\x08\x1d\xfc\xc5\x5f\x49\xd6\x56\xd8\x40\xcd\x49\xdc\x05\xe0\x8a\x83\x58\x96\xd0\x85\x28\xd8\xfb\xff\x48\x2d\xdc\x84\x9d\xa4\xb9\xff\x96\x30\x64\xc8\xa1\xff\xe5\xdd\x6f\xbf\xbe\xef\x35\x5d\x5b\x45\xad\x69\xeb\x0a\xed\x0d\x59\x3b\x7a\x9a\x5b\xe1\x5a\xee\xd4\x66\x82\xb8\x68\x12\x5c\x32\x11\x4d\x16\x5b\x12\x29\xea\x56\x48\xc9\xba\xb4\x91\x0e\xe7\x91\x0a\x75\x51\x9b\x00\x53\x32\x0a\x33\x86\xd7\xc8\x4a\xe9\xa9\x17\xe7\x5d\x9f\x3f\xb2\xd1\x5e\x5c\xf7\x9f\x6e\x0b\xba\xaa\xb2\x2c\x28\xfc\xce\xe9\x6e\x9e\xb8\x79\x87\x67\x2b\xdc\xdf\x37\x9f\xfe\x2c\x8e\x97\x92\x52\x36\xdd\xc8\x96\x64\x61\x66\xe3\x52\xc4\xe2\x21\xea\xe0\xcd\x63\x4e\x18\xae\xd5\x18\xd9\xad\xc8\xb6\x64\x53\xf3\x43\x5f\x54\xd1\x8c\x96\x1d\xdb\x30\x50\x62\xa3\xb0\xbe\x3e\x59\xac\x66\xc9\xa8\x8a\x1d\xab\x9c\x61\x83\xd1\xbd\x7d\x95\x2a\x36\x5a\x09\x1d\x65\x4e\xbc\x6a\x17\xe1\x59\x58\xe1\x84\x5d\xa3\x91\x6c\x1a\xe9\xcd\xc2\xb1\xf5\xc9\x39\xe3\x26\x28\x46\x44\x58\x04\x6b\x95\x05\xe6\xe6\x9e\x77\x6f\x36\x86\x6e\x01\xf6\x28\x14\x0c\xb9\xd5\xca\x49\x3c\xe6\x35\xee\x32\x5d\xb1\x41\x30\x8c\x57\x84\x4b\x60\x82\x7c\xa5\xce\x89\x99\xbf\x78\x95\xa7\x45\x73\x0f\x70\x69\x1e\xd0\x1f\x0b\x23\x0f\x79\x71\xa5\x18\x6f\xa4\x92\xe9\xa2\xcb\x08\x28\xa4\x06\x46\x1b\x37\x7e\xb2\x5e\xe5\xee\xca\x2a\x63\xfe\x7e\x8b\x00\x5f\x39\x4e\xfa\x75\xc9\x94\xfd\x3b\x0d\xcd\x96\x86\xb2\x8f\x80\x95\x45\x3d\xb2\x1a\x35\x18\x25\xa0\x85\xf4\x3c\x37\xdf\x29\x3c\x7c\x06\x31\x09\xc3\x39\x7b\xfa\x21\xc2\x73\x53\x81\x6f\x65\x6e\xad\x37\x12\xda\x80\x3b\x11\x6f\x5e\x82\xf2\x43\x46\x26\x0e\x74\x1a\x4a\x28\x6e\xff\x17\xfd\x9c\x6c\x34\x6d\x61\x1c\xfd\xe1\x5c\x98\x8e\xda\x53\x9a\x74\x61\x54\x92\xc2\x6c\xe1\xbc\x22\x4b\x61\xc6\xb5\x1a\x3e\x83\xe3\x4b\x6b\xfc\x69\x37\xc0\x27\xba\xcd\xec\x0f\xe7\x81\x93\xb8\xf1\x09\x9a\x66\xcc\x2a\xdf\xb2\xf0\x1d\x65\x85\xdb\xdf\xc6\x06\x00\x00")
 
 func staticPrivacyHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +298,11 @@ func staticPrivacyHtml() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "static/privacy.html", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x86, 0x19, 0x8c, 0xc, 0x7e, 0x72, 0x71, 0x98, 0xc9, 0xc3, 0x68, 0xfa, 0x5a, 0x9c, 0xe, 0xf5, 0xb2, 0xa3, 0x1, 0xdf, 0xcd, 0xe3, 0x37, 0x28, 0xa8, 0xba, 0x29, 0x3, 0xf7, 0x1d, 0xf7, 0x6}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x3b, 0x19, 0xb1, 0x2d, 0x3d, 0x4c, 0x4d, 0x34, 0x43, 0x6f, 0xc9, 0x70, 0xb9, 0x5b, 0x9b, 0x53, 0xb7, 0xa9, 0xaf, 0x16, 0x6c, 0x44, 0x89, 0x42, 0x35, 0xf1, 0xed, 0xba, 0x78, 0x99, 0xeb}}
 	return a, nil
 }
 
-var _staticStyleCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xb1\x6e\x2a\x31\x10\x45\x7b\x7f\xc5\x15\xcd\x93\x10\x86\x05\x5e\x65\xaa\x74\xf9\x0d\x2f\x8c\x77\x47\x31\x9e\x95\x3d\x06\x56\x11\xff\x1e\x79\x43\x8a\x28\xed\x91\xee\xd1\xb9\xbd\x5c\x66\x7c\x1a\xe0\xea\x1f\xf6\xce\x17\x1d\x1d\xf6\x87\xae\x9b\x1e\xa7\x05\xe6\x81\x93\x43\x07\x5f\x55\x1a\x09\x92\xd4\x06\x7f\xe5\x38\x3b\xbc\x53\xbc\x91\xf2\xd9\x6f\xf0\x96\xd9\xc7\x0d\x8a\x4f\xc5\x16\xca\x1c\x4e\xe6\x69\x4c\x10\x51\xca\x2f\x7f\x53\x59\x95\xc9\xe1\xff\x2f\xbd\xed\x45\x55\xae\x0e\x87\x05\x3f\x8d\xd9\xad\xa1\x23\x81\xd3\x54\x15\xa3\x2f\x50\x41\x4f\x58\xdd\xb8\x70\x1f\x69\xb5\x01\x07\xcc\x52\x51\x0b\xe1\xc2\x65\x8a\x7e\x76\x48\x92\x08\x67\x99\x66\xdc\x25\xfd\x53\xdc\x25\x7f\x6c\xb1\xde\x99\x6d\x83\x56\x7d\x1e\x48\x97\x96\x49\x0a\x2b\x4b\x72\xf0\x7d\x91\x58\x95\x5a\xcd\x48\x3c\x8c\xea\xb0\xff\x8e\x8b\x14\xd4\xc1\x1e\xbb\xee\x27\x6b\x1b\x65\x90\xaa\x7f\xee\x1c\x5f\x77\x42\x14\xaf\x0e\xb9\x69\xda\xe0\x2b\x00\x00\xff\xff\x27\xcf\x8b\x82\x5c\x01\x00\x00")
+var _staticStyleCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x75\x52\xcb\x6e\xdc\x30\x0c\xbc\xfb\x2b\x88\x14\x45\x81\x60\xe5\x38\x2f\x2c\xea\x9c\x72\xeb\x6f\xc8\x36\x6d\x11\x91\x45\x41\xa2\x37\xeb\x14\xfd\xf7\x4a\xb2\x9b\x4d\x1b\xf4\x22\x58\xf4\xcc\x68\x86\x64\xc7\xc3\x0a\x3f\x2b\x80\x59\x9f\xd5\x2b\x0d\x62\x5a\xb8\xbd\x6b\x1a\x7f\x7e\x2a\xc5\x30\x91\x6b\xa1\x01\xbd\x08\xe7\xca\xc8\x4e\xd4\xa8\x67\xb2\x6b\x0b\x3f\xd0\x9e\x50\xa8\xd7\x07\x78\x0e\xa4\xed\x01\xa2\x76\x51\x45\x0c\x34\x3e\x55\xbf\xaa\x6a\x64\x16\x0c\xbb\x7e\x96\x52\xc2\xbe\x85\x87\xbf\xe4\x55\xc7\x22\x3c\xb7\x70\x57\xca\x89\x76\x73\x0d\x62\x10\xc8\xf9\x45\xc0\xe8\x08\xc2\xd0\x21\x5c\x9d\x28\x52\x67\xf1\xea\x00\x34\xc2\xca\x0b\x2c\x11\x61\xa0\xe8\xad\x4e\x6e\x1c\x3b\x84\x9e\xfd\x0a\xaf\xec\xbe\x49\x3a\xc3\x4b\x0d\xd7\x37\x55\x9d\x8b\x4a\xd2\x63\x28\xc5\x8b\xe7\x48\x42\x9c\x82\xe9\x2e\xb2\x5d\x04\xb3\x1b\x83\x34\x19\x49\xf1\x37\x73\x16\xc7\x74\x51\xf7\x4d\xf3\xc7\x56\x6d\x79\xe2\x45\x3e\xc5\xb9\xdf\xe3\x8c\x96\x75\xa2\x84\x2c\xb3\x11\xa6\xc0\x8b\x57\xbd\x16\x9c\x38\xac\x9f\x88\xef\x81\x77\xe0\x80\xb1\x0f\xe4\xb3\xb5\x8f\xd8\xcd\xc9\x05\xac\x43\x6f\xe8\x84\x83\x2a\xac\x82\xec\xd9\x72\x68\xe1\xcb\xf1\x78\xdc\x30\x86\x67\x54\x96\xdc\xcb\x01\x2e\xdf\xad\xe1\xd3\x3e\x8e\x9d\x40\xce\xa4\x61\x49\x76\x2f\x78\x96\xe4\xa0\xe7\xa0\xb7\xde\xe4\x7e\x6e\x62\x7d\x1a\x3a\xa6\xb9\x7b\x3d\x61\x61\x27\x31\x54\xef\xfd\xaa\x1f\x0b\xac\x4b\xab\x54\xe3\xdc\xe1\xf0\xef\x42\x5d\x94\xca\x6f\xf5\x9f\xb5\xb8\x7d\xdc\xfb\x98\x77\x2c\xd2\x1b\xb6\xf0\xbd\xf9\xba\x11\xa3\xd1\x61\x0b\x51\x68\xc5\xac\xb6\x34\xb9\x0f\xfd\xfe\x0d\x91\xa2\xe5\x47\xcc\x02\x00\x00")
 
 func staticStyleCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +318,47 @@ func staticStyleCss() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "static/style.css", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb8, 0x3a, 0x21, 0x51, 0xaa, 0x34, 0x8a, 0x5d, 0xac, 0x55, 0xef, 0xf6, 0x65, 0x9e, 0x2b, 0xc6, 0x64, 0xb0, 0x7a, 0xbb, 0xb8, 0x8c, 0x91, 0xe3, 0x32, 0x82, 0xdb, 0x81, 0x7, 0x1c, 0x19, 0xff}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0xdc, 0xbc, 0x13, 0x5, 0x2f, 0x9c, 0x95, 0xbe, 0xa4, 0x68, 0xd, 0xca, 0xb5, 0xed, 0xa1, 0x8e, 0x1e, 0x9a, 0xbf, 0x11, 0xb6, 0x1f, 0xdc, 0xce, 0x14, 0xc5, 0x30, 0xb5, 0x23, 0x3b, 0xdc}}
+	return a, nil
+}
+
+var _localesEsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x59\x5d\x6f\x1c\xb7\x15\x7d\xd7\xaf\x20\x14\x28\x96\x01\x79\xb7\x6e\x12\xa0\xb0\x55\x05\x8a\xe3\x38\x6e\x2d\xc7\x88\x1c\xb7\xa9\x6d\x14\xdc\x19\xee\x2e\x2d\x0e\x39\x26\x67\x56\x5e\xff\x1b\x3f\xe6\xc1\x0f\x45\xde\xf2\x52\xa0\xfb\xc7\x7a\xce\x25\x67\x77\xf5\x61\x23\x48\x50\x14\x48\x60\x2d\x87\xbc\x73\x3f\xcf\x3d\x97\xf3\x89\x3a\x6d\xb5\xb7\x69\xae\xba\xa8\x7d\x72\xba\xb3\xc1\xa7\x91\xba\xaf\xab\xb9\x3a\x33\x4b\x65\x93\xea\xe6\x46\xdd\xf7\x33\x27\xbb\xcc\x9b\x4e\xf5\xc9\xd4\xca\x7a\xfc\x68\x5a\x9c\x30\x69\x6c\x7d\x6d\xde\x8c\xe6\x5d\xe3\x76\x3e\x51\x21\xca\x91\x07\x41\x55\xa1\x36\x77\x55\x32\x46\xd9\xdb\x7f\xf2\xa3\x59\x18\xed\xec\xfe\xd3\xeb\xc6\xec\xde\x51\xbb\xf7\x53\xab\x57\xff\x0a\x6e\x77\x67\xf7\x51\x98\xa9\x30\x9d\x72\xf5\x9e\x89\x51\x47\x9c\x49\x76\xf5\xb3\xc7\xb3\x53\xe3\x6b\xeb\x67\xaa\x31\x29\xe9\x99\x49\x6a\x1a\x43\xa3\x0e\x27\x47\x7b\xe9\x70\x3c\x39\x1a\xa9\xa7\x78\xd7\xfa\xe1\xb9\x75\x4e\xe9\xb6\x35\x90\xe1\xec\x99\x51\xad\x89\x29\x78\xed\xec\x5b\xe8\x6c\x1a\x6d\x5d\x91\xb0\x0c\x7d\x54\x0f\x4e\xb0\xa0\x74\x55\x85\xde\x77\x23\xd1\xca\x2f\xac\xf6\x75\x80\x48\x9f\xf4\x2b\x88\xac\x4d\xaa\xcd\xf6\x0b\x1f\x85\xb4\x79\xaa\x5b\x1d\x4d\x65\xe2\xea\x9d\x87\xb9\x0d\x6d\x8e\xd1\x60\xc7\xe6\xc5\xba\xc6\x4f\x43\xb9\xfc\x23\x8b\xeb\x7a\x55\xf5\xc6\x77\x1a\xbf\xd5\x03\xaa\x35\x82\xad\x78\xc9\x69\xa7\x63\x97\x95\x73\xa6\xeb\x4c\x54\x93\x25\xcf\x54\xd1\x4e\xe8\x86\xf3\xb9\x89\x86\xcf\x61\xde\xc2\x1c\x50\x23\x3a\x5c\x0e\x54\xc1\x7b\x53\x31\x82\xaa\x0b\x8c\x81\x8d\xaa\xb6\xa9\x8b\xb6\xea\x46\xea\x61\xa7\x1a\x7d\x06\x95\x65\x6f\xf1\x98\x6a\x02\xc4\xb5\xe1\xdc\xc4\x69\xef\xc4\x03\x50\xe2\x7e\xd3\x5a\xf3\x56\x8b\x96\x50\x47\xaf\x15\x30\xf4\x4c\x8d\xc0\x40\xeb\x05\xde\x9f\x8a\x02\xdc\x19\x8d\xd3\x15\x83\x46\x3d\x54\xea\xcb\xab\xbb\x30\x52\xc7\x69\xf5\x9e\x5b\x8a\xd7\x90\x38\xbe\x86\xc7\x54\xb3\x7a\x87\x68\xf4\x26\xbe\xd5\x34\xff\xb4\x9f\xbc\x82\xfe\x54\xe2\x38\x21\x20\x01\x6b\x27\x7a\x19\xe2\xf8\x1e\xe2\x53\x59\xd7\x98\x66\x62\xe2\xf8\xb4\x87\x73\x17\x36\xc1\xee\x43\x7b\xb4\xcf\x84\x42\x20\xfa\x2e\xdc\xba\x10\x6d\x38\xae\x0b\xc1\x29\x6a\x83\x17\xde\x3c\x1c\xdb\x23\x91\xed\x2a\xed\x6a\x03\xa1\xbe\x32\xaf\xb4\xbb\x2c\xcf\x69\x35\x67\x16\x36\x56\x02\xd4\x06\x6f\x94\x71\xca\x87\x66\x02\x5f\xc1\xf2\x4a\xd7\x7a\x88\xaf\xd2\x2e\xc7\x36\x66\xf9\x3b\xbb\x0f\x25\x32\xac\x8f\x43\xeb\x93\x41\x34\x87\x18\x50\xac\x39\x52\x48\x2f\xf5\x50\xed\x47\x48\x1f\xcf\x35\xb6\x9e\xd9\x3a\x8d\xa3\x85\xe4\x66\xa9\x26\xc8\xda\x71\x67\x58\x7f\xa9\xeb\x6b\x6c\x4a\x37\x11\xbc\x1b\x0d\xdd\x8a\x44\xf3\xb0\x4c\x4f\x42\xdf\x8d\x46\xa3\x22\xea\x3c\xf4\xae\xce\xe9\xce\xc4\x40\xec\xf7\x53\xdf\xb6\x21\x76\xe3\x80\x7f\x92\xb9\xa9\xb0\x99\xa6\x3f\xb3\x8b\x00\x75\xd5\x61\x8e\xa7\xe4\xe1\x10\x26\xa5\x5f\xf7\xab\xf7\x47\x6a\xa9\xf6\xb5\x7b\xdd\x5b\x17\xa0\x86\x9f\x05\x35\xb7\xaf\x42\x1a\x2f\xc2\x92\x27\x27\xb6\xb2\x15\x32\x53\x8f\x6b\x2c\x54\x4e\xa3\x52\xa1\xdf\x09\x92\x08\x69\x5f\xf5\xad\xa6\x5e\x4b\x84\x5a\xcd\xfa\x84\x64\x5e\xbd\xd7\xea\x75\x6f\x00\x1a\x1d\x34\xdf\xd7\x6d\x58\x9a\x71\x32\x2a\xc0\xaf\x33\xb8\x2f\xeb\xb6\xb3\xfb\x62\xf7\x6b\x16\xed\xa7\xae\xbb\xfb\xf7\x4f\x67\xdd\xdd\x83\x17\xbb\xb9\x9a\xa1\x25\x63\xdb\x00\x9a\x10\x37\x07\x48\x12\xaf\x42\x18\xf3\x1c\xc5\x3f\xb5\x31\x75\x30\x1f\x61\x3a\xb7\xdd\x5c\xd6\x72\x74\x6e\x24\xc5\xe4\x18\x1d\x4e\xa2\x1a\x1f\x9d\x66\x9f\x24\x75\x88\x00\x47\x33\xfd\xf3\x8b\xdd\x79\xd7\xb5\x77\xc6\x63\x14\x6e\x13\x7c\xa3\xe3\xd9\x28\xc4\xd9\x78\x6e\x5c\x3b\x7e\xb1\x7b\x74\x82\x85\x3a\x9c\xfb\xc3\xb1\x3e\x52\x69\x89\x64\x78\x53\x30\x42\x21\x47\xda\x68\x1b\x13\xb5\x72\xab\xf7\xde\x68\xa4\x98\x02\x9c\xe9\xda\x32\xb1\x45\xe1\xd5\x3b\x6a\x8c\x9c\xef\x8c\x7a\x01\xb8\xeb\x6c\x03\x04\x18\xeb\x8b\x36\xb2\x58\x2e\x64\x97\x5b\xe7\x56\xd1\xfb\xb8\x6e\x6c\x27\xeb\xc9\x52\x07\xfb\xdb\x2c\x18\x15\x30\x5d\xa3\x5c\xc4\xc2\x3d\x67\xab\x33\x66\x4c\x15\xda\x25\x9f\x7c\xab\xdf\x22\xaa\xb6\x52\xc0\x35\xcd\xd5\xbc\xef\x81\xe9\x14\xde\x3f\x07\xd8\xe9\x89\x33\xf4\xf6\x99\x9a\x0a\xd2\x43\x1d\xc1\x55\x9e\xfe\x6e\x82\x94\x01\x66\xf5\xb0\xc9\x03\x0e\xcc\x20\xa6\xc1\xbf\x1d\xe0\xc8\x20\x0b\x0a\x44\x42\xea\x71\x8f\x58\x79\x7a\xa9\x2b\xb1\x7b\x10\xc2\xcc\x49\x7b\x78\xe8\x91\x69\x9b\x4e\x20\x7e\x2a\x4f\x61\x87\x9d\x79\x56\x98\x9c\x39\xb1\x55\x0c\x29\x4c\xbb\x0f\x1d\xdb\x6c\xd8\xd9\x7d\x4a\x7d\x05\x16\x32\x18\xda\x4e\x19\x9d\x96\xd9\x05\xf0\x6e\x55\xc0\xb7\xed\x27\xf4\x02\xba\x12\xe5\xb9\x74\xa0\x26\x7d\xa7\xce\x8d\xf2\x06\x99\x97\xb7\x98\xd8\xd8\x94\x0a\xdc\x12\x62\x86\x06\x83\x15\xd9\x31\x31\x73\xed\xa6\x39\x67\x50\x0b\x17\x60\x65\x0a\xb0\x74\x16\x7f\x20\xf8\xe5\xcd\xa2\x02\x4a\x32\x01\x4b\x51\x4d\x89\xfb\x90\x3c\xe8\x25\xab\x5f\xa8\x4d\x80\x16\x78\x69\x80\x0e\x15\xcc\xeb\x74\x83\x7e\x82\x0a\xce\x8a\x84\xec\xeb\x0c\x46\xeb\x36\x64\x28\xb0\x64\x17\x33\xe0\x6f\xa8\x3c\x8f\x22\x12\x33\x58\x29\x2f\x76\x37\x9a\x23\x1d\x37\x46\xdd\xa5\xb5\xc0\xc3\x4a\x7b\x1f\x3a\x82\x1b\xd4\xd2\xc5\x76\xeb\x27\xe1\x0d\x1b\x0f\x9b\xfb\xd0\x7c\x68\x03\xb8\x03\x5e\x81\x2e\x81\x33\x00\x30\x76\x17\xbe\x85\x4f\x09\x65\x70\xe8\xe0\x24\x42\x95\xbc\x1a\x76\x8b\x04\xe3\x00\xfe\x2c\xeb\xc1\xe7\xe2\xb8\xd3\xe0\x2e\x1a\x0c\x7f\x0d\x06\xd7\x54\xff\x82\xc1\x2f\x76\xef\x52\x67\x0f\x67\x80\x7b\x60\xbb\xe8\xed\x0c\x72\x12\x6e\x98\x00\x2f\x81\xf8\x3c\x07\x65\x22\x61\xdc\x5b\xb5\x90\x87\x69\x1d\x05\x98\xf0\x38\x00\xbd\x74\xac\x87\x17\x66\xf5\x6d\x2d\xaf\x74\x21\xad\xdd\x4b\x64\x83\x02\x00\x39\x74\xa0\x6b\x42\x27\x2e\x9f\x23\x3b\xe6\x82\xd1\xf0\x68\x31\xf9\x4b\xda\xf6\x9f\x7f\x1f\x43\x82\x5d\xfd\xc4\x0e\x05\xb9\x39\x78\x78\xe7\x97\x2c\xb9\x18\xfa\x56\xdc\x74\xa3\xa0\x20\x0b\xef\x52\xa6\x75\xe1\x0e\x05\x3d\x88\x3d\x70\x1e\x1a\xb8\xa2\x13\xac\x8f\x6c\xae\x17\xb3\xe1\x0e\xc4\xee\x21\x06\x2d\xfa\xb0\x54\x6f\xa6\x18\x92\xb0\x7b\x89\x82\xf0\x14\x55\xdb\x22\x2d\xa5\xf1\x53\x64\xa9\xdc\x1a\x6e\xc0\x1e\x90\xb5\x72\x06\x9a\x60\x77\x85\x37\x9a\xcd\xf9\xfb\x08\x8e\xd3\x6f\x87\x5c\xcc\xac\x21\x42\x0a\xb6\x76\x8c\x9a\x97\x84\x17\x41\x58\x42\x82\x80\xab\xd5\x64\x60\x78\x29\xf9\xcd\x75\x1a\x21\x66\xab\x9f\xe9\xf9\xac\x5a\x1c\x74\x1b\x04\x11\xd5\xe8\x7d\x80\xcb\x9a\xd7\x94\xa4\x4a\xc6\x4d\x37\x80\x07\x94\x01\x32\x0d\x2c\xa4\x66\xcf\xea\xcd\x44\x43\xc4\xfe\x5e\x8d\xc8\x55\xb6\x65\x71\xde\xe4\x09\xae\x80\xf6\x40\x29\x8d\x57\xda\x70\xf3\xf2\xae\x74\xed\xb6\x24\xfb\x6e\x6f\xb6\x1d\x28\xec\xa8\xaa\x1b\x75\xde\x7e\xfb\xc2\x6e\x79\x68\x7c\xc6\x59\x9e\x3c\x28\xc1\xc9\xb6\x1f\x20\xe6\xd1\xac\xed\x24\xe8\x72\x39\x43\x2b\x16\x90\x55\xe7\x4a\xd7\x75\x94\xdd\xcc\x63\xb4\x1f\x53\x55\xa8\x5e\xc3\x03\xc7\xb1\x9a\x83\x8b\x08\xe4\xe7\xbf\x49\x41\xb3\xeb\xf7\x25\x72\x35\x24\xdf\x2c\x6e\xde\xaf\x08\x4f\xc8\x71\x79\x1f\xb5\xb9\x87\x82\xef\x32\x93\xba\xd8\x71\x12\x5a\xce\xa4\x8f\x67\x66\x94\xc8\x9c\x50\x9c\x68\x36\x7f\x35\x0b\x40\xf2\x57\x5c\x96\x7e\x93\x99\x79\xef\x3a\x7b\x4b\xb2\x15\xea\xb1\x75\x4d\x8e\x04\x4e\x40\x1b\xa7\x11\xe0\x41\x5c\x3e\x47\x5b\x19\xa9\xaf\x96\xe0\x94\xe6\x9c\x59\x20\x5d\xa5\x45\x1c\x0f\x04\x26\xf4\x8c\x3b\x33\xa7\xdd\xd2\x64\xef\xf9\xed\x97\x69\xcc\xb4\x4a\xb7\xc2\xf4\x56\x51\x05\x9a\xc8\x12\xa1\x06\x93\x4a\x56\xe5\x7b\x22\x17\x41\xe6\xf2\x69\x74\xf1\x85\xae\x96\x38\x54\xfe\x42\xe9\x00\x6a\x97\xb9\x63\x72\x20\x21\xe8\x11\x4e\xe2\x6f\xf2\x01\x0a\xe2\xa2\x0b\xc4\x7e\x31\x1f\xc0\x3e\x18\x8f\x7e\x4a\x7c\x56\xc7\x4e\xd0\xc8\xb0\x59\xb4\xab\x77\x33\xe4\xc9\x41\xc9\xfa\x24\xb5\xfd\x6b\x8c\x5f\xfd\xc4\x3a\x93\x51\x03\x0e\x08\x59\x8f\x47\x70\xa0\xef\x21\x18\x65\xf9\x11\x1f\x04\xb0\x19\xf6\xe3\x5c\x1c\x5c\xb6\xb5\xae\x07\xfa\x70\x0f\x7d\x1c\x46\xd4\x10\xcb\x28\xed\x65\x84\xce\xab\x74\x52\x25\x55\xcb\x9d\xcf\x10\x48\x89\x56\x82\xd3\x2b\x23\x93\xa0\xb0\x55\xc0\x23\x38\x98\x42\xa6\x85\x9c\xb5\xcf\x68\x2f\x10\x76\xf5\x73\x6d\x41\x36\xa7\xbd\xf0\x25\xf2\x67\x00\x14\x08\x8d\x80\x35\x77\x4b\x46\xdf\x43\xa1\x0c\xfd\x57\xd8\x88\xfa\xe1\xfb\x47\x43\x66\x80\xb7\xb4\x93\x00\xd8\x96\xbe\x61\xa4\xaa\x00\x1c\xc6\x7d\x88\x8c\x08\xf3\xa2\x3e\x98\xe2\x5a\xb4\x9f\xfc\x06\x00\xb5\xbf\xd1\x09\x23\x92\x79\xf7\x00\x46\xc4\xb8\x1c\xa9\x6f\x39\x7a\x81\x30\xd8\x04\xf9\xf8\xef\x31\xb1\x18\x4c\x41\x2c\x27\x51\xa2\x38\x9e\xe0\xc8\x43\x32\xcd\x40\xae\xde\x61\x27\x50\x53\x7b\x74\x94\x59\xa6\x36\xb5\x05\x9d\xc5\xe2\x77\xa8\x75\xd2\x18\xb4\x21\xb8\x0b\x99\x0f\xae\x26\xb5\x3a\x89\x59\xbd\x1e\x70\xb9\x90\x5e\xa2\x19\xbd\x05\x0f\xfd\x88\x86\x00\x3d\x12\x28\x90\x8c\xe1\x23\x85\x15\x40\xa2\x2f\x58\x2c\xb5\x93\x65\x8d\x32\xa3\x03\xcb\x11\x56\x54\x87\x81\x16\x8d\xd4\x93\xde\x00\x88\x54\x95\xc7\x6d\x49\xb8\xf2\x22\x86\xef\x31\x9a\x37\x38\xec\x3c\x44\x4e\x52\xc5\x56\x92\xda\x28\xe3\xec\x35\x5a\x28\x4d\xd0\xca\x2c\xa9\x9b\xeb\x6e\x18\xaa\xe1\x2c\x3a\x13\xc4\x1d\x33\xa6\xf4\xfa\x7e\xd0\x11\x0d\x1d\x29\xff\x70\x2a\x25\x2e\x83\x90\x06\x32\x80\x23\xad\xcf\x8a\x20\x0b\xa9\x7c\x0d\xca\xb7\x93\x14\xea\xe2\x12\x88\xa0\x07\xfa\x27\x9b\x00\x78\x1f\x34\x36\xcf\xe5\x7b\x03\x79\x32\x49\x0f\xb3\x37\x68\x42\x07\x8c\x36\x17\x19\x54\x9f\x74\x21\xaa\xd0\xd0\x22\x96\xa7\x36\x6f\x03\xa4\xb0\x7c\xca\x61\xb6\x59\xce\xb5\x68\xdb\x9c\x93\x50\xa8\x95\xa5\x37\xd7\xaf\x05\x9c\xf5\xc6\xd1\x2c\xa8\x44\xf6\xa3\xa3\x0b\x85\xef\xbb\x3c\xf0\x16\xb3\xae\xbd\xf8\x78\xe2\x40\x4c\x59\x85\x61\xc1\xd1\x10\x72\x37\xe3\xf1\x13\xa0\xd1\x54\x2f\x42\x3c\x50\xc3\x24\xd7\x33\x04\x65\x68\xbe\x72\x74\xe8\x8a\x93\x50\x2f\x3f\x70\x7e\x48\x5d\x69\xf4\xa5\x45\x42\xd4\x0f\xfe\xcc\x63\x80\x50\x33\xb2\x11\xb5\xf7\x7a\xcd\x36\xe4\x4e\x20\xf8\x50\x91\x15\x61\x5d\xda\x70\xc7\x7e\x56\x5e\x26\x37\x0e\xdb\xf7\x31\xb6\xb0\x62\xd9\x37\x0d\x18\xc1\x59\xc3\xa8\x3e\xb7\x2c\x05\x4b\xb6\x82\x82\x15\x19\xa2\x00\xea\x68\x7d\xbf\x52\xa8\x2c\x7a\x7f\x6b\xba\xc2\xe1\xca\x9d\x0a\xe7\xd2\x68\x16\xe6\xaa\x12\x49\xba\xd0\xf2\x37\xe8\xa1\x23\xc1\x6c\xad\x49\xda\x56\xc5\xff\x26\x5d\x72\x71\x54\x42\x04\x85\x1d\x17\xf2\x0b\xc4\x54\x25\x62\x9b\xd4\xe6\xbd\x5a\xfc\x90\x5b\x86\x31\x60\x83\x3f\x85\xe7\x69\x11\xf6\xd0\xa3\x07\x20\xdd\x9c\xd0\x55\xe2\x46\xc8\x57\x2d\xc8\xc0\xfa\x3a\x27\xfd\x2e\xcd\x2e\x3b\xea\xf7\x2a\xf7\x34\xa8\xf3\x79\x68\x08\xb4\x8d\x5e\x0e\xb7\x1e\x02\x8b\x24\xcb\xc2\x96\xc0\x66\x53\x1b\x7c\x4d\x3c\xfc\xd6\x0a\xf3\x93\xea\x0f\x0e\x65\xb8\x26\x75\xd2\xdd\x40\xb1\x9e\xff\xf1\x25\xc0\xe9\xf9\x67\x2f\xc1\x12\x61\xf7\xf3\xcf\x5f\x66\x66\xc9\xc7\x65\x1d\xaa\xe4\x6d\xa0\xd0\x44\x32\xd9\xb3\xb3\xfb\xd9\x9d\x3f\x7c\xde\x36\xea\xe4\xf4\x29\x0f\xdc\xfe\x02\x3f\xe5\xc7\xce\xee\x09\xdf\x2e\xf9\xe2\xfa\x4c\xb6\x9e\xa2\xbb\x96\xa5\x86\x54\x33\xc9\xb4\x55\xfb\xcd\x2a\x88\x7e\xac\x42\x6e\x33\x4f\xe7\x20\xa7\xe5\xc1\x2b\x38\x41\x16\xbf\x89\xb6\x2c\x81\x08\xc5\x2c\xf6\x54\x77\x7d\x2c\xab\x69\xf5\x6e\x92\xe1\xf7\xb4\x1f\x5e\x5f\x07\x34\xfb\x19\xd7\xfe\xa2\x3d\x66\x96\x65\x66\x88\x08\x01\x05\x9a\x49\x1c\xd6\xa6\xf8\x3b\xaf\x9e\x68\x70\xc1\xa2\xe7\x5b\x99\xc3\xd1\xea\x65\x7e\x07\xdd\xb4\x2e\xdf\xba\xe5\xe7\x4b\x11\x0c\x0b\xb3\x9e\xde\xe6\xdf\xae\xe8\xed\x6c\x1e\xe3\x79\xd5\x23\xe7\x67\x21\x09\x02\x9d\x82\xb2\xc8\x65\x9d\xa8\x4d\x6e\x6f\x38\x81\xb2\xe7\x61\xc2\x2a\xeb\xa1\xea\xfa\xbc\xf8\x38\x2c\xd6\xbb\x3d\x40\x6b\xd8\xfc\x35\xea\x6d\x58\xaf\x81\xea\xc3\xba\x30\x7e\x5e\xe3\x6e\xb8\x7d\xbe\x58\xcd\x9d\x89\xdd\xf0\x46\x49\x63\xb9\xec\xcd\x74\x1a\x3d\xac\xcd\x6d\xcf\x6c\x1d\xc9\xdc\x1a\x43\x2b\x26\x4e\x34\xb9\x24\xd7\x21\x0d\x1a\xe1\xac\x4c\xb6\x24\x6e\xe4\x00\x9c\xde\xd8\x66\x41\xb8\x25\x4d\x26\x84\xcc\xcc\x3c\x86\xa9\x65\x3d\xd3\xb2\x4f\x2d\x4b\x5b\x9e\x5a\x2f\xa3\x76\x43\xe4\xc9\x77\xbd\x79\x46\xa6\x8e\xff\x8f\x91\xfa\xa9\x6e\x26\x32\x74\x62\xf4\xb2\x4d\xbe\xab\xde\xb4\xbf\x19\xe7\x93\xe0\xb5\x4c\xcc\x79\x70\x3c\xc8\x4f\x38\x52\xc7\xe1\x96\x4b\xb8\x89\xe9\x2c\xda\x20\x10\x30\x97\x4d\x99\xcb\xd6\x43\x31\x2f\x9b\xe5\x17\x1f\x1a\x2f\x8d\x33\x8a\xfb\x07\x3f\x20\x24\x18\xce\xcb\x3d\xc6\x87\xc6\xf3\xfc\xf8\x7f\x37\xa0\xff\x28\x6c\xb2\x5c\x29\xcd\xa9\xea\x9b\x16\x73\x54\xcd\xce\x9f\x17\x33\xf2\x95\xa0\xae\x6f\xe6\x87\xeb\xc9\x73\x6d\x65\x76\x9d\xe6\x8b\xf8\xec\xe3\x7e\xcd\x03\xe6\x1c\xa6\xeb\x1e\xff\x83\x4b\x3c\xdb\x50\x82\x8b\x17\x55\xcb\xcd\x6d\x3b\xf9\xaf\x5c\x9d\xa2\xe7\x25\xc4\x86\xdf\x23\x3e\xa6\xe7\xc1\x15\xcd\xf2\x1d\x17\x1c\x51\xb4\xea\x65\x5c\x4f\xe0\x58\x23\x7e\x97\x99\xe8\xea\xec\x20\x13\xdc\xed\x53\x29\x34\x26\x7f\x5f\x48\x7a\x8a\x26\xc3\xcb\xb9\xf5\xfb\xc4\x05\x1f\x31\x6d\xdb\x00\xf9\x6a\xd2\xe8\x24\x2c\xcc\xe9\x38\xd3\x39\x81\x72\x2a\xe4\xc8\x31\xaa\x1b\x77\x74\xbc\xad\x38\xc8\x34\x7a\x4b\x0e\x19\xb0\x72\xfd\x4c\xfc\x34\xeb\x23\xdf\xe2\x7a\x83\x39\x61\xf1\x01\x3f\xd2\x4f\xdf\xf4\x88\xcb\xf0\x99\xe9\x71\xbe\x58\x25\xeb\x87\x99\x82\x4d\xc8\x41\x5e\x67\x62\x6e\x46\xff\xe3\x9e\xaf\xf3\xd4\x9c\x79\xd7\x3f\x1e\x3e\x91\x71\x45\x48\x59\x99\x4a\x5a\xc0\x9a\x26\x30\x3e\x99\xf3\x6b\x80\xef\x07\x50\x7a\xbc\xfa\xa5\x61\xb7\xe3\x27\x1d\xe3\x56\x3f\x4d\xc1\x88\xae\x12\x30\x71\xf2\x74\x5b\xab\xfb\x9b\xfb\x77\x7f\x45\xc1\xeb\x4e\xa7\x2b\x4a\xdf\xdf\xbe\xc2\xdf\xd2\xff\xba\xd3\xdb\x36\x6d\x9d\xab\xae\x98\x77\xcd\xd9\xf6\x92\xc9\xdb\xaa\xff\x2a\xeb\xb5\xfa\x02\x1a\xce\xd0\xcf\xaf\x53\x03\x01\xbe\xa8\x06\xa5\xe1\xc0\xea\x3d\x4e\xc8\x65\xc5\x15\x71\xdb\x1a\xe5\x19\x80\xd7\xd2\xd7\x49\xbe\x4e\x41\x61\xe0\xc3\x2b\xf1\x60\xf5\x0e\x87\x87\xea\xaa\x01\x66\x44\x52\x4e\x96\x43\x05\xe5\x9c\x47\x4a\x6b\xe8\x83\x22\xf1\x97\x92\x5b\x94\x3c\xae\xeb\x3c\xde\x10\x9a\x09\xc1\x61\xba\x5d\x5b\xd2\x3f\xe4\xf7\x1a\x8a\x15\x89\xb5\x5c\xef\x45\x1a\x05\xad\x80\xbb\x1d\x21\x92\x78\xcf\x3b\xbf\x68\xf2\x07\x2f\xca\x4c\x66\xad\x9b\x7c\xf6\xb0\x2c\x31\x4e\xfc\xa3\x42\xc5\x04\x96\x51\x30\x70\x20\x1a\x4e\x76\xe3\x50\x48\x07\x9b\xf2\xbb\xe6\x76\x19\xed\x19\x5d\x8a\x0f\xe5\x93\x1e\xab\xee\xc2\xd7\xbb\xef\x0d\xc0\xb4\x86\x47\x04\x6b\x13\x9d\x90\x5d\xc1\xf9\x99\x63\x12\xb6\x22\x74\xc0\xc0\x85\xc0\xd4\xfa\xba\x3d\x33\xca\x3c\x4b\xfb\xa9\x8d\x4d\x9e\xd5\xf6\x12\x40\x93\x8e\x4d\xeb\x3e\x55\x06\xbe\xc1\x4b\xa5\x7f\x73\x52\x44\xd6\x10\xb9\x06\xd0\x33\x31\x1b\xbc\x79\x07\xaf\x7a\xf3\x04\x2e\xaf\x00\x12\xd0\x92\x3d\x69\x6a\xfc\x18\x51\x19\xa5\x37\x9f\x5b\x01\x95\x9c\xd9\xb8\xc5\xaf\xd9\x00\x07\x3e\x57\x20\xea\x32\x2c\xcb\x47\xb1\x35\xf8\x5e\x03\xaf\xe5\x42\x76\x4d\x94\x65\x92\xe0\x58\x7f\x69\x58\x2d\xc8\xf9\x71\x80\xcc\xbc\x04\xc3\xa1\x3a\x46\x86\x62\x27\xd4\xba\x76\x8c\x1c\xbe\x64\xf0\x8e\x0c\x28\x5c\x6f\x5a\xc1\x90\x67\x43\x8f\xd2\xa9\x50\x21\xa4\xd5\xbd\xb9\xa9\xce\x06\xcd\x98\x03\x49\xe5\xbb\xd3\x35\xb6\xdf\xdf\xba\x79\x82\xdc\x09\xbf\xde\x0d\x08\xbf\xe5\xbb\x35\x42\x67\x96\x9f\x07\x14\x66\x0a\x1a\x6d\xba\xa4\x74\xdb\x3b\x0e\xd4\x99\xa5\xc1\x93\xff\x05\x9a\x3e\xaa\x21\x73\x20\x00\x00")
+
+func localesEsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_localesEsYml,
+		"locales/es.yml",
+	)
+}
+
+func localesEsYml() (*asset, error) {
+	bytes, err := localesEsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "locales/es.yml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc0, 0x1f, 0x4, 0x8a, 0x6c, 0x66, 0x4c, 0x15, 0x5a, 0xf2, 0xa4, 0x40, 0x8e, 0x1a, 0xf1, 0x13, 0x5e, 0x86, 0x4, 0x9c, 0x11, 0xb, 0xe1, 0xe5, 0x44, 0xcd, 0xbc, 0x16, 0x1e, 0xed, 0xae, 0x20}}
+	return a, nil
+}
+
+var _localesZhYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x59\x6b\x73\x13\xd7\x19\xfe\xce\xaf\x38\xe3\x0c\x01\x66\x1c\x29\xe4\xd2\xa6\x81\xd2\x49\x48\x42\x48\x43\x92\x09\x49\xdb\x14\x98\xce\x6a\x75\x64\x6d\xbd\xda\x55\x77\x57\x18\xf5\x93\x0d\xf8\x8a\xaf\xc5\x36\x36\x96\x03\x06\x9b\x8b\xb1\x25\x13\x2e\x36\xbe\xe9\xbf\xa4\x3a\xab\xd5\x27\xfe\x42\x9f\xf7\x9c\x5d\x49\x16\x4e\xda\xe9\x87\xa6\x33\x30\xb0\x67\xcf\x79\xf7\xbd\x3e\xef\xf3\x1e\xbd\xc6\xce\x1a\x99\xac\x69\xa4\x0c\x9e\x64\x27\xd3\x86\xc5\x5d\xce\x3c\x47\xb3\x5c\x53\xf3\x0c\xdb\x72\x63\xec\x63\x4d\x4f\xb3\x4e\x9e\x67\x86\xcb\xbc\x34\x67\x1f\x5b\x1d\xa6\xe1\xa6\x99\xc7\x2f\x79\x2c\xe7\xe2\x9c\x61\x1d\x78\x0d\x8f\x90\xa3\x79\xdc\x8d\x1b\x56\x92\x5f\x8a\xa5\xbd\x8c\xc9\x6c\x47\x1e\x39\x65\x33\xdd\x4e\xf2\x63\xcc\xe5\x9c\x19\x47\xdf\xb3\x62\x1d\x76\xec\x40\xdb\x5f\x2c\x2d\xc3\xdb\xde\x67\x6d\x95\x8d\x55\x7f\xba\xbf\xed\x40\xdb\xe7\x76\x07\xb3\x53\x29\x5a\xab\x75\x77\x8b\xfe\xcd\xea\xec\x96\xd8\x99\xc2\x9b\xb3\xdc\x4a\x1a\x56\x07\xcb\x70\xd7\xd5\x3a\xb8\xcb\x52\x8e\x9d\x61\xc7\x13\x27\x0e\xba\xc7\xe3\x89\x13\x31\xf6\x0d\xbe\x53\x7f\xd9\x65\x98\x26\xd3\xb2\x59\xae\x39\xcc\x34\x3a\x39\xcb\x72\xc7\xb5\x2d\xcd\x34\xfe\x0e\x7d\x79\x46\x33\xcc\x50\x42\xde\xce\x39\xec\xd4\x19\x2c\x30\x4d\xd7\xed\x9c\xe5\xc5\xe8\xeb\x62\xad\xaf\xb2\x35\xda\x90\xcf\xc4\xd8\x44\xad\xbb\xa7\x76\xb9\x58\xd9\x7a\xfe\xcf\xee\xcb\x41\x79\xb6\xb2\x39\xa7\x1e\x2b\xdb\x37\x2b\x5b\x4b\xfe\xe5\x07\xec\x14\x09\x66\xc1\xd3\x7b\xfe\xc0\x3a\x0e\x90\xfe\x37\xaf\x56\x36\x96\x2b\x9b\x9b\x6a\x2b\x1e\xc5\xce\x1d\xb1\x3d\x26\x26\x06\xaa\xa3\x6b\x10\x04\xd3\xf0\x91\xb3\x9e\xe6\x78\x4a\x17\x93\x7b\x1e\x77\x58\x22\xcf\x92\xdc\xd5\x1d\x23\x41\x56\x77\xa5\xb9\xc3\xe9\x3d\xac\xb9\xc8\xdb\xa5\x46\xf0\xad\x3c\xa0\xdb\x96\xc5\x75\x0a\x16\xf3\x6c\x72\xb7\xe1\xb0\xa4\xe1\x7a\x8e\xa1\x7b\x31\x76\xda\x63\x19\xad\x13\x2e\x91\x7b\x43\x07\xb1\x8c\x0d\x71\x59\xbb\x8b\x3b\xa9\x9c\x29\x0d\x86\x12\x41\x69\x5d\x14\x1e\x54\xca\x0b\xa4\xe5\x76\xb7\x58\x7c\x1a\x94\x9e\xfa\x33\xa3\xb0\xac\xb2\x33\x86\x57\xe2\xfa\x72\xad\x7f\xf8\xe5\xf6\x30\x7d\xdf\x1f\x98\xa6\x17\x1b\xa3\xa2\xf7\x79\xad\x7b\x50\x0c\x93\xad\x41\xcf\x64\xf5\xc9\x56\xe8\x9f\xed\x9b\x41\xf1\x21\xf6\x90\x0f\xca\x0b\xfe\xdc\x53\xbf\x30\x28\x06\xfa\x6a\xfd\x63\xca\xee\xb3\xb9\xc4\x5f\xa1\xb8\x4a\x80\xad\xda\x9d\x19\xac\x9d\xd1\xf2\xb6\x13\x3f\x89\x38\xe8\x86\x99\xe1\x99\x04\x77\xe2\x67\x73\x88\xde\x45\xc3\x85\xc1\xc7\x8d\x13\x87\x29\x69\x5c\xa6\xe5\x3c\xfb\x8d\x3d\x51\x85\xc7\x3c\xdb\x46\xce\x59\x48\x33\x2b\x79\xe4\x78\xdc\x38\x21\x43\xb9\x71\xb9\x36\x55\x8e\xe3\x9f\xa0\x58\x14\x13\x33\xf1\xea\xdc\x44\x65\xf3\x1a\xc9\x7a\xb9\x3d\xa0\xe2\xea\xdf\xa0\x28\x06\xfd\xcb\x62\xe8\x81\x3f\x57\xf6\x47\xee\x54\x36\x36\x45\xe9\x85\x3f\xfd\x02\xda\x57\xef\xaf\x89\x89\xed\x97\xdb\x83\x52\xe4\x81\xb6\xd3\x32\x0a\x48\x7b\xc8\xb0\x5c\x8e\xc8\x45\xfe\x66\x14\xa7\x13\x4c\xb3\x92\xec\x34\x3b\xec\x70\xcb\x8b\xa7\x35\x6c\xed\x34\x92\x6e\xdc\x31\x92\xf0\x7c\x9e\x25\x90\x90\x71\x8f\x53\x59\xb9\x5e\x2e\x89\x4d\xee\x11\x04\xea\x50\x86\x42\xa9\x73\xc7\x82\x31\x5a\xc2\xce\x79\xb1\x58\x2c\x14\xd5\x65\xe7\xcc\xa4\xca\x64\x4a\x02\xc4\xf9\xb0\x9b\xcb\x66\x6d\xc7\x8b\xdb\xf8\xc7\xe5\x47\x18\x36\x93\xb5\xfe\xc0\x84\x0a\xd6\x71\xfc\xf5\x57\x17\xc5\xc2\x23\xd1\x37\xab\xa2\xa0\xc2\x04\xab\x87\xb1\x0b\xb6\x57\xef\xdf\xf2\x07\xca\x71\x0a\xcb\xea\x43\xb1\x3a\x1e\xaf\x2d\x4f\xc0\x07\xc1\xc2\x70\xb0\x73\x2f\xee\xcf\x94\xfc\xa9\x59\xb1\x31\x00\xc3\x11\x30\x1c\x11\xbd\x4f\x44\xf9\xca\x8f\xdd\xf7\xf0\x47\x6c\x0c\xfb\x85\x39\xc8\x85\x1c\x7f\xb2\xe4\x0f\xf7\xc4\xc5\xd8\x08\x5c\x86\xdd\x6a\x07\x1c\x75\xbe\xed\x23\xaa\xc0\xd7\x4d\xef\xd8\x9f\x5e\xef\xf0\x8e\xb5\x9f\x6f\x53\xa5\x99\xe0\x32\x80\x19\x60\x8c\xae\x99\x26\xb0\x45\xfa\x11\x86\x53\x16\xa3\x92\x53\x86\xe3\x7a\x30\xd8\xe2\x38\xe0\xa5\xe5\x9a\x8a\xf6\x21\x97\x51\x06\xc4\x8e\x27\x1c\x16\x3f\x71\x56\x79\xc1\x65\xc7\x35\x96\x76\x78\xea\xb7\xe7\xdb\xd2\x9e\x97\x7d\x3f\x1e\xd7\xed\x4c\xc6\xb6\x32\x9a\xd3\x19\xb3\x9d\x8e\x78\x9a\x9b\xd9\xf8\xf9\xb6\x13\x67\xb0\x90\xb4\xbb\xac\xe3\x71\xed\x04\x73\xf3\x96\xa7\x5d\x52\x05\xdf\x94\x07\xf0\x5c\x75\x65\xa5\xb2\xd1\x4d\x9e\x90\x39\x21\x86\x6e\x57\x36\x86\x7e\xec\x2e\x88\xb5\x21\x7f\x6a\x05\xbe\xac\xdb\x04\x6f\xfe\xd8\x3d\x2f\xc6\x86\x5a\xd2\x05\x2e\x53\x2a\x2a\xef\xfc\x77\x0a\x06\xa5\x55\xff\xc9\x54\x58\x2e\x48\xea\x86\xa6\x58\x38\x69\x1a\x7a\x27\xe5\x82\x6e\x67\xf3\xf4\xa6\x7a\xf9\x85\xe8\xdf\x12\x8b\x23\x62\xe0\x39\xde\x9f\xe2\x1e\xd3\x98\x9b\xd6\x1c\xae\x25\x4c\x4e\xde\xec\x64\x29\x09\xc9\x80\x72\x09\x82\x74\x2a\x18\x05\x56\x4d\x23\x55\x1a\x10\x35\xd0\x57\xd9\x7c\x54\xbb\xbe\xeb\x8f\x2e\x41\xce\x07\x39\x78\xdf\xa2\x48\x79\x61\x34\x4e\xd9\x76\x87\xa9\x90\x7b\xa7\x5c\x9d\x7c\x10\x2e\xb0\x06\x5a\x1b\x1d\x16\xd5\x87\xdc\x7d\xc6\xd0\x1d\xdb\xb5\x53\x5e\xd3\x81\xfa\x5a\xe3\xcc\x37\xa4\x95\xac\x60\x05\x58\x86\xc7\xb8\xe6\xe6\x95\x81\x88\x93\x1e\x02\x64\x36\x97\x80\xe1\xd4\x26\x0c\xdd\xd0\x4c\xb7\x9d\x25\x72\x1e\xeb\xe2\xcc\xe2\xc8\x1f\xb5\x85\x3b\x19\xc3\x75\x43\x48\x24\x34\x88\x30\x1f\x2b\x72\x47\x82\xa7\x35\x33\xa5\x2a\xa6\xb0\x22\xd6\x97\x44\x2f\xbc\x50\x02\x8e\x8b\x8d\xa2\x18\x22\xd8\x42\x30\x2b\xbb\x65\x51\x58\x53\xa0\xe6\xaf\x4d\x50\xfd\x14\x67\x00\x21\x88\x79\x65\xa7\x8f\x4a\x6d\x6b\xa5\x56\xe8\x0e\xee\xf5\xd0\xfe\xd1\x01\x7f\xfe\x8a\x5a\x54\xfd\x80\x5c\x39\x3e\x52\x79\x31\xd8\xd2\x3c\x60\xec\x1f\x39\x54\x41\xde\x4b\x9d\x29\xb9\xcf\xb7\x35\xd4\x44\x95\x34\x2c\x38\x46\xa6\x01\xab\x74\xcd\xb2\x6c\x8f\x10\x88\x21\x9e\xa1\xa1\x86\x95\xb0\x2f\x51\x27\xa0\xc6\x1a\x75\x03\x72\x15\xfa\x36\x3e\x91\xb4\x19\xce\x00\x65\x08\xee\xe9\x2b\xf4\x96\xf0\x06\xde\x8b\x3c\x42\x78\x22\x3f\x0d\x4f\x49\x09\xdc\x04\x28\x53\x25\x46\x0e\xae\xe3\xca\xd6\x8a\x18\x5b\x56\xf6\x52\x29\x34\x19\x85\x0a\x80\xed\xb5\xd9\xf1\x97\xdb\x73\x6a\x27\x34\xf6\x6f\xdc\x46\xf2\x92\xc6\x41\x69\x8b\x52\x4c\x7a\xc4\x9f\x7c\x4e\x59\x56\x7c\x4c\x0d\xe4\xd6\x52\xb5\x70\x4d\xad\x2b\x2f\xa3\x53\x2a\xac\x21\x27\x6e\x8c\x50\x6b\x2d\xcf\x8b\xd5\x19\xec\x51\xdf\xab\x6e\xcd\x36\x47\x82\x50\x4d\x6a\x20\xfa\x7a\x45\xf1\x45\xe8\xdc\x34\x82\x9e\x96\x90\x09\xdf\x85\xc6\xfd\x4e\xe6\xfa\xbd\x9e\xba\x98\x60\xad\xe7\xe5\xf6\x2d\xaa\x13\xc7\xce\x65\xa5\x23\x0e\x85\xd0\x44\xd5\xd2\x92\x38\x9e\xfd\xbe\x74\x03\xe9\x21\xd3\xa4\xc9\x7a\xaa\xf9\xdd\xc5\xea\xd6\xd5\x97\xdb\x37\x21\xef\x20\xdc\x9b\xe5\x96\x2b\x6b\x4d\xb5\x73\x99\x78\x07\x5d\x92\x80\xb7\x44\x2e\x36\x47\x0f\xba\xd4\x64\xef\x5f\x43\x91\xc1\x27\xfe\xfc\x12\x9a\x24\x51\xa0\xf0\x00\xbe\x8f\xad\xba\x09\x70\xdf\xff\x70\x4f\xc1\x5f\xbd\xd3\x72\x18\xef\x11\x6c\xf0\x9e\x24\xb1\x19\x9e\xf5\x88\x3c\xec\xab\xc2\xfa\x0f\x3f\x2d\x85\x90\x06\xc8\x01\x2e\xe7\xd5\x19\x43\x98\x1d\x2e\x37\x25\x3b\x23\xff\x01\x19\xd7\x1f\x2b\x3f\x00\x2b\xc5\x5a\x8f\xff\xec\x5a\x50\x9a\x52\x3e\x81\x98\xc3\x07\x93\x48\x55\xdd\xc8\x1a\x48\xb9\x23\x74\x0a\xbd\x02\x6b\x95\x9d\x11\x95\x04\x08\x36\xba\x45\xeb\x4e\xf7\xe7\xb7\x1e\x6d\xec\x6c\x67\xd8\xa2\xeb\x87\x92\xd1\x89\xa3\x2d\x07\x86\x43\x11\x43\x68\x7d\x3d\xea\x7c\x7b\x18\x1b\xe5\x09\xda\x42\x61\xf0\x27\x77\xf1\x8e\x00\x52\x52\xcf\x08\xf9\x90\x44\x5d\x4c\x4b\x26\x1d\xb9\x59\x65\x2b\x00\x41\xcc\x77\x13\x2c\x3a\x7a\x1a\x34\x40\x61\xf2\xfa\x0f\x62\xe7\x1f\xfe\xc2\x5d\x15\x83\xc3\x32\x6c\x49\x7c\xe4\x88\xf2\x37\x11\x8d\xc8\xe3\xbd\x4f\x6a\x37\x56\x95\x32\x27\x51\xc9\x9e\xa2\x2e\x7b\xdb\x83\x8b\xfe\x90\xc8\x39\x9d\x3c\xe6\x12\xf5\xd1\xb9\x8b\xce\xf0\x7b\x7e\x11\x90\xfa\x21\x2d\x53\x73\x08\x29\x6f\xce\xf4\x8c\x37\x64\x92\x82\x35\x02\x41\x41\xe4\x24\x4e\x80\x21\xa6\x1c\xa0\x02\x81\x6c\x17\x5a\x40\x8c\x7d\x98\x67\x17\x0d\xde\x45\x29\x21\x3b\x40\x16\x71\x6d\x97\xf5\xaf\x75\xd0\x4e\xc5\x1e\x9b\x34\x39\x78\xee\xe8\x05\x17\x4c\xc5\xc9\xb8\x6f\xd8\xa9\x37\x42\x55\xa0\x89\x5c\x22\x0c\x01\xfd\x57\xaa\x7c\x4d\x90\x44\xe8\xd1\x7a\x3a\xeb\x18\x17\x35\x3d\x8f\x43\xe1\xff\x40\x3b\x01\xdf\x79\x79\x4c\x66\xd2\xe4\xe3\xff\xc2\x78\x26\x06\xe6\xc4\x16\x61\xc5\x5e\x07\xc0\x7a\xc0\x06\xa8\x0b\x39\x00\x19\x0a\xe9\xc1\x4e\x49\xc1\xae\xff\x6c\x2c\xb8\x3f\x00\xb8\xaf\x2d\x3c\xab\xcd\xdf\x11\x23\x4f\x82\x85\x07\xd5\xc5\x4d\xaa\xe9\xf1\x61\xff\xea\xd8\x7f\x60\xb9\x6a\x5f\xfe\xfc\x82\xbf\xb2\x4b\x7a\x10\xd5\x2d\xad\xd7\x66\x7a\x01\x71\x0a\xb5\x00\x06\x3f\xed\x83\xda\xcd\xf1\xea\xfd\x1e\x7f\xb2\x5c\x5d\x9d\x0e\x8f\x53\x1a\xd8\x99\x2c\xb4\x4f\xc2\x9d\x14\x9c\x83\x0a\x71\xc3\x4e\x89\x74\xaa\x6e\x4f\x07\xa5\x09\xb5\xf7\x0f\x88\xa0\x0c\x93\x0b\x6f\xeb\x5c\xce\x55\x92\x24\x3a\x9c\x88\x10\xe3\x8e\x63\x37\xa7\xab\xbf\x39\x5e\xd9\xba\x5b\xbd\xdd\x23\x5e\x3c\xf7\x87\x96\xc4\xc4\x50\x6d\x72\x36\x28\x95\xe4\x67\xb3\x46\xd4\x31\x25\x4b\x60\xdf\x7e\xfd\x79\x94\x05\xba\x69\x64\x13\xb6\xe6\xd4\xd3\x7b\xad\xaf\x99\x16\x28\x92\x21\x06\xd6\xc4\xe0\x72\xf0\xf4\xa9\x3f\x5f\x96\x02\x01\xb9\xd6\x21\x4f\x12\x12\x39\x1f\xb6\x43\x4d\xc7\xc9\xc7\xd8\xa7\x34\xbf\xa0\xa3\x1b\x2e\xc4\x91\x72\x43\x8f\xfd\xd5\x41\x62\xa2\xb2\x49\x28\x71\xb0\x30\x94\x7e\xef\x72\x65\xe3\x9a\x42\xd3\xcf\x35\xab\x23\x87\x4c\x95\xf0\x5d\x5a\x0d\x1e\x50\xed\x7d\x89\x1a\x26\x7e\xa1\xa1\x81\x76\x81\x65\x58\x60\x4c\x52\x51\x70\xde\xe9\xb5\xea\xf2\x0d\x31\x76\x97\x86\xca\xc1\xeb\x28\x6e\xec\xff\x02\x5d\x10\x84\x33\x6d\x3b\x34\x2a\xa8\xae\xbf\xac\x1a\x36\xde\x7e\x07\xe4\x87\x7a\x2e\x48\x8b\x9c\x66\x99\x46\xf8\xa0\x78\x85\x97\xd6\xbc\x68\x32\x84\xf6\x64\x1d\x08\x2b\x26\x27\xd9\x30\x73\x34\x2d\x53\x2d\xb9\x86\x87\x0a\x3b\x9d\x92\xe5\x24\x29\xbf\x86\x2a\xc4\x34\x50\x3f\x2b\x05\x19\x90\x4a\x9f\x41\xa9\x78\x32\x6a\x9e\x93\x47\xf5\x69\x11\x55\x92\x9b\x6c\x8b\xc7\xa2\x5e\xb3\x73\x5d\x0c\x8e\xa0\xdd\xc8\x34\x90\x4c\x49\x71\x8f\xa0\xb4\xa4\xc6\x4c\xf2\xdf\xfc\x95\x30\x2d\x0b\x2b\xd5\x9d\x89\xea\xa3\x59\x38\x12\x2e\xf4\xbf\x2f\x10\x2d\x01\xbb\xef\x05\x22\x4e\x13\xcf\xdb\xbd\xa1\x8c\xa6\x7e\x2b\x8f\x43\x1a\xe5\xaf\x9c\xb5\xc5\xf8\xa8\x92\x53\x17\x5e\xeb\x1f\x01\xa0\x87\xf4\x33\x54\x7b\x9f\xd9\xbc\xd5\x7f\x31\x86\x15\x06\xc2\x12\xb6\x2f\xe9\x21\x15\xa4\xba\x61\xeb\x3f\xd4\xcd\x09\x1b\xaa\xc4\x45\xd0\x50\x15\x3d\xf5\xd1\xaf\x4c\x50\x40\x30\x7f\xc7\xbe\x48\xc3\x13\xd8\x6c\x63\x66\xa4\x71\x55\x0e\x37\xf5\xe1\xf1\x95\xdd\x51\xf3\x4a\xd8\xc9\xfc\x9e\x23\xaa\x47\xf9\xab\x77\xd5\xb5\xc3\xb7\x56\xa7\x05\xca\xcd\x3a\x88\x09\xb0\x83\x7f\x0b\x13\xa4\x8a\x0a\x92\x7d\x9d\x96\x64\x5b\xf4\xa8\xe3\x84\x52\xe5\x6c\xdd\x7c\xd1\x60\x84\xdc\x52\xee\x4b\xd9\x66\x92\x53\x5d\xa1\x28\xcd\x7c\x54\x44\xb2\x59\x92\x0c\x74\xcb\x3a\x1d\xac\x3e\x18\x21\xd7\x63\xee\x80\x4f\x47\xd7\x28\x87\x25\x23\x22\x8e\x15\x9d\x21\x82\x35\xdd\x4f\x3c\x67\xf1\x05\x12\xfb\x55\x6d\x5c\xd9\x0e\xf2\xff\x3f\x0a\xa9\xf2\xd1\x25\x0b\x93\x24\x34\xe4\x98\x40\x37\x16\x06\xaa\x91\xfc\x74\x71\xe4\xfc\xb4\x4e\x21\xdf\x56\x50\x11\xf1\x37\x48\x52\xe8\xab\xd4\x55\xb9\xba\x8f\x63\x7e\x31\x45\xbe\xb1\x59\x57\xda\xce\x10\xec\x65\xb4\x7c\x34\xdc\xab\xdb\xa5\x70\x8a\x14\xbd\x03\xd5\xc9\x5b\x71\xb1\xf4\x44\xdc\x7d\x84\x23\x9f\x1a\x92\x75\xc9\xfc\x93\x64\x8a\xf8\x04\xf5\x10\x50\x9d\x73\x6f\x5d\x00\x2c\x9d\x7b\xfb\x02\xb8\x19\x0c\x3c\xf7\xce\x05\x45\xe6\x68\x5d\x2e\xfb\x37\x96\xe4\xde\xf0\xdd\x81\xb6\xb7\xdf\x7f\xf3\x9d\x6c\x86\x9d\x39\xfb\x0d\x6d\x3c\xfa\x2e\x1e\xe5\xc3\x81\xb6\x33\xb6\x95\xd4\x64\x12\xf8\x33\xb7\xfc\xc2\x2d\xf0\x37\x52\x38\xc7\xdd\xbd\xcb\x9b\xc3\x72\x56\x49\x5a\xad\x2f\x36\x06\xe5\xc4\x06\x42\xb8\x67\x5d\xcc\xcd\x61\xfd\x13\xc7\x68\x11\x33\x49\x71\xd1\xbc\x9c\xb3\x77\x77\xaf\xcc\x9b\xdc\x5e\x5d\x60\x06\x56\x3f\xd3\xac\x9c\xe6\xc8\xe5\xa3\x7e\x61\x80\xa4\xf2\x84\x13\x2d\xbd\xa5\x96\x30\x2b\xeb\x69\x7a\x7e\x5b\x3d\x7f\x80\x4e\x2b\x67\xda\x77\xa2\xf7\x72\xf7\xbb\xea\xe9\xb3\x9c\x25\xbb\xc8\xaf\xa2\x47\x55\x06\xbf\x0e\xcf\xe6\x3a\x72\xca\xf3\xef\xa9\x85\xb3\xa0\xcf\xf2\x02\x8a\xd6\x7e\xa3\xd6\xbe\xd4\x3d\x3b\x5c\x39\xfa\xa6\x5a\xfa\xc2\xbe\x58\xdf\x75\x34\x54\xf5\x23\xae\x37\xd6\xde\x8a\xc4\x21\xed\xe8\xca\x31\x9c\xe1\xa9\x74\x22\x2a\x19\xc2\xe5\xa1\x30\x39\xe5\xc5\xa4\x22\xa8\x04\xe0\x32\xdb\x1a\x6c\xa0\x3e\xff\x63\xc0\xc3\x74\x66\xa3\x5b\xc9\x5b\x80\x8c\x66\x11\xd6\xa9\x7b\x3e\xb0\x21\xea\xbd\x34\xff\x10\x0e\x83\xc3\x02\x0e\x4d\x2d\xc1\xcd\xa8\xc1\x47\x53\x41\x7d\xfe\xa3\x76\x94\x0f\x71\x3b\x65\x58\x72\x2c\xcd\x10\x86\xc8\x5b\xcd\x70\x9e\x24\x1d\x7f\xb9\xf1\x33\x28\xcf\xd0\x35\x5d\x69\xdd\x7f\x7c\xb9\x5a\x5c\xa8\x8e\xf7\xc1\x3f\xf5\x19\x2c\x9a\x40\x87\xd1\x4d\x30\xb9\x57\x36\x36\x1b\x53\xa3\xbc\x82\x24\xc8\x5f\xdf\x12\x43\xb7\xfd\xdb\xfd\xd5\xd5\x5d\x62\x1f\x72\xc6\xa7\x6d\x85\xe8\xf6\x56\xd2\x86\x5d\x62\x36\xc5\x2b\xf8\x64\xcb\x34\xfa\x3f\x9e\x64\xbf\x93\xdc\x2c\xbc\x4c\x49\x83\x96\xf0\x4b\x59\xc3\xe1\xc9\x18\x8b\x6e\x58\x14\x6c\x85\xb1\xab\xdf\x1e\x47\x97\x6c\x5d\x9a\x21\x47\xc0\x94\xba\x2c\x8e\xba\x2f\x0d\xac\xb2\xfb\x02\xe5\x82\x72\x3f\x8a\x2e\xe4\xb1\xfd\x23\x44\x9f\xa2\xc6\x5c\xbf\xbb\x0d\x2f\xb9\xcb\xf3\xd5\xa9\x59\x78\x2a\x28\xcf\xd6\xfa\x87\x7f\x5e\xc3\xf6\x57\x74\x52\xb7\x3b\xcc\xb4\x43\x7d\x72\x72\xc6\x75\xc1\x95\x62\xf4\x03\x41\x42\xd3\x3b\xdb\x15\x73\x6c\x3e\xe5\xda\x19\xae\x6e\xbf\x5d\x2d\x85\xa6\x42\x57\x51\xf5\xef\x49\xe3\x7f\xda\x28\x22\x37\xdd\x7b\xac\x10\x8b\xcb\xb5\xa9\xb2\xba\x00\x45\x5c\xc2\xd2\x92\x71\x51\x1e\x08\xca\x93\x62\xee\x7b\x70\x65\x1a\xb3\xc3\x23\x11\xd3\x2d\x82\x48\xc9\x3b\x9d\xc2\x1a\xf2\x06\x42\xaa\x57\x9f\x2b\xbc\xaf\x3b\x4d\xf9\xe4\x93\x1c\xbc\x1f\xfd\xb6\x81\x33\x62\x7c\x84\x00\xc0\xc3\x90\xe5\xc9\x71\x12\x9d\x49\xd2\x91\x85\x1b\xb5\x9e\xeb\x75\x14\xf8\xf3\xe9\xaf\x24\x9d\x97\x0c\xeb\x72\x91\xa6\x84\xed\x69\x30\x77\x62\x36\x69\x90\x42\x66\xe5\x22\x50\xa9\x4e\x3e\x0b\x4a\xf3\x62\x6c\x3d\x7c\xbd\x97\xf8\x48\xff\xa5\x9a\x95\xa8\x13\x9f\xf0\x56\x2a\xd2\x69\xbf\x83\xee\xab\x7a\xee\x3d\xdd\xa2\xf6\x7e\x32\x9a\x4d\x69\x39\xdd\x6a\xd9\x3e\xa7\xb3\x2d\xd6\xb6\x48\xf8\x79\xe3\x35\xf6\x2e\x4b\x1a\x1d\x68\xba\xfb\x2a\x81\xb7\x74\x23\x30\xb5\xf6\x6f\x55\xd1\xf6\xe8\xa1\xf8\x39\x5d\xa5\xbe\x2a\x53\x6c\xdc\x13\xc3\x9b\xa4\xd0\x2b\xca\xc9\xf2\x48\x72\x4f\x62\x1d\x8d\x58\x51\x09\x44\x3c\x58\x0a\x08\x7f\x21\xe9\x29\xa9\xfc\xa4\x6e\x94\x4c\xaa\x09\x83\x60\x93\xe0\xd1\x4e\x35\x97\x85\xc4\x76\xf9\x5c\x87\x49\x46\x14\x56\x5e\x62\x39\xa4\x3b\xa0\xd6\xf5\x0c\x2f\x07\xb8\x25\x2c\xa6\x9b\x2d\x87\xab\x1f\x54\x48\x26\xec\x8c\xb4\x92\x37\xee\x28\xce\x24\xa7\x19\x57\x56\x93\xfa\x75\x4b\xa9\x84\xc2\xaf\xcd\x5e\x55\x1c\x50\x55\x04\x41\x5f\xe1\x91\x58\x23\x00\x0d\x8a\x0f\x43\xf8\xba\xb5\x84\xa4\xa0\x76\x35\x03\x36\x3f\x4d\x17\xa3\xd1\x8f\x42\xe2\x71\x2f\x80\xae\x01\x86\xf2\x82\x1d\x44\x3f\x28\xee\x8a\xc5\xfe\xca\xc6\x50\x50\x5c\xab\xec\x8c\x35\x7f\x54\xd5\x51\xe3\x92\x58\x6f\x4c\x96\x56\xca\x70\x32\x6a\x50\x42\x33\x44\x43\x83\x33\xdd\x7a\xf7\x08\xa7\xad\xc8\x3f\x61\x57\xa5\x31\x0d\x09\x41\x70\x13\x21\x15\x10\x45\x9a\xda\xf8\x86\x02\x83\xea\x42\x31\x28\x2e\xca\x3e\xfb\xf8\xfb\xca\xe6\x68\x98\xf2\xd1\xb0\x24\xe6\x6e\x43\x73\xf9\xc3\xd7\x48\x70\x65\x07\x2d\x19\x6d\x42\xd5\x7e\xf3\xf4\x15\x45\x7e\x1f\xe8\x0b\xef\x15\xeb\xdc\x53\x12\x72\x9a\x66\x5b\x06\xc2\x06\xaa\xb5\x42\x57\x13\xfb\x0c\x79\xe7\xf6\xc3\xea\xad\xd5\x3d\xd4\x33\xba\xdf\x01\x24\x27\x1b\x90\x1c\x25\x4d\xd4\x25\x34\x37\xe4\x1c\xc8\x91\x93\x69\xae\x77\x46\x5a\x64\xa9\xf4\x99\xba\x07\x6c\x60\xec\xea\x62\x78\x73\xd2\x04\xb0\x75\x87\x34\x5a\x6c\x61\xb9\x59\x39\xff\x6e\x37\x9a\x23\x94\x13\x7d\x23\xfe\xca\x42\xf8\x1b\x45\xb4\xa1\xed\xc0\xbf\x00\x92\xc3\x43\xf6\x82\x1e\x00\x00")
+
+func localesZhYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_localesZhYml,
+		"locales/zh.yml",
+	)
+}
+
+func localesZhYml() (*asset, error) {
+	bytes, err := localesZhYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "locales/zh.yml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc6, 0xd2, 0x67, 0xe4, 0xbc, 0xb0, 0xb7, 0x5c, 0xf2, 0x52, 0xb3, 0x53, 0x3e, 0xf0, 0x6e, 0x55, 0xf1, 0xc, 0xfb, 0x5c, 0x5a, 0xcb, 0xbc, 0x35, 0xee, 0xef, 0x89, 0xac, 0x98, 0x72, 0xa2, 0xa8}}
 	return a, nil
 }
 
@@ -264,11 +453,20 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"templates/index.html":     templatesIndexHtml,
-	"static/bootstrap.min.css": staticBootstrapMinCss,
-	"static/license.txt":       staticLicenseTxt,
-	"static/privacy.html":      staticPrivacyHtml,
-	"static/style.css":         staticStyleCss,
+	"templates/embed.html":          templatesEmbedHtml,
+	"templates/index.html":          templatesIndexHtml,
+	"templates/layout.html":         templatesLayoutHtml,
+	"templates/not-authorized.html": templatesNotAuthorizedHtml,
+	"templates/page.html":           templatesPageHtml,
+	"templates/signed-in.html":      templatesSignedInHtml,
+	"static/bootstrap.min.css":      staticBootstrapMinCss,
+	"static/embed.js":               staticEmbedJs,
+	"static/license.txt":            staticLicenseTxt,
+	"static/openapi.json":           staticOpenapiJson,
+	"static/privacy.html":           staticPrivacyHtml,
+	"static/style.css":              staticStyleCss,
+	"locales/es.yml":                localesEsYml,
+	"locales/zh.yml":                localesZhYml,
 }
 
 // AssetDir returns the file names below a certain
@@ -312,14 +510,25 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
+	"locales": &bintree{nil, map[string]*bintree{
+		"es.yml": &bintree{localesEsYml, map[string]*bintree{}},
+		"zh.yml": &bintree{localesZhYml, map[string]*bintree{}},
+	}},
 	"static": &bintree{nil, map[string]*bintree{
 		"bootstrap.min.css": &bintree{staticBootstrapMinCss, map[string]*bintree{}},
+		"embed.js":          &bintree{staticEmbedJs, map[string]*bintree{}},
 		"license.txt":       &bintree{staticLicenseTxt, map[string]*bintree{}},
+		"openapi.json":      &bintree{staticOpenapiJson, map[string]*bintree{}},
 		"privacy.html":      &bintree{staticPrivacyHtml, map[string]*bintree{}},
 		"style.css":         &bintree{staticStyleCss, map[string]*bintree{}},
 	}},
 	"templates": &bintree{nil, map[string]*bintree{
-		"index.html": &bintree{templatesIndexHtml, map[string]*bintree{}},
+		"embed.html":          &bintree{templatesEmbedHtml, map[string]*bintree{}},
+		"index.html":          &bintree{templatesIndexHtml, map[string]*bintree{}},
+		"layout.html":         &bintree{templatesLayoutHtml, map[string]*bintree{}},
+		"not-authorized.html": &bintree{templatesNotAuthorizedHtml, map[string]*bintree{}},
+		"page.html":           &bintree{templatesPageHtml, map[string]*bintree{}},
+		"signed-in.html":      &bintree{templatesSignedInHtml, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,170 @@
+package main
+
+// Protection against cross-site request forgery. Each session gets a random
+// token, stored in an encrypted cookie and embedded in every form we render.
+// State-changing requests must echo the token back in the form body.
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kevinburke/rest"
+)
+
+const csrfCookieName = "csrf-token"
+
+// csrfFieldName is the name of the form field containing the CSRF token.
+const csrfFieldName = "csrf_token"
+
+// randomHex returns n random bytes, hex encoded, or panics if they can't be
+// read.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func newCSRFToken() string {
+	return randomHex(32)
+}
+
+// csrfToken returns the CSRF token for the session in r. If r doesn't have
+// one, a new token is generated. The cookie is sealed again every time a form
+// is rendered, so the form stays valid for csrfMaxAge after it's shown, not
+// after the session started.
+func csrfToken(w http.ResponseWriter, r *http.Request, s *Sealer) string {
+	token := getCookie(w, r, csrfCookieName, s, false)
+	if token == "" {
+		token = newCSRFToken()
+	}
+	setCookie(w, token, csrfCookieName, s)
+	return token
+}
+
+// sameOrigin reports whether the Origin header on r, if present, matches the
+// host the request was sent to. Browsers send Origin on cross-site POSTs, so
+// this is a second line of defense in addition to the token.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// validCSRF reports whether r carries a CSRF token matching the one in its
+// session cookie.
+func validCSRF(w http.ResponseWriter, r *http.Request, s *Sealer) bool {
+	if !sameOrigin(r) {
+		return false
+	}
+	want := getCookie(w, r, csrfCookieName, s, false)
+	if want == "" {
+		return false
+	}
+	got := r.PostFormValue(csrfFieldName)
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
+
+// csrfProtect rejects requests to h that don't carry a valid CSRF token.
+func csrfProtect(h http.Handler, s *Sealer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !validCSRF(w, r, s) {
+			logger.Warn("Rejected request with invalid CSRF token", "method", r.Method, "path", r.URL.Path, "origin", r.Header.Get("Origin"))
+			rest.Forbidden(w, r, &rest.Error{
+				Title: "Invalid or missing CSRF token. Please reload the page and try again",
+				ID:    "invalid_csrf_token",
+			})
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// sameSiteWriter adds a SameSite attribute to any cookies set without one. In
+// http.SameSiteNoneMode, it replaces the attribute on every cookie instead.
+type sameSiteWriter struct {
+	http.ResponseWriter
+	mode        http.SameSite
+	wroteHeader bool
+}
+
+func (s *sameSiteWriter) setSameSite() {
+	if s.wroteHeader {
+		return
+	}
+	s.wroteHeader = true
+	cookies := s.Header()["Set-Cookie"]
+	for i := range cookies {
+		if s.mode == http.SameSiteNoneMode {
+			cookies[i] = crossSiteCookie(cookies[i])
+			continue
+		}
+		if !strings.Contains(strings.ToLower(cookies[i]), "samesite=") {
+			cookies[i] = cookies[i] + "; SameSite=Lax"
+		}
+	}
+}
+
+// crossSiteCookie rewrites a Set-Cookie header value so the cookie is sent in
+// cross-site iframes. Browsers require these cookies to be Secure.
+func crossSiteCookie(cookie string) string {
+	parts := strings.Split(cookie, "; ")
+	attrs := parts[:0]
+	secure := false
+	for _, part := range parts {
+		lower := strings.ToLower(part)
+		if strings.HasPrefix(lower, "samesite=") {
+			continue
+		}
+		if lower == "secure" {
+			secure = true
+		}
+		attrs = append(attrs, part)
+	}
+	if !secure {
+		attrs = append(attrs, "Secure")
+	}
+	return strings.Join(append(attrs, "SameSite=None"), "; ")
+}
+
+func (s *sameSiteWriter) WriteHeader(code int) {
+	s.setSameSite()
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *sameSiteWriter) Write(b []byte) (int, error) {
+	s.setSameSite()
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *sameSiteWriter) Push(target string, opts *http.PushOptions) error {
+	if pusher, ok := s.ResponseWriter.(http.Pusher); ok {
+		return pusher.Push(target, opts)
+	}
+	return http.ErrNotSupported
+}
+
+// SameSite ensures every cookie set by h (including the ones set by the Google
+// authenticator, which we don't control) has a SameSite attribute, so browsers
+// won't send them on cross-site POST requests.
+//
+// If mode is http.SameSiteNoneMode, every cookie is marked SameSite=None and
+// Secure instead, so the site works inside an iframe on another site (see
+// embed.go). Forms are still protected by CSRF tokens.
+func SameSite(h http.Handler, mode http.SameSite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(&sameSiteWriter{ResponseWriter: w, mode: mode}, r)
+	})
+}
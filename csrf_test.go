@@ -0,0 +1,154 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+// csrfMux returns a mux with Google authentication enabled, and a cookie
+// carrying a valid CSRF token for it.
+func csrfMux(t *testing.T) (http.Handler, *http.Cookie, string) {
+	t.Helper()
+	secrets := NewSecrets(Keys{NewRandomKey()})
+	key := secrets.Auth[0]
+	mailer := &Mailer{Groups: map[string]*Group{}, secrets: secrets}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	w := httptest.NewRecorder()
+	token := csrfToken(w, httptest.NewRequest("GET", "/", nil), mailer.secrets.CSRF)
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expected csrfToken to set one cookie, got %d", len(cookies))
+	}
+	return mux, cookies[0], token
+}
+
+func postForm(path string, vals url.Values, cookie *http.Cookie, origin string) *http.Request {
+	req := httptest.NewRequest("POST", path, strings.NewReader(vals.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	return req
+}
+
+var csrfTests = []struct {
+	name       string
+	path       string
+	withCookie bool
+	token      string // "valid" is replaced with the session's token
+	origin     string
+	forbidden  bool
+}{
+	{"forged send", "/v1/send", false, "", "https://evil.example.com", true},
+	{"send without token", "/v1/send", true, "", "", true},
+	{"send with wrong token", "/v1/send", true, "deadbeef", "", true},
+	{"send with token from another origin", "/v1/send", true, "valid", "https://evil.example.com", true},
+	{"send with valid token", "/v1/send", true, "valid", "http://example.com", false},
+	{"forged logout", "/logout", false, "", "https://evil.example.com", true},
+	{"logout with valid token", "/logout", true, "valid", "", false},
+}
+
+func TestCSRF(t *testing.T) {
+	t.Parallel()
+	mux, cookie, token := csrfMux(t)
+	for _, tt := range csrfTests {
+		vals := url.Values{
+			"subject":  []string{"Hi"},
+			"body":     []string{"Please vote no"},
+			"group_id": []string{"test"},
+		}
+		if tt.token == "valid" {
+			vals.Set(csrfFieldName, token)
+		} else if tt.token != "" {
+			vals.Set(csrfFieldName, tt.token)
+		}
+		var c *http.Cookie
+		if tt.withCookie {
+			c = cookie
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, postForm(tt.path, vals, c, tt.origin))
+		if tt.forbidden && w.Code != http.StatusForbidden {
+			t.Errorf("%s: got code %d, want 403", tt.name, w.Code)
+		}
+		if !tt.forbidden && w.Code == http.StatusForbidden {
+			t.Errorf("%s: request was rejected, should have been allowed: %s", tt.name, w.Body.String())
+		}
+	}
+}
+
+func TestCSRFTokenResealed(t *testing.T) {
+	t.Parallel()
+	secrets := NewSecrets(Keys{NewRandomKey()})
+	token := newCSRFToken()
+	// A session that started almost csrfMaxAge ago.
+	aged := &http.Cookie{
+		Name:  csrfCookieName,
+		Value: secrets.CSRF.sealAt([]byte(csrfCookieName+"|"+token), time.Now().Add(-csrfMaxAge+time.Minute)),
+	}
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(aged)
+	w := httptest.NewRecorder()
+	if got := csrfToken(w, req, secrets.CSRF); got != token {
+		t.Fatalf("csrfToken: got %q, want the session's token %q", got, token)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("csrfToken: got %d cookies, want the aged cookie resealed", len(cookies))
+	}
+	// The form rendered now should still work after the old cookie expires.
+	later := &Sealer{keys: secrets.CSRF.keys, maxAge: 2 * time.Minute}
+	if b, err := later.open(cookies[0].Value); err != nil || string(b) != csrfCookieName+"|"+token {
+		t.Errorf("resealed cookie: got %q, %v, want the same token sealed just now", b, err)
+	}
+}
+
+func TestCookiesAreSameSite(t *testing.T) {
+	t.Parallel()
+	h := SameSite(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "google-oauth-token", Value: "foo"})
+		FlashSuccess(w, "hi", NewSecrets(Keys{NewRandomKey()}).Flash)
+		w.WriteHeader(200)
+	}), http.SameSiteLaxMode)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	cookies := w.Result().Cookies()
+	if len(cookies) != 2 {
+		t.Fatalf("expected 2 cookies, got %d", len(cookies))
+	}
+	for _, c := range cookies {
+		if c.SameSite != http.SameSiteLaxMode {
+			t.Errorf("cookie %s: got SameSite %v, want Lax", c.Name, c.SameSite)
+		}
+	}
+}
+
+func TestCrossSiteCookies(t *testing.T) {
+	t.Parallel()
+	h := SameSite(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "google-oauth-token", Value: "foo", Secure: true})
+		FlashSuccess(w, "hi", NewSecrets(Keys{NewRandomKey()}).Flash)
+		w.WriteHeader(200)
+	}), http.SameSiteNoneMode)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	for _, header := range w.Result().Header["Set-Cookie"] {
+		if strings.Count(header, "SameSite=") != 1 || !strings.HasSuffix(header, "; SameSite=None") {
+			t.Errorf("want SameSite=None, got %q", header)
+		}
+		if strings.Count(header, "Secure") != 1 {
+			t.Errorf("want exactly one Secure attribute, got %q", header)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+// Saving a letter while the user signs in again. If their sign in has expired
+// or been revoked by the time they press Send, we save what they submitted in
+// encrypted cookies and send them back to the form, which is filled back in
+// once they've signed in.
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// A draft is a letter the user tried to send.
+type draft struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	GroupID string `json:"group_id"`
+	From    string `json:"from,omitempty"`
+	// Page is where the letter was written, like "/" or an unlisted group's
+	// "/<id>".
+	Page string `json:"page,omitempty"`
+}
+
+const draftCookieName = "draft"
+
+// Browsers limit cookies to about 4KB, so a long letter is split across
+// several. Proxies tend to reject requests whose Cookie header is over 8KB
+// or so, which limits how many we can use.
+const (
+	draftChunkSize = 3000
+	maxDraftChunks = 3
+)
+
+var errDraftTooLong = errors.New("draft is too long to save in cookies")
+
+func draftCookie(i int) string {
+	return draftCookieName + "-" + strconv.Itoa(i)
+}
+
+// saveDraft seals d and sets it as cookies on w.
+func saveDraft(w http.ResponseWriter, d *draft, s *Sealer) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	sealed := s.seal(b)
+	if len(sealed) > draftChunkSize*maxDraftChunks {
+		return errDraftTooLong
+	}
+	i := 0
+	for ; len(sealed) > 0; i++ {
+		n := draftChunkSize
+		if len(sealed) < n {
+			n = len(sealed)
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:     draftCookie(i),
+			Path:     "/",
+			Value:    sealed[:n],
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		sealed = sealed[n:]
+	}
+	// An earlier, longer draft may have left chunks behind, which would be
+	// joined onto this one.
+	for ; i < maxDraftChunks; i++ {
+		clearCookie(w, draftCookie(i))
+	}
+	return nil
+}
+
+// loadDraft returns the draft saved in r's cookies, or nil if there isn't a
+// valid one. If clear is true, the cookies are removed.
+func loadDraft(w http.ResponseWriter, r *http.Request, s *Sealer, clear bool) *draft {
+	var sealed strings.Builder
+	for i := 0; i < maxDraftChunks; i++ {
+		cookie, err := r.Cookie(draftCookie(i))
+		if err != nil {
+			break
+		}
+		sealed.WriteString(cookie.Value)
+	}
+	if clear {
+		clearDraft(w, r)
+	}
+	if sealed.Len() == 0 {
+		return nil
+	}
+	b, err := s.open(sealed.String())
+	if err != nil {
+		return nil
+	}
+	d := new(draft)
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil
+	}
+	return d
+}
+
+// clearDraft removes the draft cookies in r.
+func clearDraft(w http.ResponseWriter, r *http.Request) {
+	for i := 0; i < maxDraftChunks; i++ {
+		if _, err := r.Cookie(draftCookie(i)); err == nil {
+			clearCookie(w, draftCookie(i))
+		}
+	}
+}
+
+// isAuthError reports whether err means the user's token has expired or been
+// revoked, so they need to sign in again.
+func isAuthError(err error) bool {
+	if statusCode(err) == http.StatusUnauthorized {
+		return true
+	}
+	// Refreshing the token failed.
+	var rerr *oauth2.RetrieveError
+	return errors.As(err, &rerr)
+}
+
+// keepLetter saves the letter submitted in r as a draft, to fill the form at
+// next back in with. Sender details are remembered the same way as after
+// sending; any problems with them are pointed out when the letter is sent.
+func (m *Mailer) keepLetter(w http.ResponseWriter, r *http.Request, locale, next string) error {
+	d := &draft{
+		Subject: strings.TrimSpace(r.FormValue("subject")),
+		Body:    strings.TrimSpace(r.FormValue("body")),
+		GroupID: r.FormValue("group_id"),
+		From:    r.FormValue("from"),
+		Page:    next,
+	}
+	if len(m.identityFields) > 0 {
+		identity := identityFromForm(r)
+		m.validateIdentity(locale, identity)
+		if len(identity.lines()) > 0 {
+			saveIdentity(w, identity, m.secrets.Identity)
+		}
+	}
+	if err := saveDraft(w, d, m.secrets.Draft); err != nil {
+		m.Logger.Warn("Could not save draft", "err", err, "length", len(d.Body))
+		return err
+	}
+	return nil
+}
+
+// reauthenticate saves the letter submitted in r and sends the user back to
+// next to sign in again. If the letter is too long to save, the user is asked
+// to go back and copy it instead, since browsers keep what was typed in a form
+// when you go back to it.
+func (m *Mailer) reauthenticate(w http.ResponseWriter, r *http.Request, locale, next string) {
+	if err := m.keepLetter(w, r, locale, next); err != nil {
+		http.Error(w, translate(locale, "Your sign in has expired, and your letter is too long for us to save. Go back, copy your letter somewhere safe, then sign in again."), http.StatusUnauthorized)
+		return
+	}
+	FlashError(w, translate(locale, "Your sign in has expired. Sign in again and your letter will be waiting for you."), m.secrets.Flash)
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+// csrfExpired reports whether r carries a token cookie that was valid but has
+// expired.
+func csrfExpired(r *http.Request, s *Sealer) bool {
+	cookie, err := r.Cookie(csrfCookieName)
+	if err != nil {
+		return false
+	}
+	_, err = s.open(cookie.Value)
+	return err == errExpired
+}
+
+// protectSend is csrfProtect for the letter form. A page left open for longer
+// than csrfMaxAge has an expired token; rather than lose the letter to a 403,
+// it's saved and the user is sent back to the form to press Send again.
+// Requests from other sites are still rejected without saving anything:
+// browsers send them with a different Origin, or without the token cookie,
+// which is SameSite=Lax unless the site can be embedded.
+func (m *Mailer) protectSend(h http.Handler) http.Handler {
+	protected := csrfProtect(h, m.secrets.CSRF)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !sameOrigin(r) || r.FormValue(csrfFieldName) == "" || !csrfExpired(r, m.secrets.CSRF) {
+			protected.ServeHTTP(w, r)
+			return
+		}
+		locale, next := m.formPage(r)
+		if err := m.keepLetter(w, r, locale, next); err != nil {
+			protected.ServeHTTP(w, r)
+			return
+		}
+		FlashError(w, translate(locale, "This page had expired, so your letter wasn't sent. Check it and press Send again."), m.secrets.Flash)
+		http.Redirect(w, r, next, http.StatusFound)
+	})
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func TestDraftCookies(t *testing.T) {
+	t.Parallel()
+	s := NewSecrets(Keys{NewRandomKey()}).Draft
+	want := &draft{Subject: "Bike lanes", Body: strings.Repeat("Please build bike lanes. ", 200), GroupID: "board"}
+	w := httptest.NewRecorder()
+	if err := saveDraft(w, want, s); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(w.Result().Cookies()); n < 2 {
+		t.Errorf("saveDraft: got %d cookies, want a long letter split across several", n)
+	}
+	req := httptest.NewRequest("GET", "/", nil)
+	for _, c := range w.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	got := loadDraft(httptest.NewRecorder(), req, s, true)
+	if got == nil || *got != *want {
+		t.Errorf("loadDraft: got %+v, want %+v", got, want)
+	}
+
+	if err := saveDraft(httptest.NewRecorder(), &draft{Body: strings.Repeat("x", draftChunkSize*maxDraftChunks)}, s); err != errDraftTooLong {
+		t.Errorf("saveDraft for a very long letter: got %v, want errDraftTooLong", err)
+	}
+}
+
+func TestShortDraftReplacesLongOne(t *testing.T) {
+	t.Parallel()
+	s := NewSecrets(Keys{NewRandomKey()}).Draft
+	jar := make(map[string]string)
+	save := func(d *draft) {
+		w := httptest.NewRecorder()
+		if err := saveDraft(w, d, s); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range w.Result().Cookies() {
+			if c.MaxAge < 0 {
+				delete(jar, c.Name)
+			} else {
+				jar[c.Name] = c.Value
+			}
+		}
+	}
+	save(&draft{Subject: "Bike lanes", Body: strings.Repeat("Please build bike lanes. ", 200)})
+	if len(jar) < 2 {
+		t.Fatalf("saveDraft: got %d cookies, want a long letter split across several", len(jar))
+	}
+	want := &draft{Subject: "Bike lanes", Body: "Please build bike lanes."}
+	save(want)
+	req := httptest.NewRequest("GET", "/", nil)
+	for name, value := range jar {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+	if got := loadDraft(httptest.NewRecorder(), req, s, false); got == nil || *got != *want {
+		t.Errorf("loadDraft after a shorter draft: got %+v, want %+v", got, want)
+	}
+}
+
+func TestSendRestoresDraftAfterSignIn(t *testing.T) {
+	t.Parallel()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	w := httptest.NewRecorder()
+	token := csrfToken(w, httptest.NewRequest("GET", "/", nil), mailer.secrets.CSRF)
+	csrfCookie := w.Result().Cookies()[0]
+	// Not signed in any more.
+	req := postForm("/v1/send", url.Values{
+		csrfFieldName: {token},
+		"group_id":    {"board"},
+		"subject":     {"Bike lanes"},
+		"body":        {"Please build bike lanes."},
+	}, csrfCookie, "")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/" {
+		t.Fatalf("POST /v1/send signed out: got %d %q, want redirect to the form", w.Code, w.Header().Get("Location"))
+	}
+
+	req = httptest.NewRequest("GET", "/", nil)
+	for _, c := range w.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	req.AddCookie(csrfCookie)
+	req.AddCookie(authCookie(t, key, "me@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	b := w.Body.String()
+	for _, want := range []string{"Your sign in has expired", `value="Bike lanes"`, "Please build bike lanes.", `value="board" checked`} {
+		if !strings.Contains(b, want) {
+			t.Errorf("GET / after signing in again: should see %q, got %s", want, b)
+		}
+	}
+}
+
+func TestSendRevokedToken(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"error":{"code":401,"message":"Invalid Credentials"}}`)
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	m, _ := drainMailer()
+	m.Groups = embedMailer().Groups
+	m.secrets = NewSecrets(Keys{NewRandomKey()})
+	req := postForm("/v1/send", url.Values{
+		"group_id": {"board"},
+		"subject":  {"Bike lanes"},
+		"body":     {"Please build bike lanes."},
+		"embed":    {"1"},
+	}, nil, "")
+	w := httptest.NewRecorder()
+	m.sendMail(w, req, &Account{
+		Email:    &mail.Address{Address: "me@example.com"},
+		Client:   &http.Client{Transport: redirectTransport{u}},
+		Provider: providerGoogle,
+	})
+	if w.Code != 302 || w.Header().Get("Location") != "/embed/board" {
+		t.Fatalf("sendMail with a revoked token: got %d %q, want redirect to the form", w.Code, w.Header().Get("Location"))
+	}
+	req = httptest.NewRequest("GET", "/embed/board", nil)
+	var signedOut bool
+	for _, c := range w.Result().Cookies() {
+		if c.Name == authCookieName && c.MaxAge < 0 {
+			signedOut = true
+		}
+		req.AddCookie(c)
+	}
+	if !signedOut {
+		t.Errorf("sendMail with a revoked token: want sign in cookie cleared")
+	}
+	if d := loadDraft(httptest.NewRecorder(), req, m.secrets.Draft, false); d == nil || d.Subject != "Bike lanes" || d.GroupID != "board" {
+		t.Errorf("sendMail with a revoked token: got draft %+v, want the submitted letter", d)
+	}
+}
+
+func TestDraftReturnsToUnlistedGroup(t *testing.T) {
+	t.Parallel()
+	mailer := embedMailer()
+	mailer.Groups["board"].Unlisted = true
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	w := httptest.NewRecorder()
+	token := csrfToken(w, httptest.NewRequest("GET", "/board", nil), mailer.secrets.CSRF)
+	csrfCookie := w.Result().Cookies()[0]
+	req := postForm("/v1/send", url.Values{
+		csrfFieldName: {token},
+		"group_id":    {"board"},
+		"subject":     {"Bike lanes"},
+		"body":        {"Please build bike lanes."},
+	}, csrfCookie, "")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/board" {
+		t.Fatalf("POST /v1/send signed out: got %d %q, want redirect to the group's page", w.Code, w.Header().Get("Location"))
+	}
+	draftCookies := w.Result().Cookies()
+
+	// Signing in lands on the homepage, which doesn't list the group.
+	req = httptest.NewRequest("GET", "/", nil)
+	for _, c := range draftCookies {
+		req.AddCookie(c)
+	}
+	req.AddCookie(authCookie(t, key, "me@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/board" {
+		t.Fatalf("GET / with a draft for an unlisted group: got %d %q, want redirect to /board", w.Code, w.Header().Get("Location"))
+	}
+
+	req = httptest.NewRequest("GET", "/board", nil)
+	for _, c := range draftCookies {
+		req.AddCookie(c)
+	}
+	req.AddCookie(authCookie(t, key, "me@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	b := w.Body.String()
+	for _, want := range []string{`value="Bike lanes"`, "Please build bike lanes."} {
+		if !strings.Contains(b, want) {
+			t.Errorf("GET /board after signing in again: should see %q, got %s", want, b)
+		}
+	}
+}
+
+func TestDraftSavedForExpiredCSRFToken(t *testing.T) {
+	t.Parallel()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	// The form's token, whose cookie has since expired.
+	token := newCSRFToken()
+	expired := &http.Cookie{
+		Name:  csrfCookieName,
+		Value: mailer.secrets.CSRF.sealAt([]byte(csrfCookieName+"|"+token), time.Now().Add(-csrfMaxAge-time.Minute)),
+	}
+	vals := url.Values{
+		csrfFieldName: {token},
+		"group_id":    {"board"},
+		"subject":     {"Bike lanes"},
+		"body":        {"Please build bike lanes."},
+	}
+	// Some browsers don't send Origin on same origin requests.
+	for _, origin := range []string{"http://example.com", ""} {
+		req := postForm("/v1/send", vals, authCookie(t, key, "me@example.com"), origin)
+		req.AddCookie(expired)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != 302 || w.Header().Get("Location") != "/" {
+			t.Fatalf("POST /v1/send from %q with an expired token: got %d %q, want redirect to the form", origin, w.Code, w.Header().Get("Location"))
+		}
+		req = httptest.NewRequest("GET", "/", nil)
+		for _, c := range w.Result().Cookies() {
+			req.AddCookie(c)
+		}
+		if d := loadDraft(httptest.NewRecorder(), req, mailer.secrets.Draft, false); d == nil || d.Subject != "Bike lanes" {
+			t.Errorf("POST /v1/send from %q with an expired token: got draft %+v, want the submitted letter", origin, d)
+		}
+	}
+
+	// Nothing is saved for requests from other sites, which either send
+	// their own Origin or no token cookie.
+	req := postForm("/v1/send", vals, authCookie(t, key, "me@example.com"), "https://evil.example.com")
+	req.AddCookie(expired)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden || len(w.Result().Cookies()) != 0 {
+		t.Errorf("POST /v1/send from another site: got %d and %d cookies, want 403 and none", w.Code, len(w.Result().Cookies()))
+	}
+	req = postForm("/v1/send", vals, authCookie(t, key, "me@example.com"), "")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden || len(w.Result().Cookies()) != 0 {
+		t.Errorf("POST /v1/send without a token cookie: got %d and %d cookies, want 403 and none", w.Code, len(w.Result().Cookies()))
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+// The embeddable form at /embed/<group-id>, for partner sites to show in an
+// iframe with static/embed.js. Only the origins in Site.EmbedOrigins may frame
+// it.
+//
+// Cookies in a cross-site iframe are third-party cookies, so embedding only
+// works if the site is served over HTTPS with SameSite=None cookies (see
+// SameSite in csrf.go), and only in browsers that allow third-party cookies.
+// The embedded form always links to the full page as a fallback.
+
+import (
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kevinburke/rest"
+)
+
+// GET /embed/<id>
+var embedRx = regexp.MustCompile(fmt.Sprintf(`^/embed/(%s)$`, idRxPart))
+
+// signedInPath is where the sign in popup lands after authenticating with
+// Google.
+const signedInPath = "/auth/signed-in"
+
+type embedData struct {
+	layoutData
+	Group     *Group
+	Email     *mail.Address
+	Aliases   []*mail.Address
+	Error     string
+	Success   string
+	Subject   string
+	Body      string
+	From      string
+	AuthURL   string
+	CSRFToken string
+	// If the group has one recipient, their opening line.
+	OpeningLine string
+	// Set if visitors can sign in with Microsoft too.
+	MicrosoftAuthURL string
+	// Details the sender must fill in; see identity.go.
+	Identity []identityInput
+}
+
+// validEmbedOrigin checks that origin is a scheme and host, like
+// "https://example.org", suitable for a frame-ancestors directive.
+func validEmbedOrigin(origin string) error {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("embed origin %q should start with https://", origin)
+	}
+	if u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("embed origin %q should be a scheme and host, like https://example.org", origin)
+	}
+	return nil
+}
+
+// frameAncestors returns a Content-Security-Policy that lets this site and
+// origins frame a page.
+func frameAncestors(origins []string) string {
+	sources := []string{"'self'"}
+	for _, origin := range origins {
+		sources = append(sources, strings.TrimSuffix(origin, "/"))
+	}
+	return "frame-ancestors " + strings.Join(sources, " ")
+}
+
+// popupAuthURL returns a sign in URL, from authURL, that returns to
+// signedInPath, which closes the popup and reloads the embedded form.
+func popupAuthURL(authURL func(*http.Request) string, r *http.Request) string {
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.URL = &url.URL{Path: signedInPath}
+	return authURL(r2)
+}
+
+func renderEmbed(w http.ResponseWriter, r *http.Request, mailer *Mailer, site *Site, email *mail.Address, aliases []*mail.Address, authURL string) {
+	match := embedRx.FindStringSubmatch(r.URL.Path)
+	group, ok := mailer.Groups[match[1]]
+	if !ok || !group.VisibleTo(email) {
+		rest.NotFound(w, r)
+		return
+	}
+	saveLocale(w, r)
+	var openingLine string
+	if len(group.Recipients) == 1 {
+		openingLine = group.Recipients[0].OpeningLine
+	}
+	var token, microsoftURL string
+	if email != nil {
+		token = csrfToken(w, r, mailer.secrets.CSRF)
+	} else if authURL != "" && site.Microsoft != nil {
+		microsoftURL = popupAuthURL(site.Microsoft.URL, r)
+	}
+	vals := r.URL.Query()
+	subject, body, from := vals.Get("subject"), vals.Get("body"), ""
+	// A letter saved while the user signed in again; see draft.go.
+	if d := loadDraft(w, r, mailer.secrets.Draft, email != nil); d != nil && d.GroupID == group.ID {
+		subject, body, from = d.Subject, d.Body, d.From
+	}
+	allowFraming(w, site.EmbedOrigins)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	render(w, r, site.Theme.templates, "embed.html", &embedData{
+		layoutData:  newLayoutData(r, site, requestLocale(r, group.Locale)),
+		Group:       group,
+		Email:       email,
+		Aliases:     aliases,
+		Error:       GetFlashError(w, r, mailer.secrets.Flash),
+		Success:     GetFlashSuccess(w, r, mailer.secrets.Flash),
+		Subject:     subject,
+		Body:        body,
+		From:        from,
+		AuthURL:     authURL,
+		CSRFToken:   token,
+		OpeningLine: openingLine,
+
+		MicrosoftAuthURL: microsoftURL,
+		Identity:         mailer.identityInputs(savedIdentity(r, mailer.secrets.Identity)),
+	})
+}
+
+func renderSignedIn(w http.ResponseWriter, r *http.Request, site *Site) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	data := newLayoutData(r, site, requestLocale(r, ""))
+	render(w, r, site.Theme.templates, "signed-in.html", &data)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func embedMailer() *Mailer {
+	addr, _ := mail.ParseAddress("Recipient <recipient@example.com>")
+	return &Mailer{Groups: map[string]*Group{
+		"board": {ID: "board", Name: "Board of Supervisors", Recipients: []*Recipient{{Address: *addr, OpeningLine: "Dear Supervisor"}}},
+	}}
+}
+
+func TestEmbed(t *testing.T) {
+	t.Parallel()
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), embedMailer(), &Site{EmbedOrigins: []string{"https://partner.example.org"}})
+	req := httptest.NewRequest("GET", "/embed/board?subject=Bike+lanes", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("GET /embed/board: got code %d, want 200", w.Code)
+	}
+	if csp := w.Header().Get("Content-Security-Policy"); csp != "frame-ancestors 'self' https://partner.example.org" {
+		t.Errorf("GET /embed/board: got Content-Security-Policy %q", csp)
+	}
+	b := w.Body.String()
+	for _, want := range []string{"Board of Supervisors", `name="embed" value="1"`, `value="Bike lanes"`, "Dear Supervisor,"} {
+		if !strings.Contains(b, want) {
+			t.Errorf("GET /embed/board: should see %q, got %s", want, b)
+		}
+	}
+	if strings.Contains(b, "View the source code") {
+		t.Errorf("GET /embed/board: embedded form shouldn't have the site footer")
+	}
+
+	req = httptest.NewRequest("GET", "/embed/unknown", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 404 {
+		t.Errorf("GET /embed/unknown: got code %d, want 404", w.Code)
+	}
+}
+
+func TestEmbedSignIn(t *testing.T) {
+	t.Parallel()
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), embedMailer(), &Site{WithGoogle: true})
+	req := httptest.NewRequest("GET", "/embed/board", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("GET /embed/board: got code %d, want 200", w.Code)
+	}
+	if b := w.Body.String(); !strings.Contains(b, `id="auth-popup" href="https://accounts.google.com/`) {
+		t.Errorf("GET /embed/board: should see sign in link, got %s", b)
+	}
+
+	req = httptest.NewRequest("GET", signedInPath, nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 302 || !strings.HasPrefix(w.Header().Get("Location"), "https://accounts.google.com/") {
+		t.Errorf("GET %s: should redirect to Google when not signed in, got %d %q", signedInPath, w.Code, w.Header().Get("Location"))
+	}
+}
+
+func TestEmbedSendReturnsToEmbed(t *testing.T) {
+	t.Parallel()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	w := httptest.NewRecorder()
+	token := csrfToken(w, httptest.NewRequest("GET", "/", nil), mailer.secrets.CSRF)
+	req := postForm("/v1/send", url.Values{
+		csrfFieldName: {token},
+		"group_id":    {"board"},
+		"embed":       {"1"},
+	}, w.Result().Cookies()[0], "")
+	req.AddCookie(authCookie(t, key, "me@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/embed/board" {
+		t.Errorf("POST /v1/send from embed: want redirect to /embed/board, got %d %q", w.Code, w.Header().Get("Location"))
+	}
+}
+
+func TestValidEmbedOrigin(t *testing.T) {
+	t.Parallel()
+	for _, origin := range []string{"https://example.org", "https://example.org/", "http://localhost:8000"} {
+		if err := validEmbedOrigin(origin); err != nil {
+			t.Errorf("validEmbedOrigin(%q): %v", origin, err)
+		}
+	}
+	for _, origin := range []string{"example.org", "https://example.org/widget", "javascript:alert(1)", "*"} {
+		if err := validEmbedOrigin(origin); err == nil {
+			t.Errorf("validEmbedOrigin(%q): expected error, got nil", origin)
+		}
+	}
+}
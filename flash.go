@@ -12,39 +12,40 @@ import (
 // FlashSuccess encrypts msg and sets it as a cookie on w. Only one success
 // message can be set on w; the last call to FlashSuccess will be set on the
 // response.
-func FlashSuccess(w http.ResponseWriter, msg string, key *[32]byte) {
-	setCookie(w, msg, "flash-success", key)
+func FlashSuccess(w http.ResponseWriter, msg string, s *Sealer) {
+	setCookie(w, msg, "flash-success", s)
 }
 
 // FlashError encrypts msg and sets it as a cookie on w. Only one error can be
 // set on w; the last call to FlashError will be set on the response.
-func FlashError(w http.ResponseWriter, msg string, key *[32]byte) {
-	setCookie(w, msg, "flash-error", key)
+func FlashError(w http.ResponseWriter, msg string, s *Sealer) {
+	setCookie(w, msg, "flash-error", s)
 }
 
-func setCookie(w http.ResponseWriter, msg string, name string, key *[32]byte) {
+func setCookie(w http.ResponseWriter, msg string, name string, s *Sealer) {
 	c := &http.Cookie{
 		Name:     name,
 		Path:     "/",
-		Value:    opaque(name+"|"+msg, key),
+		Value:    s.seal([]byte(name + "|" + msg)),
 		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
 	}
 	http.SetCookie(w, c)
 }
 
 // GetFlashSuccess finds a flash success message in the request (if one exists).
 // If one exists then it's unset and returned.
-func GetFlashSuccess(w http.ResponseWriter, r *http.Request, key *[32]byte) string {
-	return getCookie(w, r, "flash-success", key, true)
+func GetFlashSuccess(w http.ResponseWriter, r *http.Request, s *Sealer) string {
+	return getCookie(w, r, "flash-success", s, true)
 }
 
 // GetFlashError finds a flash error in the request (if one exists). If one
 // exists then it's unset and returned.
-func GetFlashError(w http.ResponseWriter, r *http.Request, key *[32]byte) string {
-	return getCookie(w, r, "flash-error", key, true)
+func GetFlashError(w http.ResponseWriter, r *http.Request, s *Sealer) string {
+	return getCookie(w, r, "flash-error", s, true)
 }
 
-func getCookie(w http.ResponseWriter, r *http.Request, name string, key *[32]byte, clear bool) string {
+func getCookie(w http.ResponseWriter, r *http.Request, name string, s *Sealer, clear bool) string {
 	cookie, err := r.Cookie(name)
 	if err == http.ErrNoCookie {
 		return ""
@@ -52,10 +53,11 @@ func getCookie(w http.ResponseWriter, r *http.Request, name string, key *[32]byt
 	if clear {
 		clearCookie(w, name)
 	}
-	msg, err := unopaque(cookie.Value, key)
+	b, err := s.open(cookie.Value)
 	if err != nil {
 		return ""
 	}
+	msg := string(b)
 	if !strings.HasPrefix(msg, name+"|") {
 		clearCookie(w, name)
 		return ""
@@ -3,10 +3,14 @@ package main
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"html/template"
 	"net/http"
 	"net/mail"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/inconshreveable/log15"
@@ -15,7 +19,7 @@ import (
 	"github.com/kevinburke/rest"
 	"github.com/kevinburke/semaphore"
 	"github.com/russross/blackfriday"
-	"golang.org/x/sync/errgroup"
+	"golang.org/x/oauth2"
 	gmail "google.golang.org/api/gmail/v1"
 	"google.golang.org/api/googleapi"
 )
@@ -41,124 +45,426 @@ type Group struct {
 	ID string
 	// Appears in the UI to represent this group
 	Name string
+	// Rendered from the Markdown description in the config file.
+	Description template.HTML
+	// Groups with the same Category are listed together under a heading.
+	Category string
+	// Groups are listed by Order, then by ID.
+	Order int
+	// Unlisted groups can be reached at /<id>, but don't appear on the
+	// homepage.
+	Unlisted bool
+	// If non-zero, letters can't be sent to the group before OpensAt or
+	// after ClosesAt.
+	OpensAt  time.Time
+	ClosesAt time.Time
+	// Displayed in place of the send option once the group has closed.
+	ClosedMessage template.HTML
+	// Whether recipient addresses are shown, obfuscated or hidden on public
+	// pages; see recipients.go. Empty means they're shown.
+	AddressVisibility string
+	// The default language for the group's page; see i18n.go.
+	Locale string
+	// If non-nil, only these people can see the group or send to it; see
+	// access.go.
+	Senders *SenderList
+	// If non-nil, letters to the group are sent from this Workspace mailbox,
+	// instead of the sender's; see workspace.go.
+	SendAs *mail.Address
+	// If set, letters to the group are given this Gmail label; see labels.go.
+	GmailLabel string
 }
 
 type Mailer struct {
-	Groups    map[string]*Group
-	Logger    log.Logger
-	secretKey *[32]byte
+	Groups   map[string]*Group
+	Logger   log.Logger
+	secrets  *Secrets
+	jobs     *jobStore
+	inflight *sendTracker
+	// If non-nil, send as Workspace users with a service account.
+	delegation *Delegation
+	// Let users pick one of their Gmail send-as addresses; see aliases.go.
+	sendAsAliases bool
+	aliases       *aliasCache
+	// Details senders must give to sign their letters with; see identity.go.
+	identityFields []string
+	// Refreshes Google tokens for sends that outlast the request; see
+	// Account.detached.
+	googleOAuth *oauth2.Config
 }
 
-func (m *Mailer) sendMail(w http.ResponseWriter, r *http.Request, auth *google.Auth) {
-	// TODO check csrf
-	subject := strings.TrimSpace(r.FormValue("subject"))
-	body := strings.TrimSpace(r.FormValue("body"))
-	id := r.FormValue("group_id")
+// validateSend checks the subject, body and group ID submitted by a user and
+// returns the group to send to, or an error that's safe to show to the user,
+// in the given locale.
+func (m *Mailer) validateSend(locale, subject, body, id string, from *mail.Address) (*Group, *rest.Error) {
 	if subject == "" {
-		FlashError(w, "Please provide a subject", m.secretKey)
-		http.Redirect(w, r, "/", http.StatusFound)
-		return
+		return nil, &rest.Error{Title: translate(locale, "Please provide a subject"), ID: "missing_subject"}
 	}
 	if body == "" {
-		FlashError(w, "Please provide a message body", m.secretKey)
-		http.Redirect(w, r, "/", http.StatusFound)
-		return
+		return nil, &rest.Error{Title: translate(locale, "Please provide a message body"), ID: "missing_body"}
 	}
-	var group *Group
 	if id == "test" {
-		group = &Group{
+		return &Group{
+			ID: "test",
 			Recipients: []*Recipient{
-				{Address: *auth.Email, OpeningLine: "Hi test"},
+				{Address: *from, OpeningLine: translate(locale, "Hi test")},
 			},
+		}, nil
+	}
+	group, ok := m.Groups[id]
+	if !ok || !group.VisibleTo(from) {
+		return nil, &rest.Error{Title: translate(locale, "Unknown group %q", id), ID: "unknown_group"}
+	}
+	now := time.Now()
+	if group.upcomingAt(now) {
+		return nil, &rest.Error{
+			Title: translate(locale, "%s opens for letters on %s", group.Name, formatDeadlineIn(locale, group.OpensAt)),
+			ID:    "group_not_open",
 		}
-	} else {
-		var ok bool
-		if group, ok = m.Groups[id]; !ok {
-			rest.ServerError(w, r, fmt.Errorf("unknown group %s", id))
-			return
+	}
+	if group.closedAt(now) {
+		return nil, &rest.Error{
+			Title: translate(locale, "%s stopped accepting letters on %s", group.Name, formatDeadlineIn(locale, group.ClosesAt)),
+			ID:    "group_closed",
+		}
+	}
+	return group, nil
+}
+
+// newMessage personalizes the letter for a single recipient.
+func newMessage(from *mail.Address, to *Recipient, subject, body string, sig *Identity) *gophermail.Message {
+	line := strings.TrimSpace(to.OpeningLine)
+	// Some languages use a different comma, or a colon, after the greeting.
+	if !strings.HasSuffix(line, ",") && !strings.HasSuffix(line, "，") &&
+		!strings.HasSuffix(line, ":") && !strings.HasSuffix(line, "：") {
+		line = line + ","
+	}
+	html := line + "<br />" + string(blackfriday.MarkdownCommon([]byte(body))) + sig.signatureHTML()
+	return &gophermail.Message{
+		From:     *from,
+		To:       []mail.Address{to.Address},
+		Cc:       to.CC,
+		Subject:  subject,
+		Body:     line + "\n\n" + body + sig.signatureText(),
+		HTMLBody: html,
+	}
+}
+
+// SendResult is the outcome of sending a letter to a single recipient.
+type SendResult struct {
+	To  mail.Address
+	Err error
+}
+
+const (
+	providerGoogle    = "google"
+	providerMicrosoft = "microsoft"
+)
+
+// An Account is a signed in user, who letters are sent from.
+type Account struct {
+	Email *mail.Address
+	// Makes requests to the provider's API as the user.
+	Client *http.Client
+	// Who the user signed in with, providerGoogle or providerMicrosoft.
+	Provider string
+	// The Microsoft Graph endpoint to send through; see microsoft.go.
+	graphURL string
+	// Whether the service account sends for this user; see workspace.go.
+	delegated bool
+	// The Gmail send-as address the user picked, if it isn't Email; see
+	// aliases.go.
+	alias *mail.Address
+	// The details the user signs letters with, if the site asks for any;
+	// see identity.go.
+	identity *Identity
+	// Makes a Client like the one above, with a different context.
+	newClient func(ctx context.Context) *http.Client
+}
+
+// googleAccount returns the user signed in with Google. conf refreshes their
+// token when sending after the request is over, and may be nil.
+func googleAccount(auth *google.Auth, conf *oauth2.Config) *Account {
+	acct := &Account{Email: auth.Email, Client: auth.Client, Provider: providerGoogle}
+	if conf != nil {
+		acct.newClient = func(ctx context.Context) *http.Client {
+			return conf.Client(ctx, auth.Token)
 		}
 	}
-	srv, err := gmail.New(auth.Client)
+	return acct
+}
+
+// detached returns a copy of a whose Client isn't tied to the request a was
+// made for. Clients refresh expired tokens with the context they were made
+// with, which is cancelled once the request is over.
+func (a *Account) detached() *Account {
+	cp := *a
+	if a.newClient != nil {
+		cp.Client = a.newClient(context.Background())
+	}
+	return &cp
+}
+
+// A messageSender delivers a message from the signed in user.
+type messageSender interface {
+	sendMessage(ctx context.Context, msg *gophermail.Message) error
+}
+
+// gmailSender sends mail as the signed in user with the Gmail API.
+type gmailSender struct {
+	srv *gmail.Service
+	// The mailbox to send through. Gmail sets the From address to this
+	// unless it's one of the mailbox's send-as addresses.
+	userID string
+	logger log.Logger
+
+	// The name of the label to apply to sent messages, if any, and its ID,
+	// which is looked up when the first message is sent.
+	label     string
+	labelOnce sync.Once
+	labelID   string
+}
+
+func (g *gmailSender) sendMessage(ctx context.Context, msg *gophermail.Message) error {
+	raw, err := msg.Bytes()
 	if err != nil {
-		rest.ServerError(w, r, err)
-		return
+		return err
+	}
+	sent, err := g.srv.Users.Messages.Send(g.userID, &gmail.Message{
+		Raw: base64.URLEncoding.EncodeToString(raw),
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	g.applyLabel(ctx, sent.Id)
+	return nil
+}
+
+// sendFrom returns the address letters to group are sent from.
+func sendFrom(acct *Account, group *Group) *mail.Address {
+	if group.SendAs != nil {
+		return group.SendAs
+	}
+	if acct.alias != nil {
+		return acct.alias
+	}
+	return acct.Email
+}
+
+// newSender returns a sender for the mailbox letters to group are sent
+// through: the user's own, or the group's SendAs mailbox.
+func (m *Mailer) newSender(acct *Account, group *Group) (messageSender, error) {
+	mailbox := acct.Email
+	client := acct.Client
+	label := group.GmailLabel
+	switch {
+	case group.SendAs != nil || acct.delegated:
+		label = ""
+		if group.SendAs != nil {
+			mailbox = group.SendAs
+		}
+		if !m.delegation.covers(mailbox.Address) {
+			return nil, fmt.Errorf("can't send as %s without a service account for its domain", mailbox.Address)
+		}
+		client = m.delegation.client(mailbox.Address)
+	case acct.Provider == providerMicrosoft:
+		return &graphSender{client: acct.Client, url: acct.graphURL}, nil
+	}
+	srv, err := gmail.New(client)
+	if err != nil {
+		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	return &gmailSender{srv: srv, userID: mailbox.Address, logger: m.Logger, label: label}, nil
+}
+
+// statusCode returns the HTTP status of an error from the Gmail or Graph API,
+// or 0 if it isn't one.
+func statusCode(err error) int {
+	switch terr := err.(type) {
+	case *googleapi.Error:
+		return terr.Code
+	case *graphError:
+		return terr.StatusCode
+	}
+	return 0
+}
+
+// send delivers a personalized copy of the letter to every recipient in group.
+// A failure to reach one recipient doesn't stop delivery to the others; the
+// returned results are in the same order as group.Recipients.
+func (m *Mailer) send(ctx context.Context, acct *Account, group *Group, subject, body string) []*SendResult {
+	results := make([]*SendResult, len(group.Recipients))
+	from := sendFrom(acct, group)
+	srv, err := m.newSender(acct, group)
+	if err != nil {
+		for i, recipient := range group.Recipients {
+			results[i] = &SendResult{To: recipient.Address, Err: err}
+		}
+		return results
+	}
+	ctx, inflight := m.inflight.start(ctx, acct.Email, group)
+	defer m.inflight.finish(inflight)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
-	eg, errctx := errgroup.WithContext(ctx)
-	for _, recipient := range group.Recipients {
-		body := body
-		to := *recipient
-		eg.Go(func() error {
-			line := strings.TrimSpace(to.OpeningLine)
-			if !strings.HasSuffix(line, ",") {
-				line = line + ","
-			}
-			html := line + "<br />" + string(blackfriday.MarkdownCommon([]byte(body)))
-			body := line + "\n\n" + body
-			msg := &gophermail.Message{
-				From:     *auth.Email,
-				To:       []mail.Address{to.Address},
-				Cc:       to.CC,
-				Subject:  subject,
-				Body:     body,
-				HTMLBody: string(html),
-			}
-			raw, err := msg.Bytes()
+	var wg sync.WaitGroup
+	for i, recipient := range group.Recipients {
+		wg.Add(1)
+		go func(i int, to *Recipient) {
+			defer wg.Done()
+			start := time.Now()
+			err := m.sendOne(ctx, srv, from, to, subject, body, acct.identity)
+			outcome := resultSent
 			if err != nil {
-				return err
-			}
-			for i := 0; i < 3; i++ {
-				call := srv.Users.Messages.Send(auth.Email.Address, &gmail.Message{
-					Raw: base64.URLEncoding.EncodeToString(raw),
-				})
-				call = call.Context(errctx)
-				sema.Acquire()
-				_, doErr := call.Do()
-				sema.Release()
-				if doErr == nil {
-					m.Logger.Info("Successfully sent message", "from", auth.Email.String(), "to", to.Address.String())
-					return nil
-				}
-				if doErr == context.Canceled {
-					return doErr
-				}
-				switch terr := doErr.(type) {
-				case *googleapi.Error:
-					switch terr.Code {
-					case 429, 500:
-						// TODO figure out whether this actually sends the
-						// message
-						dur := time.Duration(i+1) * 2 * time.Second
-						m.Logger.Info("got retryable error", "err", terr, "code", terr.Code, "sleep_dur", dur)
-						time.Sleep(dur)
-						continue
-					default:
-						// We failed to send a message; it happens. Shouldn't block
-						// sending of other emails, so log and return nil.
-						m.Logger.Error("Error sending message", "from", auth.Email.String(),
-							"to", to.Address.String(), "err", fmt.Sprintf("%#v", terr))
-						return terr
-					}
-				default:
-					// We failed to send a message; it happens. Shouldn't block
-					// sending of other emails, so log and return nil.
-					m.Logger.Error("Error sending message", "from", auth.Email.String(),
-						"to", to.Address.String(), "err", fmt.Sprintf("%#v", terr))
-					return terr
-				}
+				outcome = resultFailed
 			}
+			sendDurationSeconds.observeSince(start, outcome)
+			lettersTotal.inc(groupLabel(group), outcome)
+			results[i] = &SendResult{To: to.Address, Err: err}
+			m.inflight.record(inflight, i, results[i])
+		}(i, recipient)
+	}
+	wg.Wait()
+	return results
+}
+
+func (m *Mailer) sendOne(ctx context.Context, srv messageSender, from *mail.Address, to *Recipient, subject, body string, sig *Identity) error {
+	msg := newMessage(from, to, subject, body, sig)
+	retries := gmailRetriesTotal
+	if _, ok := srv.(*graphSender); ok {
+		retries = graphRetriesTotal
+	}
+	for i := 0; i < 3; i++ {
+		waitStart := time.Now()
+		sema.Acquire()
+		semaphoreWaitSeconds.observeSince(waitStart)
+		doErr := srv.sendMessage(ctx, msg)
+		sema.Release()
+		if doErr == nil {
+			m.Logger.Info("Successfully sent message", "from", from.String(), "to", to.Address.String())
 			return nil
-		})
+		}
+		if doErr == context.Canceled {
+			return doErr
+		}
+		switch code := statusCode(doErr); code {
+		case 429, 500, 503:
+			// TODO figure out whether this actually sends the
+			// message
+			dur := time.Duration(i+1) * 2 * time.Second
+			m.Logger.Info("got retryable error", "err", doErr, "code", code, "sleep_dur", dur)
+			retries.inc(strconv.Itoa(code))
+			select {
+			case <-time.After(dur):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		default:
+			// We failed to send a message; it happens. Shouldn't block
+			// sending of other emails, so log and return the error.
+			m.Logger.Error("Error sending message", "from", from.String(),
+				"to", to.Address.String(), "err", fmt.Sprintf("%#v", doErr))
+			return doErr
+		}
+	}
+	return errTooManyRetries
+}
+
+var errTooManyRetries = errors.New("gave up sending message after 3 attempts")
+
+// formPage returns the language to reply to a letter submitted in r in, and
+// the page to send the user back to afterwards.
+func (m *Mailer) formPage(r *http.Request) (locale, next string) {
+	id := r.FormValue("group_id")
+	var groupLocale string
+	if group, ok := m.Groups[id]; ok {
+		groupLocale = group.Locale
 	}
-	if err := eg.Wait(); err != nil {
-		rest.ServerError(w, r, err)
+	// Forms in the embedded widget return to the widget, and unlisted
+	// groups to their own page, since the homepage doesn't show them.
+	next = "/"
+	if r.FormValue("embed") != "" && validID(id) {
+		next = "/embed/" + id
+	} else if group, ok := m.Groups[id]; ok && group.Unlisted {
+		next = "/" + id
+	}
+	return requestLocale(r, groupLocale), next
+}
+
+func (m *Mailer) sendMail(w http.ResponseWriter, r *http.Request, auth *Account) {
+	subject := strings.TrimSpace(r.FormValue("subject"))
+	body := strings.TrimSpace(r.FormValue("body"))
+	id := r.FormValue("group_id")
+	locale, next := m.formPage(r)
+	group, verr := m.validateSend(locale, subject, body, id, auth.Email)
+	if verr != nil {
+		FlashError(w, verr.Title, m.secrets.Flash)
+		http.Redirect(w, r, next, http.StatusFound)
 		return
 	}
-	var word = "message"
-	if len(group.Recipients) > 1 {
-		word = "messages"
+	if len(m.identityFields) > 0 {
+		identity := identityFromForm(r)
+		if verr := m.validateIdentity(locale, identity); verr != nil {
+			FlashError(w, verr.Title, m.secrets.Flash)
+			http.Redirect(w, r, next, http.StatusFound)
+			return
+		}
+		saveIdentity(w, identity, m.secrets.Identity)
+		auth.identity = identity
+	}
+	if from := r.FormValue("from"); from != "" && !strings.EqualFold(from, auth.Email.Address) {
+		alias, err := m.alias(r.Context(), auth, from)
+		if err == errNotAlias {
+			FlashError(w, translate(locale, "You can't send from %s", from), m.secrets.Flash)
+			http.Redirect(w, r, next, http.StatusFound)
+			return
+		}
+		if err != nil {
+			rest.ServerError(w, r, err)
+			return
+		}
+		auth.alias = alias
+	}
+	results := m.send(r.Context(), auth, group, subject, body)
+	var failed []string
+	var firstErr error
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result.To.String())
+			if firstErr == nil {
+				firstErr = result.Err
+			}
+		}
+	}
+	if len(failed) == len(results) && firstErr != nil {
+		// The user's token was revoked, or expired and couldn't be
+		// refreshed. Re-authenticating won't help if we sent with the
+		// service account.
+		if isAuthError(firstErr) && group.SendAs == nil && !auth.delegated {
+			m.Logger.Info("Sign in expired while sending", "email", auth.Email.Address, "err", firstErr)
+			if auth.Provider == providerMicrosoft {
+				clearCookie(w, microsoftCookieName)
+			} else {
+				clearCookie(w, authCookieName)
+			}
+			m.reauthenticate(w, r, locale, next)
+			return
+		}
+		rest.ServerError(w, r, firstErr)
+		return
+	}
+	sent := len(results) - len(failed)
+	if len(failed) > 0 {
+		FlashError(w, translatePlural(locale,
+			"Sent %d message, but could not send to %s. Please try again later",
+			"Sent %d messages, but could not send to %s. Please try again later",
+			sent, strings.Join(failed, ", ")), m.secrets.Flash)
+		http.Redirect(w, r, next, http.StatusFound)
+		return
 	}
-	FlashSuccess(w, fmt.Sprintf("Sent %d %s. They will appear in your Sent folder shortly", len(group.Recipients), word), m.secretKey)
-	http.Redirect(w, r, "/", http.StatusFound)
+	FlashSuccess(w, translatePlural(locale,
+		"Sent %d message. It will appear in your Sent folder shortly",
+		"Sent %d messages. They will appear in your Sent folder shortly",
+		sent), m.secrets.Flash)
+	http.Redirect(w, r, next, http.StatusFound)
 }
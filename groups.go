@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"time"
+)
+
+// A GroupCategory is a set of groups listed together on the homepage under a
+// common heading. Groups without a category have an empty Name.
+type GroupCategory struct {
+	Name   string
+	Groups []*Group
+}
+
+// sortGroups returns the groups in the order they should be displayed: by
+// Order, then by ID.
+func sortGroups(groups map[string]*Group) []*Group {
+	sorted := make([]*Group, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Order != sorted[j].Order {
+			return sorted[i].Order < sorted[j].Order
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// listedGroups returns the groups that should appear on the homepage for
+// viewer, in display order. viewer is nil if they aren't signed in.
+func listedGroups(groups map[string]*Group, viewer *mail.Address) []*Group {
+	listed := make([]*Group, 0, len(groups))
+	for _, group := range sortGroups(groups) {
+		if group.Unlisted || !group.VisibleTo(viewer) {
+			continue
+		}
+		listed = append(listed, group)
+	}
+	return listed
+}
+
+// splitArchived separates groups that have closed from the groups that are
+// open or upcoming, preserving their order.
+func splitArchived(groups []*Group, now time.Time) (active []*Group, archived []*Group) {
+	for _, group := range groups {
+		if group.closedAt(now) {
+			archived = append(archived, group)
+		} else {
+			active = append(active, group)
+		}
+	}
+	return active, archived
+}
+
+// categorize splits groups (which should already be sorted) into categories.
+// Categories appear in the order of the first group that belongs to them.
+func categorize(groups []*Group) []*GroupCategory {
+	var categories []*GroupCategory
+	byName := make(map[string]*GroupCategory)
+	for _, group := range groups {
+		cat, ok := byName[group.Category]
+		if !ok {
+			cat = &GroupCategory{Name: group.Category}
+			byName[group.Category] = cat
+			categories = append(categories, cat)
+		}
+		cat.Groups = append(cat.Groups, group)
+	}
+	return categories
+}
+
+// Layouts accepted for opens_at and closes_at in the config file, in addition
+// to RFC 3339. Times without an offset are interpreted in the group's
+// time_zone.
+var groupTimeLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseGroupTime parses a timestamp from the config file. An empty string
+// returns the zero time.
+func parseGroupTime(val string, loc *time.Location) (time.Time, error) {
+	if val == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, val); err == nil {
+		return t.In(loc), nil
+	}
+	for _, layout := range groupTimeLayouts {
+		if t, err := time.ParseInLocation(layout, val, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("could not parse time %q; use a format like \"2006-01-02 15:04\"", val)
+}
+
+// deadlineFormat is used to display opening and closing times.
+const deadlineFormat = "Monday, January 2 at 3:04pm MST"
+
+func formatDeadline(t time.Time) string {
+	return t.Format(deadlineFormat)
+}
+
+// Upcoming reports whether the group has an opening time in the future.
+func (g *Group) Upcoming() bool {
+	return g.upcomingAt(time.Now())
+}
+
+// Closed reports whether the group has a closing time in the past.
+func (g *Group) Closed() bool {
+	return g.closedAt(time.Now())
+}
+
+func (g *Group) upcomingAt(now time.Time) bool {
+	return !g.OpensAt.IsZero() && now.Before(g.OpensAt)
+}
+
+func (g *Group) closedAt(now time.Time) bool {
+	return !g.ClosesAt.IsZero() && !now.Before(g.ClosesAt)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseGroupTime(t *testing.T) {
+	t.Parallel()
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skip("no time zone database:", err)
+	}
+	want := time.Date(2018, 4, 3, 17, 0, 0, 0, la)
+	for _, in := range []string{"2018-04-03T17:00:00-07:00", "2018-04-04T00:00:00Z", "2018-04-03 17:00", "2018-04-03 17:00:00"} {
+		got, err := parseGroupTime(in, la)
+		if err != nil {
+			t.Errorf("parseGroupTime(%q): %v", in, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseGroupTime(%q): got %v, want %v", in, got, want)
+		}
+	}
+	if _, err := parseGroupTime("next tuesday", la); err == nil {
+		t.Errorf("parseGroupTime: expected error for invalid input, got nil")
+	}
+}
+
+func TestGroupWindow(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2018, 4, 3, 12, 0, 0, 0, time.UTC)
+	g := &Group{OpensAt: now.Add(time.Hour), ClosesAt: now.Add(2 * time.Hour)}
+	if !g.upcomingAt(now) || g.closedAt(now) {
+		t.Errorf("group should be upcoming and not closed before it opens")
+	}
+	if g.upcomingAt(now.Add(90*time.Minute)) || g.closedAt(now.Add(90*time.Minute)) {
+		t.Errorf("group should be open between OpensAt and ClosesAt")
+	}
+	if !g.closedAt(now.Add(2 * time.Hour)) {
+		t.Errorf("group should be closed at ClosesAt")
+	}
+	if (&Group{}).closedAt(now) {
+		t.Errorf("group without a window should never close")
+	}
+}
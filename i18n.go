@@ -0,0 +1,195 @@
+package main
+
+// Translations for the web UI, flash messages and the boilerplate we add to
+// outgoing letters. The English text in the templates and the code is the key;
+// each file in locales/ maps those keys to a translation. Missing keys fall
+// back to English.
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/multi-emailer/assets"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// defaultLocale is the language the templates and code are written in.
+const defaultLocale = "en"
+
+// The query parameter and cookie used to pick a language explicitly.
+const (
+	localeParam      = "lang"
+	localeCookieName = "lang"
+)
+
+// localeNameKey is the catalog entry holding the language's own name for
+// itself, e.g. "Español".
+const localeNameKey = "_name"
+
+// A catalog maps English text to its translation in a single locale.
+type catalog map[string]string
+
+var catalogs = map[string]catalog{
+	defaultLocale: {localeNameKey: "English"},
+}
+
+func init() {
+	for _, name := range assets.AssetNames() {
+		if !strings.HasPrefix(name, "locales/") || path.Ext(name) != ".yml" {
+			continue
+		}
+		c := make(catalog)
+		if err := yaml.Unmarshal(assets.MustAsset(name), &c); err != nil {
+			panic(fmt.Sprintf("could not parse %s: %v", name, err))
+		}
+		catalogs[strings.TrimSuffix(path.Base(name), ".yml")] = c
+	}
+}
+
+// A Language is an entry in the language switcher.
+type Language struct {
+	Code string
+	Name string
+}
+
+// supportedLanguages returns every language we have a catalog for, sorted by
+// code.
+func supportedLanguages() []Language {
+	langs := make([]Language, 0, len(catalogs))
+	for code, c := range catalogs {
+		langs = append(langs, Language{Code: code, Name: c[localeNameKey]})
+	}
+	sort.Slice(langs, func(i, j int) bool { return langs[i].Code < langs[j].Code })
+	return langs
+}
+
+// matchLocale returns the supported locale for a language tag like "es-MX",
+// or the empty string if we don't support it.
+func matchLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if _, ok := catalogs[tag]; ok {
+		return tag
+	}
+	if i := strings.IndexAny(tag, "-_"); i > 0 {
+		if _, ok := catalogs[tag[:i]]; ok {
+			return tag[:i]
+		}
+	}
+	return ""
+}
+
+// parseAcceptLanguage returns the best supported locale from an
+// Accept-Language header, or the empty string if none match.
+func parseAcceptLanguage(header string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, q := part, 1.0
+		if i := strings.IndexByte(part, ';'); i >= 0 {
+			tag = part[:i]
+			param := strings.TrimSpace(part[i+1:])
+			if strings.HasPrefix(param, "q=") {
+				f, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil {
+					continue
+				}
+				q = f
+			}
+		}
+		if locale := matchLocale(tag); locale != "" && q > bestQ {
+			best, bestQ = locale, q
+		}
+	}
+	return best
+}
+
+// requestLocale picks the language for a response. A language chosen with
+// ?lang= or saved in a cookie wins, then the group's default language (if
+// the page is for a single group), then the browser's Accept-Language header.
+func requestLocale(r *http.Request, groupLocale string) string {
+	if locale := matchLocale(r.URL.Query().Get(localeParam)); locale != "" {
+		return locale
+	}
+	if cookie, err := r.Cookie(localeCookieName); err == nil {
+		if locale := matchLocale(cookie.Value); locale != "" {
+			return locale
+		}
+	}
+	if groupLocale != "" {
+		return groupLocale
+	}
+	if locale := parseAcceptLanguage(r.Header.Get("Accept-Language")); locale != "" {
+		return locale
+	}
+	return defaultLocale
+}
+
+// saveLocale remembers a language chosen with ?lang= for future requests.
+func saveLocale(w http.ResponseWriter, r *http.Request) {
+	locale := matchLocale(r.URL.Query().Get(localeParam))
+	if locale == "" {
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     localeCookieName,
+		Value:    locale,
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// translate returns the translation of key in locale, formatted with args.
+func translate(locale, key string, args ...interface{}) string {
+	msg := key
+	if c, ok := catalogs[locale]; ok {
+		if translated, ok := c[key]; ok && translated != "" {
+			msg = translated
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// translatePlural is like translate, but chooses between the singular and
+// plural key based on n, which is passed as the first format argument.
+func translatePlural(locale, one, other string, n int, args ...interface{}) string {
+	key := other
+	if n == 1 {
+		key = one
+	}
+	return translate(locale, key, append([]interface{}{n}, args...)...)
+}
+
+// translateHTML is like translate, for keys that contain trusted HTML. Args
+// are escaped.
+func translateHTML(locale, key string, args ...interface{}) template.HTML {
+	escaped := make([]interface{}, len(args))
+	for i := range args {
+		escaped[i] = template.HTMLEscapeString(fmt.Sprint(args[i]))
+	}
+	return template.HTML(translate(locale, key, escaped...))
+}
+
+var weekdays = [...]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// formatDeadlineIn formats an opening or closing time for display in locale.
+func formatDeadlineIn(locale string, t time.Time) string {
+	if locale == defaultLocale {
+		return formatDeadline(t)
+	}
+	return translate(locale, "%[1]s, %[2]s %[3]d at %[4]s",
+		translate(locale, weekdays[t.Weekday()]),
+		translate(locale, t.Month().String()),
+		t.Day(),
+		t.Format(translate(locale, "3:04pm MST")),
+	)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+var acceptLanguageTests = []struct {
+	header string
+	want   string
+}{
+	{"", ""},
+	{"es", "es"},
+	{"es-MX,es;q=0.9,en;q=0.8", "es"},
+	{"en-US,en;q=0.9,es;q=0.8", "en"},
+	{"fr-FR,fr;q=0.9,zh-CN;q=0.5", "zh"},
+	{"fr-FR,de;q=0.5", ""},
+	{"es;q=0.2, zh_TW", "zh"},
+	{"es;q=bogus", ""},
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Parallel()
+	for _, tt := range acceptLanguageTests {
+		if got := parseAcceptLanguage(tt.header); got != tt.want {
+			t.Errorf("parseAcceptLanguage(%q): got %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestCatalogsHaveNames(t *testing.T) {
+	t.Parallel()
+	for _, lang := range supportedLanguages() {
+		if lang.Name == "" {
+			t.Errorf("locale %q: missing %q entry", lang.Code, localeNameKey)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	t.Parallel()
+	if got := translate("es", "Subject"); got != "Asunto" {
+		t.Errorf("translate(es, Subject): got %q", got)
+	}
+	if got := translate("es", "Not in the catalog %d", 3); got != "Not in the catalog 3" {
+		t.Errorf("translate: missing keys should fall back to English, got %q", got)
+	}
+	if got := translatePlural("en", "(%d recipient)", "(%d recipients)", 1); got != "(1 recipient)" {
+		t.Errorf("translatePlural: got %q", got)
+	}
+	if got := translateHTML("en", "Sending messages from <b>%s</b>. The messages will appear like personalized emails from your GMail account.", "<script>"); strings.Contains(string(got), "<script>") {
+		t.Errorf("translateHTML: arguments should be escaped, got %q", got)
+	}
+	d := time.Date(2018, 4, 3, 17, 0, 0, 0, time.UTC)
+	if got := formatDeadlineIn("es", d); got != "martes 3 de abril a las 17:00 UTC" {
+		t.Errorf("formatDeadlineIn(es): got %q", got)
+	}
+}
+
+func TestHomepageLanguage(t *testing.T) {
+	t.Parallel()
+	addr, _ := mail.ParseAddress("Recipient <recipient@example.com>")
+	recipients := []*Recipient{{Address: *addr, OpeningLine: "Dear Test"}}
+	mailer := &Mailer{Groups: map[string]*Group{
+		"board":   {ID: "board", Name: "Board", Recipients: recipients},
+		"consejo": {ID: "consejo", Name: "Consejo", Recipients: recipients, Locale: "es"},
+	}}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), mailer, nil)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Language", "es-MX,es;q=0.9,en;q=0.8")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if b := w.Body.String(); !strings.Contains(b, `<html lang="es">`) || !strings.Contains(b, "Enviarte un mensaje de prueba") {
+		t.Errorf("GET / with Accept-Language: es: want Spanish page, got %s", b)
+	}
+
+	req = httptest.NewRequest("GET", "/consejo", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if b := w.Body.String(); !strings.Contains(b, "Enviarte un mensaje de prueba") {
+		t.Errorf("GET /consejo: want the group's language, got %s", b)
+	}
+
+	req = httptest.NewRequest("GET", "/consejo?lang=en", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if b := w.Body.String(); !strings.Contains(b, "Send a test message to yourself") {
+		t.Errorf("GET /consejo?lang=en: want English page, got %s", b)
+	}
+	var cookie *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == localeCookieName {
+			cookie = c
+		}
+	}
+	if cookie == nil || cookie.Value != "en" {
+		t.Fatalf("GET /consejo?lang=en: want language cookie, got %v", cookie)
+	}
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Language", "es")
+	req.AddCookie(cookie)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if b := w.Body.String(); !strings.Contains(b, `<html lang="en">`) {
+		t.Errorf("GET / with language cookie: want English page, got %s", b)
+	}
+}
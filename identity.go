@@ -0,0 +1,244 @@
+package main
+
+// Sender details, like a name and street address, that letters are signed
+// with. Officials' offices often discard letters that don't say who sent them
+// or where they live. The site picks which details are required with
+// sender_fields; they're remembered in an encrypted cookie, so returning
+// users don't have to type them again.
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/rest"
+)
+
+const (
+	fieldName    = "name"
+	fieldAddress = "address"
+	fieldZIP     = "zip"
+	fieldPhone   = "phone"
+)
+
+// identityFields are the details we know how to collect, in the order they
+// appear in the form and the signature.
+var identityFields = []string{fieldName, fieldAddress, fieldZIP, fieldPhone}
+
+// identityCookieName holds a user's details between visits.
+const identityCookieName = "sender"
+
+// An Identity is the sender's details. Fields the site doesn't ask for are
+// empty.
+type Identity struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address,omitempty"`
+	ZIP     string `json:"zip,omitempty"`
+	Phone   string `json:"phone,omitempty"`
+}
+
+func (id *Identity) get(field string) string {
+	if id == nil {
+		return ""
+	}
+	switch field {
+	case fieldName:
+		return id.Name
+	case fieldAddress:
+		return id.Address
+	case fieldZIP:
+		return id.ZIP
+	case fieldPhone:
+		return id.Phone
+	}
+	return ""
+}
+
+func (id *Identity) set(field, v string) {
+	switch field {
+	case fieldName:
+		id.Name = v
+	case fieldAddress:
+		id.Address = v
+	case fieldZIP:
+		id.ZIP = v
+	case fieldPhone:
+		id.Phone = v
+	}
+}
+
+// lines returns the details to sign a letter with, in order.
+func (id *Identity) lines() []string {
+	var lines []string
+	for _, field := range identityFields {
+		if v := id.get(field); v != "" {
+			lines = append(lines, v)
+		}
+	}
+	return lines
+}
+
+// signatureText returns id as a block to add to the end of a plain text
+// letter, or "" if there's nothing to sign with.
+func (id *Identity) signatureText() string {
+	lines := id.lines()
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n\n" + strings.Join(lines, "\n")
+}
+
+// signatureHTML is like signatureText, for the HTML part.
+func (id *Identity) signatureHTML() string {
+	lines := id.lines()
+	if len(lines) == 0 {
+		return ""
+	}
+	for i := range lines {
+		lines[i] = html.EscapeString(lines[i])
+	}
+	return "<p>" + strings.Join(lines, "<br />") + "</p>"
+}
+
+// validIdentityFields checks the sender_fields setting.
+func validIdentityFields(fields []string) error {
+	for _, field := range fields {
+		if !containsFold(identityFields, field) {
+			return fmt.Errorf("unknown sender field %q, use one of %s", field, strings.Join(identityFields, ", "))
+		}
+	}
+	return nil
+}
+
+// identityFromForm returns the details submitted in r.
+func identityFromForm(r *http.Request) *Identity {
+	return &Identity{
+		Name:    strings.TrimSpace(r.FormValue("sender_name")),
+		Address: strings.TrimSpace(r.FormValue("sender_address")),
+		ZIP:     strings.TrimSpace(r.FormValue("sender_zip")),
+		Phone:   strings.TrimSpace(r.FormValue("sender_phone")),
+	}
+}
+
+var zipRx = regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)
+
+// maxIdentityLength is the longest any one detail can be.
+const maxIdentityLength = 200
+
+// validateIdentity checks that id has every field the site requires, and
+// returns an error that's safe to show to the user, in the given locale.
+// Fields the site doesn't ask for are dropped from id.
+func (m *Mailer) validateIdentity(locale string, id *Identity) *rest.Error {
+	required := func(field string) bool {
+		return containsFold(m.identityFields, field)
+	}
+	// Only keep what the site asks for, on one line each.
+	kept := new(Identity)
+	for _, field := range m.identityFields {
+		kept.set(field, strings.Join(strings.Fields(id.get(field)), " "))
+	}
+	*id = *kept
+	missing := map[string]string{
+		fieldName:    "Please provide your full name",
+		fieldAddress: "Please provide your street address",
+		fieldZIP:     "Please provide your ZIP code",
+		fieldPhone:   "Please provide your phone number",
+	}
+	for _, field := range identityFields {
+		if !required(field) {
+			continue
+		}
+		v := id.get(field)
+		if v == "" {
+			return &rest.Error{Title: translate(locale, missing[field]), ID: "missing_sender_" + field}
+		}
+		if len(v) > maxIdentityLength {
+			return &rest.Error{Title: translate(locale, "Your details are too long"), ID: "invalid_sender_" + field}
+		}
+	}
+	if required(fieldZIP) && !zipRx.MatchString(id.ZIP) {
+		return &rest.Error{Title: translate(locale, "Please provide a 5 digit ZIP code"), ID: "invalid_sender_zip"}
+	}
+	if required(fieldPhone) {
+		digits := 0
+		for _, c := range id.Phone {
+			if c >= '0' && c <= '9' {
+				digits++
+			}
+		}
+		if digits < 10 || digits > 15 {
+			return &rest.Error{Title: translate(locale, "Please provide a phone number with area code"), ID: "invalid_sender_phone"}
+		}
+	}
+	return nil
+}
+
+// saveIdentity remembers id in a cookie on w, for the user's next visit.
+func saveIdentity(w http.ResponseWriter, id *Identity, s *Sealer) {
+	b, err := json.Marshal(id)
+	if err != nil {
+		panic(err)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     identityCookieName,
+		Path:     "/",
+		Value:    s.seal(b),
+		MaxAge:   int(identityMaxAge / time.Second),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// savedIdentity returns the details remembered in r, or nil.
+func savedIdentity(r *http.Request, s *Sealer) *Identity {
+	cookie, err := r.Cookie(identityCookieName)
+	if err != nil {
+		return nil
+	}
+	b, err := s.open(cookie.Value)
+	if err != nil {
+		return nil
+	}
+	id := new(Identity)
+	if err := json.Unmarshal(b, id); err != nil {
+		return nil
+	}
+	return id
+}
+
+// An identityInput is a field in the form.
+type identityInput struct {
+	Name         string
+	Label        string
+	Type         string
+	Autocomplete string
+	Value        string
+}
+
+// identityInputs returns the fields to show in the form, filled in with
+// saved.
+func (m *Mailer) identityInputs(saved *Identity) []identityInput {
+	var inputs []identityInput
+	for _, field := range identityFields {
+		if !containsFold(m.identityFields, field) {
+			continue
+		}
+		input := identityInput{Name: "sender_" + field, Type: "text", Value: saved.get(field)}
+		switch field {
+		case fieldName:
+			input.Label, input.Autocomplete = "Full name", "name"
+		case fieldAddress:
+			input.Label, input.Autocomplete = "Street address", "street-address"
+		case fieldZIP:
+			input.Label, input.Autocomplete = "ZIP code", "postal-code"
+		case fieldPhone:
+			input.Label, input.Autocomplete, input.Type = "Phone number", "tel", "tel"
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+var validateIdentityTests = []struct {
+	name   string
+	id     Identity
+	wantID string
+}{
+	{"valid", Identity{Name: "Jane Doe", Address: "123 Main St", ZIP: "94110-1234"}, ""},
+	{"missing name", Identity{Address: "123 Main St", ZIP: "94110"}, "missing_sender_name"},
+	{"blank address", Identity{Name: "Jane Doe", Address: "  ", ZIP: "94110"}, "missing_sender_address"},
+	{"bad zip", Identity{Name: "Jane Doe", Address: "123 Main St", ZIP: "9411"}, "invalid_sender_zip"},
+	{"too long", Identity{Name: strings.Repeat("J", maxIdentityLength+1), Address: "123 Main St", ZIP: "94110"}, "invalid_sender_name"},
+}
+
+func TestValidateIdentity(t *testing.T) {
+	t.Parallel()
+	m := &Mailer{identityFields: []string{fieldName, fieldAddress, fieldZIP}}
+	for _, tt := range validateIdentityTests {
+		id := tt.id
+		verr := m.validateIdentity("en", &id)
+		if tt.wantID == "" && verr != nil {
+			t.Errorf("%s: got error %v", tt.name, verr)
+		}
+		if tt.wantID != "" && (verr == nil || verr.ID != tt.wantID) {
+			t.Errorf("%s: got error %v, want %s", tt.name, verr, tt.wantID)
+		}
+	}
+
+	id := &Identity{Name: "Jane\n Doe", Address: "123 Main St", ZIP: "94110", Phone: "415-555-0100"}
+	if verr := m.validateIdentity("en", id); verr != nil {
+		t.Fatal(verr)
+	}
+	if id.Name != "Jane Doe" || id.Phone != "" {
+		t.Errorf("validateIdentity: got %+v, want name on one line and phone dropped", id)
+	}
+
+	m.identityFields = []string{fieldPhone}
+	if verr := m.validateIdentity("en", &Identity{Phone: "555-0100"}); verr == nil || verr.ID != "invalid_sender_phone" {
+		t.Errorf("phone without area code: got %v, want invalid_sender_phone", verr)
+	}
+	if verr := m.validateIdentity("en", &Identity{Phone: "(415) 555-0100"}); verr != nil {
+		t.Errorf("phone with area code: got %v", verr)
+	}
+}
+
+func TestSignature(t *testing.T) {
+	t.Parallel()
+	from := &mail.Address{Address: "jane@example.com"}
+	to := &Recipient{Address: mail.Address{Address: "supervisor@example.com"}, OpeningLine: "Dear Supervisor"}
+	sig := &Identity{Name: "Jane <Doe>", Address: "123 Main St", ZIP: "94110"}
+	msg := newMessage(from, to, "Bike lanes", "Please build bike lanes.", sig)
+	if !strings.HasSuffix(msg.Body, "Please build bike lanes.\n\nJane <Doe>\n123 Main St\n94110") {
+		t.Errorf("text part: got %q, want signature at the end", msg.Body)
+	}
+	if !strings.HasSuffix(msg.HTMLBody, "<p>Jane &lt;Doe&gt;<br />123 Main St<br />94110</p>") {
+		t.Errorf("HTML part: got %q, want escaped signature at the end", msg.HTMLBody)
+	}
+	if msg := newMessage(from, to, "Bike lanes", "Please build bike lanes.", nil); !strings.HasSuffix(msg.Body, "Please build bike lanes.") {
+		t.Errorf("without a signature: got %q", msg.Body)
+	}
+}
+
+func TestHomepageRemembersIdentity(t *testing.T) {
+	t.Parallel()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	mailer.identityFields = []string{fieldName, fieldAddress}
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true})
+	w := httptest.NewRecorder()
+	saveIdentity(w, &Identity{Name: "Jane Doe", Address: "123 Main St"}, mailer.secrets.Identity)
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(w.Result().Cookies()[0])
+	req.AddCookie(authCookie(t, key, "jane@example.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	b := w.Body.String()
+	for _, want := range []string{`name="sender_name" value="Jane Doe" autocomplete="name"`, `name="sender_address" value="123 Main St" autocomplete="street-address"`} {
+		if !strings.Contains(b, want) {
+			t.Errorf("GET /: should see %q, got %s", want, b)
+		}
+	}
+	if strings.Contains(b, "sender_zip") {
+		t.Errorf("GET /: should not ask for a ZIP code the site doesn't require")
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+// Secret key rotation. secret_key may list several keys: the first one
+// encrypts everything, and all of them are tried when decrypting, so a new key
+// can be put in front of the old one without logging everyone out or losing
+// their drafts. Once the longest lived cookie sealed with the old key has
+// expired (two weeks, for sign in), the old key can be removed. Saved sender
+// details last longer, but users are just asked for them again.
+//
+// The configured keys are never used directly. Each kind of value - sign in
+// cookies, flash messages, drafts, CSRF tokens - is sealed with its own key
+// derived from them with HKDF, so a value sealed for one purpose can't be
+// opened as another.
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Keys is a list of secret keys. The first key seals new values; all of them
+// can open old ones.
+type Keys []*[32]byte
+
+func (k Keys) seal(b []byte) string {
+	return opaqueByte(b, k[0])
+}
+
+func (k Keys) open(s string) ([]byte, error) {
+	b, _, err := k.openIndex(s)
+	return b, err
+}
+
+// openIndex is like open, and also returns the index of the key that opened s.
+func (k Keys) openIndex(s string) ([]byte, int, error) {
+	err := errInvalidInput
+	for i := range k {
+		var b []byte
+		b, err = unopaqueByte(s, k[i])
+		if err == nil {
+			return b, i, nil
+		}
+	}
+	return nil, -1, err
+}
+
+// derive returns the subkeys of k for purpose.
+func (k Keys) derive(purpose string) Keys {
+	sub := make(Keys, len(k))
+	for i := range k {
+		r := hkdf.New(sha256.New, k[i][:], nil, []byte("multi-emailer "+purpose))
+		sub[i] = new([32]byte)
+		if _, err := io.ReadFull(r, sub[i][:]); err != nil {
+			panic(err)
+		}
+	}
+	return sub
+}
+
+var errExpired = errors.New("sealed value is too old")
+
+// A Sealer seals values for one purpose. Sealed values carry the time they
+// were sealed, and are rejected once they're older than maxAge.
+type Sealer struct {
+	keys   Keys
+	maxAge time.Duration
+	// legacy are the configured keys, which earlier releases sealed flash
+	// messages and drafts with directly and without a time. Values they open
+	// are accepted until legacyUntil, so upgrading doesn't lose letters that
+	// are being written. Any such value was sealed before this process
+	// started, so it's at most maxAge old by then.
+	legacy      Keys
+	legacyUntil time.Time
+}
+
+func (s *Sealer) seal(b []byte) string {
+	return s.sealAt(b, time.Now())
+}
+
+// sealAt is like seal, for a value sealed at issued.
+func (s *Sealer) sealAt(b []byte, issued time.Time) string {
+	buf := make([]byte, 8, 8+len(b))
+	binary.BigEndian.PutUint64(buf, uint64(issued.Unix()))
+	return s.keys.seal(append(buf, b...))
+}
+
+func (s *Sealer) open(sealed string) ([]byte, error) {
+	b, err := s.keys.open(sealed)
+	if err != nil {
+		if len(s.legacy) > 0 && time.Now().Before(s.legacyUntil) {
+			if legacy, lerr := s.legacy.open(sealed); lerr == nil {
+				return legacy, nil
+			}
+		}
+		return nil, err
+	}
+	if len(b) < 8 {
+		return nil, errTooShort
+	}
+	issued := time.Unix(int64(binary.BigEndian.Uint64(b)), 0)
+	if time.Since(issued) > s.maxAge {
+		return nil, errExpired
+	}
+	return b[8:], nil
+}
+
+// How long values sealed for each purpose stay valid. Sign in cookies expire
+// on their own, after google.DefaultExpiry.
+const (
+	flashMaxAge        = 10 * time.Minute
+	draftMaxAge        = time.Hour
+	csrfMaxAge         = 24 * time.Hour
+	hostedDomainMaxAge = time.Hour
+	// Microsoft access tokens last about an hour; see microsoft.go.
+	microsoftAuthMaxAge = 24 * time.Hour
+	// How long users have to finish signing in, like google.AuthTimeout.
+	oauthStateMaxAge = time.Hour
+	// Sender details are remembered between visits; see identity.go.
+	identityMaxAge = 365 * 24 * time.Hour
+)
+
+// Secrets holds the keys for each purpose, derived from the configured keys.
+type Secrets struct {
+	// Auth seals the Google sign in cookie and OAuth state.
+	Auth         Keys
+	Flash        *Sealer
+	Draft        *Sealer
+	CSRF         *Sealer
+	HostedDomain *Sealer
+	// MicrosoftAuth seals the Microsoft sign in cookie, and MicrosoftState
+	// the OAuth state.
+	MicrosoftAuth  *Sealer
+	MicrosoftState *Sealer
+	Identity       *Sealer
+
+	// legacy are the configured keys, which earlier releases sealed sign in
+	// cookies with; see resealKeys.
+	legacy Keys
+}
+
+// NewSecrets derives the keys for each purpose from keys, newest first.
+func NewSecrets(keys Keys) *Secrets {
+	now := time.Now()
+	return &Secrets{
+		Auth:         keys.derive("auth"),
+		Flash:        &Sealer{keys: keys.derive("flash"), maxAge: flashMaxAge, legacy: keys, legacyUntil: now.Add(flashMaxAge)},
+		Draft:        &Sealer{keys: keys.derive("draft"), maxAge: draftMaxAge, legacy: keys, legacyUntil: now.Add(draftMaxAge)},
+		CSRF:         &Sealer{keys: keys.derive("csrf"), maxAge: csrfMaxAge},
+		HostedDomain: &Sealer{keys: keys.derive("hosted-domain"), maxAge: hostedDomainMaxAge},
+
+		MicrosoftAuth:  &Sealer{keys: keys.derive("microsoft-auth"), maxAge: microsoftAuthMaxAge},
+		MicrosoftState: &Sealer{keys: keys.derive("microsoft-state"), maxAge: oauthStateMaxAge},
+		Identity:       &Sealer{keys: keys.derive("identity"), maxAge: identityMaxAge},
+
+		legacy: keys,
+	}
+}
+
+// resealKeys are the keys ResealAuth opens sign in cookies with: the derived
+// ones, then the configured keys earlier releases sealed them with, so
+// upgrading doesn't log everyone out.
+// TODO: return s.Auth in the next release.
+func (s *Secrets) resealKeys() Keys {
+	keys := make(Keys, 0, len(s.Auth)+len(s.legacy))
+	return append(append(keys, s.Auth...), s.legacy...)
+}
+
+// getSecretKeys parses a list of hex keys. If the list is empty, one key is
+// randomly generated.
+func getSecretKeys(hexKeys []string) (Keys, error) {
+	if len(hexKeys) == 0 {
+		return Keys{NewRandomKey()}, nil
+	}
+	keys := make(Keys, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		if hexKey == "" {
+			return nil, errors.New("secret_key list can't contain an empty key")
+		}
+		key, err := getSecretKey(hexKey)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// secretKeyList is the secret_key setting, which may be a single key or a
+// list of them, newest first.
+type secretKeyList []string
+
+func (l *secretKeyList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var key string
+	if err := unmarshal(&key); err == nil {
+		if key == "" {
+			*l = nil
+		} else {
+			*l = secretKeyList{key}
+		}
+		return nil
+	}
+	var keys []string
+	if err := unmarshal(&keys); err != nil {
+		return errors.New("secret_key should be a key or a list of keys")
+	}
+	*l = keys
+	return nil
+}
+
+// ResealAuth re-encrypts Google sign in cookies and OAuth state parameters
+// that were sealed with an older key, so the authenticator, which only knows
+// the first key, accepts them. The browser gets the resealed cookie back, so
+// it's only done once per visitor.
+func ResealAuth(h http.Handler, keys Keys) http.Handler {
+	if len(keys) < 2 {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var r2 *http.Request
+		clone := func() {
+			if r2 == nil {
+				r2 = new(http.Request)
+				*r2 = *r
+				r2.Header = r.Header.Clone()
+			}
+		}
+		if cookie, err := r.Cookie(authCookieName); err == nil {
+			if b, i, err := keys.openIndex(cookie.Value); err == nil && i > 0 {
+				var t struct{ Expiry time.Time }
+				json.Unmarshal(b, &t)
+				resealed := &http.Cookie{
+					Name:     authCookieName,
+					Value:    keys.seal(b),
+					Path:     "/",
+					Expires:  t.Expiry,
+					HttpOnly: true,
+				}
+				http.SetCookie(w, resealed)
+				clone()
+				cookies := r.Cookies()
+				r2.Header.Del("Cookie")
+				for _, c := range cookies {
+					if c.Name == authCookieName {
+						c = resealed
+					}
+					r2.AddCookie(&http.Cookie{Name: c.Name, Value: c.Value})
+				}
+			}
+		}
+		if r.URL.Path == "/auth/callback" {
+			query := r.URL.Query()
+			if b, i, err := keys.openIndex(query.Get("state")); err == nil && i > 0 {
+				clone()
+				query.Set("state", keys.seal(b))
+				r2.URL = new(url.URL)
+				*r2.URL = *r.URL
+				r2.URL.RawQuery = query.Encode()
+			}
+		}
+		if r2 != nil {
+			r = r2
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	google "github.com/kevinburke/google-oauth-handler"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestSecretKeyList(t *testing.T) {
+	t.Parallel()
+	single := "d7211b215341871968869d1c4c0ffee1789fc88e0ac6e296ba36703edf812345"
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"secret_key: " + single, 1},
+		{"secret_key:\n  - " + single + "\n  - " + single, 2},
+		{"secret_key:", 0},
+	}
+	for _, tt := range tests {
+		c := new(FileConfig)
+		if err := yaml.Unmarshal([]byte(tt.in), c); err != nil {
+			t.Errorf("Unmarshal(%q): %v", tt.in, err)
+			continue
+		}
+		if len(c.SecretKey) != tt.want {
+			t.Errorf("Unmarshal(%q): got %d keys, want %d", tt.in, len(c.SecretKey), tt.want)
+		}
+	}
+	if _, err := getSecretKeys([]string{single, "tooshort"}); err == nil {
+		t.Errorf("getSecretKeys: want error for invalid old key")
+	}
+}
+
+func TestOldKeysOpen(t *testing.T) {
+	t.Parallel()
+	oldKey, newKey := NewRandomKey(), NewRandomKey()
+	sealed := NewSecrets(Keys{oldKey}).Flash.seal([]byte("flash-success|Sent"))
+	b, err := NewSecrets(Keys{newKey, oldKey}).Flash.open(sealed)
+	if err != nil || string(b) != "flash-success|Sent" {
+		t.Errorf("open with old key: got %q, %v", b, err)
+	}
+	if _, err := NewSecrets(Keys{newKey}).Flash.open(sealed); err == nil {
+		t.Errorf("open: want error once the old key is removed")
+	}
+}
+
+func TestSealerPurposes(t *testing.T) {
+	t.Parallel()
+	secrets := NewSecrets(Keys{NewRandomKey()})
+	sealed := secrets.Draft.seal([]byte("body|Please vote no"))
+	if _, err := secrets.CSRF.open(sealed); err == nil {
+		t.Errorf("a draft should not open as a CSRF token")
+	}
+	if _, err := secrets.Flash.open(sealed); err == nil {
+		t.Errorf("a draft should not open as a flash message")
+	}
+	if b, err := secrets.Draft.open(sealed); err != nil || string(b) != "body|Please vote no" {
+		t.Errorf("Draft.open: got %q, %v", b, err)
+	}
+
+	old := &Sealer{keys: secrets.Draft.keys, maxAge: -time.Second}
+	if _, err := old.open(sealed); err != errExpired {
+		t.Errorf("open: got %v for a value older than maxAge, want errExpired", err)
+	}
+}
+
+func TestDeriveIsStable(t *testing.T) {
+	t.Parallel()
+	// Changing how keys are derived would log everyone out, so pin one.
+	k := new([32]byte)
+	for i := range k {
+		k[i] = byte(i)
+	}
+	want := "b0f309e88229c6a54a68536208971b7ce86c861908d15290a8363f2eefa999e3"
+	if got := hex.EncodeToString(Keys{k}.derive("flash")[0][:]); got != want {
+		t.Errorf("derive: got %s, want %s", got, want)
+	}
+}
+
+func TestResealAuth(t *testing.T) {
+	t.Parallel()
+	oldKey, newKey := NewRandomKey(), NewRandomKey()
+	auth := google.NewAuthenticator(google.Config{SecretKey: newKey, AllowUnencryptedTraffic: true})
+	h := ResealAuth(auth.Handle(func(w http.ResponseWriter, r *http.Request, a *google.Auth) {
+		io.WriteString(w, a.Email.Address)
+	}), Keys{newKey, oldKey})
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "flash-success", Value: "unchanged"})
+	req.AddCookie(authCookie(t, oldKey, "sender@example.com"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 || w.Body.String() != "sender@example.com" {
+		t.Fatalf("GET / with old key: got %d %q, want 200 and the signed in address", w.Code, w.Body.String())
+	}
+	var resealed *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == authCookieName {
+			resealed = c
+		}
+	}
+	if resealed == nil {
+		t.Fatal("want resealed cookie on the response")
+	}
+	if _, err := unopaqueByte(resealed.Value, newKey); err != nil {
+		t.Errorf("resealed cookie should open with the new key: %v", err)
+	}
+
+	// Cookies sealed with the current key are left alone.
+	req = httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, newKey, "sender@example.com"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 || len(w.Result().Cookies()) != 0 {
+		t.Errorf("GET / with new key: got %d and %d cookies, want 200 and none", w.Code, len(w.Result().Cookies()))
+	}
+}
+
+func TestLegacyValuesOpen(t *testing.T) {
+	t.Parallel()
+	key := NewRandomKey()
+	secrets := NewSecrets(Keys{key})
+	// Sealed before keys were derived, without a time.
+	sealed := opaqueByte([]byte("flash|Sent"), key)
+	if b, err := secrets.Flash.open(sealed); err != nil || string(b) != "flash|Sent" {
+		t.Errorf("Flash.open for a value sealed with the configured key: got %q, %v", b, err)
+	}
+	if _, err := secrets.CSRF.open(sealed); err == nil {
+		t.Errorf("only flash messages and drafts should accept old values")
+	}
+	// Old values can't outlive the ones this release seals.
+	secrets.Draft.legacyUntil = time.Now().Add(-time.Second)
+	if _, err := secrets.Draft.open(opaqueByte([]byte("draft"), key)); err == nil {
+		t.Errorf("Draft.open accepted an old value after the cutoff")
+	}
+
+	auth := google.NewAuthenticator(google.Config{SecretKey: secrets.Auth[0], AllowUnencryptedTraffic: true})
+	h := ResealAuth(auth.Handle(func(w http.ResponseWriter, r *http.Request, a *google.Auth) {
+		io.WriteString(w, a.Email.Address)
+	}), secrets.resealKeys())
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "sender@example.com"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 || w.Body.String() != "sender@example.com" {
+		t.Errorf("GET / signed in before upgrading: got %d %q, want 200 and the signed in address", w.Code, w.Body.String())
+	}
+}
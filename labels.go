@@ -0,0 +1,88 @@
+package main
+
+// Gmail labels on sent letters, so users can find them among everything else
+// in Sent. Gmail only lets us label a message with the gmail.modify scope,
+// so it's only requested if the site or a group sets gmail_label.
+//
+// Letters sent through a service account (see workspace.go) aren't labeled,
+// since its domain-wide delegation only covers gmail.send.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+// labelsMail reports whether users are asked for permission to label the
+// letters they send.
+func (m *Mailer) labelsMail() bool {
+	if m.delegation != nil && m.delegation.Required {
+		return false
+	}
+	for _, group := range m.Groups {
+		if group.GmailLabel != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// gmailLabel returns the ID of the label called name in userID's mailbox,
+// creating it if it doesn't exist yet.
+func gmailLabel(ctx context.Context, srv *gmail.Service, userID, name string) (string, error) {
+	find := func() (string, error) {
+		resp, err := srv.Users.Labels.List(userID).Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		for _, label := range resp.Labels {
+			if strings.EqualFold(label.Name, name) {
+				return label.Id, nil
+			}
+		}
+		return "", nil
+	}
+	id, err := find()
+	if err != nil || id != "" {
+		return id, err
+	}
+	label, err := srv.Users.Labels.Create(userID, &gmail.Label{
+		Name:                  name,
+		LabelListVisibility:   "labelShow",
+		MessageListVisibility: "show",
+	}).Context(ctx).Do()
+	if statusCode(err) == http.StatusConflict {
+		// Another send created it first.
+		return find()
+	}
+	if err != nil {
+		return "", err
+	}
+	return label.Id, nil
+}
+
+// applyLabel adds g's label to the sent message id. Errors are logged rather
+// than returned, since the letter has already gone out.
+func (g *gmailSender) applyLabel(ctx context.Context, id string) {
+	if g.label == "" {
+		return
+	}
+	g.labelOnce.Do(func() {
+		var err error
+		g.labelID, err = gmailLabel(ctx, g.srv, g.userID, g.label)
+		if err != nil {
+			g.logger.Warn("Could not find or create Gmail label", "label", g.label, "err", err)
+		}
+	})
+	if g.labelID == "" {
+		return
+	}
+	_, err := g.srv.Users.Messages.Modify(g.userID, id, &gmail.ModifyMessageRequest{
+		AddLabelIds: []string{g.labelID},
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Warn("Could not label sent message", "label", g.label, "err", err)
+	}
+}
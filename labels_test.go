@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// gmailStandIn serves the Gmail API calls used to send and label messages.
+type gmailStandIn struct {
+	// If true, labeling a message fails.
+	failModify bool
+
+	mu      sync.Mutex
+	sent    int
+	created []string
+	labeled map[string][]string
+}
+
+func (g *gmailStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(r.URL.Path, "/gmail/v1/users/volunteer@gmail.com/")
+	switch {
+	case path == "labels" && r.Method == "GET":
+		labels := []map[string]string{{"id": "INBOX", "name": "INBOX"}}
+		for i, name := range g.created {
+			labels = append(labels, map[string]string{"id": fmt.Sprintf("Label_%d", i+1), "name": name})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"labels": labels})
+	case path == "labels" && r.Method == "POST":
+		var label struct{ Name string }
+		json.NewDecoder(r.Body).Decode(&label)
+		g.created = append(g.created, label.Name)
+		fmt.Fprintf(w, `{"id":"Label_%d","name":%q}`, len(g.created), label.Name)
+	case path == "messages/send":
+		g.sent++
+		fmt.Fprintf(w, `{"id":"msg-%d"}`, g.sent)
+	case strings.HasPrefix(path, "messages/") && strings.HasSuffix(path, "/modify"):
+		if g.failModify {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, `{"error":{"code":403,"message":"Insufficient Permission"}}`)
+			return
+		}
+		var req struct{ AddLabelIds []string }
+		json.NewDecoder(r.Body).Decode(&req)
+		g.labeled[strings.Split(path, "/")[1]] = req.AddLabelIds
+		io.WriteString(w, `{}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestGmailLabel(t *testing.T) {
+	t.Parallel()
+	for _, failModify := range []bool{false, true} {
+		gmailServer := &gmailStandIn{failModify: failModify, labeled: make(map[string][]string)}
+		server := httptest.NewServer(gmailServer)
+		u, _ := url.Parse(server.URL)
+		m, _ := drainMailer()
+		group := &Group{ID: "board", GmailLabel: "Letters to City Hall", Recipients: []*Recipient{
+			{Address: mail.Address{Address: "supervisor@example.com"}, OpeningLine: "Dear Supervisor"},
+			{Address: mail.Address{Address: "mayor@example.com"}, OpeningLine: "Dear Mayor"},
+		}}
+		results := m.send(context.Background(), &Account{
+			Email:    &mail.Address{Address: "volunteer@gmail.com"},
+			Client:   &http.Client{Transport: redirectTransport{u}},
+			Provider: providerGoogle,
+		}, group, "Vote no", "Please vote no.")
+		server.Close()
+		for _, result := range results {
+			if result.Err != nil {
+				t.Errorf("send to %s with failModify %t: %v", result.To.Address, failModify, result.Err)
+			}
+		}
+		if len(gmailServer.created) != 1 || gmailServer.created[0] != "Letters to City Hall" {
+			t.Errorf("got labels created %q, want the group's label once", gmailServer.created)
+		}
+		if failModify {
+			continue
+		}
+		if len(gmailServer.labeled) != 2 || gmailServer.labeled["msg-1"][0] != "Label_1" || gmailServer.labeled["msg-2"][0] != "Label_1" {
+			t.Errorf("got labeled messages %v, want both sent messages labeled Label_1", gmailServer.labeled)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+// Listening on TCP addresses, Unix sockets and sockets passed down by systemd,
+// and reloading TLS certificates when they're renewed.
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Listen returns a listener for addr, which is one of:
+//
+//	":8048" or "127.0.0.1:8048" - a TCP address
+//	"unix:/run/multi-emailer.sock" - a Unix socket, replacing any stale one
+//	"systemd" - the first socket passed by systemd socket activation
+//	"systemd:https" - the socket with FileDescriptorName=https
+func Listen(addr string) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(addr, "unix:"):
+		path := strings.TrimPrefix(addr, "unix:")
+		if fi, err := os.Lstat(path); err == nil {
+			if fi.Mode()&os.ModeSocket == 0 {
+				return nil, fmt.Errorf("can't listen on %s: file exists and isn't a socket", path)
+			}
+			if err := os.Remove(path); err != nil {
+				return nil, err
+			}
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		return net.Listen("unix", path)
+	case addr == "systemd" || strings.HasPrefix(addr, "systemd:"):
+		fd, err := systemdFD(strings.TrimPrefix(strings.TrimPrefix(addr, "systemd"), ":"), os.Getenv)
+		if err != nil {
+			return nil, err
+		}
+		f := os.NewFile(uintptr(fd), addr)
+		defer f.Close()
+		return net.FileListener(f)
+	default:
+		return net.Listen("tcp", addr)
+	}
+}
+
+// The first file descriptor passed by systemd; see sd_listen_fds(3).
+const systemdFirstFD = 3
+
+// systemdFD returns the file descriptor systemd passed for the socket with
+// the given name, or the first one if name is empty.
+func systemdFD(name string, getenv func(string) string) (int, error) {
+	if pid, err := strconv.Atoi(getenv("LISTEN_PID")); err != nil || pid != os.Getpid() {
+		return 0, errors.New("no sockets were passed by systemd")
+	}
+	n, err := strconv.Atoi(getenv("LISTEN_FDS"))
+	if err != nil || n < 1 {
+		return 0, errors.New("no sockets were passed by systemd")
+	}
+	if name == "" {
+		return systemdFirstFD, nil
+	}
+	for i, fdName := range strings.Split(getenv("LISTEN_FDNAMES"), ":") {
+		if fdName == name && i < n {
+			return systemdFirstFD + i, nil
+		}
+	}
+	return 0, fmt.Errorf("systemd did not pass a socket named %q", name)
+}
+
+// A certReloader serves a TLS certificate from disk, loading it again when
+// the certificate or key file changes, so renewed certificates are picked up
+// without a restart.
+type certReloader struct {
+	certFile, keyFile string
+
+	mu          sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	c := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *certReloader) modTimes() (time.Time, time.Time, error) {
+	certInfo, err := os.Stat(c.certFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	keyInfo, err := os.Stat(c.keyFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
+
+// reload loads the certificate if either file has changed since it was last
+// loaded. The caller must not hold c.mu.
+func (c *certReloader) reload() error {
+	certModTime, keyModTime, err := c.modTimes()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cert != nil && certModTime.Equal(c.certModTime) && keyModTime.Equal(c.keyModTime) {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+	if c.cert != nil {
+		logger.Info("Reloaded TLS certificate", "file", c.certFile)
+	}
+	c.cert = &cert
+	c.certModTime = certModTime
+	c.keyModTime = keyModTime
+	return nil
+}
+
+// GetCertificate is for use as tls.Config.GetCertificate. If the files on
+// disk can't be loaded - say, the certificate has been renewed but the key
+// hasn't been written yet - the previous certificate is served.
+func (c *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if err := c.reload(); err != nil {
+		logger.Warn("Could not reload TLS certificate, using the previous one", "file", c.certFile, "err", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cert, nil
+}
+
+// RedirectToHTTPS redirects every request to the same path on publicHost, if
+// it's an https:// URL, or otherwise on the requested host at httpsPort.
+func RedirectToHTTPS(publicHost string, httpsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u := &url.URL{Scheme: "https", Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+		if pu, err := url.Parse(publicHost); err == nil && pu.Scheme == "https" {
+			u.Host = pu.Host
+		} else {
+			host, _, err := net.SplitHostPort(r.Host)
+			if err != nil {
+				host = r.Host
+			}
+			u.Host = host
+			if httpsPort != 443 {
+				u.Host = net.JoinHostPort(host, strconv.Itoa(httpsPort))
+			}
+		}
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTestCert writes a self-signed certificate for commonName, and its key,
+// to dir.
+func writeTestCert(t *testing.T, dir, commonName string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, "leaf.pem")
+	keyFile = filepath.Join(dir, "leaf.key")
+	writeTestFile(t, dir, "leaf.pem", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	writeTestFile(t, dir, "leaf.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})))
+	return certFile, keyFile
+}
+
+func servedName(t *testing.T, c *certReloader) string {
+	t.Helper()
+	cert, err := c.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "multi-emailer-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := writeTestCert(t, dir, "old.example.com")
+	c, err := newCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := servedName(t, c); name != "old.example.com" {
+		t.Errorf("got certificate for %q, want old.example.com", name)
+	}
+
+	// A half-written renewal keeps serving the old certificate.
+	later := time.Now().Add(time.Minute)
+	writeTestFile(t, dir, "leaf.pem", "not a certificate")
+	if err := os.Chtimes(certFile, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if name := servedName(t, c); name != "old.example.com" {
+		t.Errorf("got certificate for %q, want old.example.com while files are invalid", name)
+	}
+
+	writeTestCert(t, dir, "new.example.com")
+	later = later.Add(time.Minute)
+	for _, f := range []string{certFile, keyFile} {
+		if err := os.Chtimes(f, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if name := servedName(t, c); name != "new.example.com" {
+		t.Errorf("got certificate for %q, want new.example.com after renewal", name)
+	}
+}
+
+func TestListenUnix(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "multi-emailer-sock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// Other files are left alone.
+	writeTestFile(t, dir, "config.yml", "port: 8048")
+	if _, err := Listen("unix:" + filepath.Join(dir, "config.yml")); err == nil || !strings.Contains(err.Error(), "isn't a socket") {
+		t.Errorf("Listen on a regular file: got %v, want an error", err)
+	}
+
+	// A socket left behind by a previous run is replaced.
+	path := filepath.Join(dir, "server.sock")
+	stale, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+	ln, err := Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	if ln.Addr().Network() != "unix" {
+		t.Errorf("got network %q, want unix", ln.Addr().Network())
+	}
+	go http.Serve(ln, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestSystemdFD(t *testing.T) {
+	t.Parallel()
+	env := map[string]string{
+		"LISTEN_PID":     strconv.Itoa(os.Getpid()),
+		"LISTEN_FDS":     "2",
+		"LISTEN_FDNAMES": "http:https",
+	}
+	getenv := func(key string) string { return env[key] }
+	if fd, err := systemdFD("", getenv); err != nil || fd != 3 {
+		t.Errorf("systemdFD(\"\"): got %d, %v; want 3", fd, err)
+	}
+	if fd, err := systemdFD("https", getenv); err != nil || fd != 4 {
+		t.Errorf("systemdFD(https): got %d, %v; want 4", fd, err)
+	}
+	if _, err := systemdFD("metrics", getenv); err == nil {
+		t.Errorf("systemdFD(metrics): want error for unknown name")
+	}
+	env["LISTEN_PID"] = "1"
+	if _, err := systemdFD("", getenv); err == nil {
+		t.Errorf("systemdFD: want error for sockets passed to another process")
+	}
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		publicHost string
+		port       int
+		want       string
+	}{
+		{"https://letters.example.com", 443, "https://letters.example.com/board?g=1"},
+		{"", 443, "https://localhost/board?g=1"},
+		{"", 8443, "https://localhost:8443/board?g=1"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "http://localhost:8080/board?g=1", nil)
+		RedirectToHTTPS(tt.publicHost, tt.port).ServeHTTP(w, req)
+		if w.Code != http.StatusMovedPermanently || w.Header().Get("Location") != tt.want {
+			t.Errorf("RedirectToHTTPS(%q, %d): got %d %q, want 301 %q", tt.publicHost, tt.port, w.Code, w.Header().Get("Location"), tt.want)
+		}
+	}
+}
@@ -32,8 +32,10 @@ import (
 	log "github.com/inconshreveable/log15"
 	google "github.com/kevinburke/google-oauth-handler"
 	"github.com/kevinburke/handlers"
-	"github.com/kevinburke/multi-emailer/assets"
 	"github.com/kevinburke/rest"
+	"github.com/russross/blackfriday"
+	"golang.org/x/oauth2"
+	googleoauth "golang.org/x/oauth2/google"
 	gmail "google.golang.org/api/gmail/v1"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -45,17 +47,14 @@ var idRxPart = "[-_a-z0-9A-Z]+"
 var homeRx = regexp.MustCompile(fmt.Sprintf(`^/(%s)?$`, idRxPart))
 
 // GET /<id>/recipients
-var recipientsRx = regexp.MustCompile(fmt.Sprintf(`^/(%s)/recipients$`, idRxPart))
+// GET /<id>/recipients.json (or .csv, .vcf, .yml)
+var recipientsRx = regexp.MustCompile(fmt.Sprintf(`^/(%s)/recipients(\.json|\.csv|\.vcf|\.yml|\.yaml)?$`, idRxPart))
 var validIDRx = regexp.MustCompile(fmt.Sprintf(`^%s$`, idRxPart))
 
 var logger log.Logger
 
-var homepageTpl *template.Template
-
 func init() {
 	logger = handlers.Logger
-	homepageHTML := assets.MustAssetString("templates/index.html")
-	homepageTpl = template.Must(template.New("homepage").Parse(homepageHTML))
 }
 
 var goVersion = runtime.Version()
@@ -65,9 +64,10 @@ const Version = "1.7"
 var DefaultPort = 8048
 
 // Static file HTTP server; all assets are packaged up in the assets directory
-// with go-bindata.
+// with go-bindata, and can be overridden by the theme.
 type static struct {
 	modTime time.Time
+	theme   *Theme
 }
 
 func (s *static) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -80,12 +80,18 @@ func (s *static) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.URL.Path == "/terms-of-service" {
 		r.URL.Path = "/static/license.txt"
 	}
-	bits, err := assets.Asset(strings.TrimPrefix(r.URL.Path, "/"))
+	if r.URL.Path == "/v1/openapi.json" {
+		r.URL.Path = "/static/openapi.json"
+	}
+	bits, modTime, err := s.theme.staticAsset(strings.TrimPrefix(r.URL.Path, "/"))
 	if err != nil {
 		rest.NotFound(w, r)
 		return
 	}
-	http.ServeContent(w, r, r.URL.Path, s.modTime, bytes.NewReader(bits))
+	if modTime.IsZero() {
+		modTime = s.modTime
+	}
+	http.ServeContent(w, r, r.URL.Path, modTime, bytes.NewReader(bits))
 }
 
 func render(w http.ResponseWriter, r *http.Request, tpl *template.Template, name string, data interface{}) {
@@ -97,143 +103,381 @@ func render(w http.ResponseWriter, r *http.Request, tpl *template.Template, name
 	w.Write(buf.Bytes())
 }
 
-func logout(auth *google.Authenticator) http.HandlerFunc {
+func logout(auth *google.Authenticator, microsoft *MicrosoftAuthenticator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		auth.Logout(w)
+		microsoft.Logout(w)
+		clearCookie(w, csrfCookieName)
+		clearCookie(w, hdCookieName)
 		http.Redirect(w, r, "/", http.StatusFound)
 	}
 }
 
+// layoutData is used by the templates in layout.html, which every page
+// shares.
+type layoutData struct {
+	Title   string
+	Version string
+	// The language to render the page in, and the languages users can switch
+	// to; see i18n.go.
+	Locale    string
+	Languages []Language
+	// Inline scripts need this to run under the Content-Security-Policy; see
+	// security.go.
+	CSPNonce string
+	// Goes in front of links to pages on the site, like "/letters" if the
+	// site is mounted there; see proxy.go.
+	Base string
+}
+
+func newLayoutData(r *http.Request, site *Site, locale string) layoutData {
+	return layoutData{
+		Title:     site.Title,
+		Version:   goVersion,
+		Locale:    locale,
+		Languages: supportedLanguages(),
+		CSPNonce:  cspNonce(r),
+		Base:      site.BasePath,
+	}
+}
+
+// T translates text in the template into the page's language.
+func (l *layoutData) T(key string, args ...interface{}) string {
+	return translate(l.Locale, key, args...)
+}
+
+// TH is like T, for text that contains HTML.
+func (l *layoutData) TH(key string, args ...interface{}) template.HTML {
+	return translateHTML(l.Locale, key, args...)
+}
+
+// Tn is like T, but picks the singular or plural form based on n.
+func (l *layoutData) Tn(one, other string, n int) string {
+	return translatePlural(l.Locale, one, other, n)
+}
+
+// Deadline formats a group's opening or closing time in the page's language.
+func (l *layoutData) Deadline(t time.Time) string {
+	return formatDeadlineIn(l.Locale, t)
+}
+
 type homepageData struct {
-	Email      *mail.Address
-	Groups     map[string]*Group
+	layoutData
+	Email *mail.Address
+	// Gmail send-as addresses the user can pick from; see aliases.go.
+	Aliases []*mail.Address
+	// Groups on this page, in display order.
+	Groups []*Group
+	// Groups split up by category, for display.
+	Categories []*GroupCategory
+	// Groups that have closed, in display order.
+	Archived []*Group
+	// If non-nil, the page shows a countdown to this group's next opening or
+	// closing time.
+	Countdown  *Group
 	Error      string
 	Success    string
-	Title      string
-	Version    string
 	PublicHost string
+	// The public URL of this page, for share links.
+	ShareURL   string
 	Subject    string
 	Body       string
 	IsHomepage bool
 	// If there's only one group and one recipient, put opening line there
 	OpeningLine string
 	AuthURL     string
+	// Whether signing in asks for permission to label sent letters; see
+	// labels.go.
+	LabelsMail bool
+	// Set if visitors can sign in with Microsoft too.
+	MicrosoftAuthURL string
+	// Must be submitted with every form, see csrf.go.
+	CSRFToken string
+	// The group and send-as address picked in a letter saved while the user
+	// signed in again; see draft.go.
+	GroupID string
+	From    string
+	// Details the sender must fill in; see identity.go.
+	Identity []identityInput
 }
 
-func NewServeMux(authenticator *google.Authenticator, mailer *Mailer, title string, withGoogle bool, publicHost string, siteVerification string) http.Handler {
+// Site holds settings that apply to the whole site, rather than to a single
+// group.
+type Site struct {
+	Title string
+	// If false, skip Google authentication and render the homepage as a test
+	// user. For development; you can't send emails this way.
+	WithGoogle bool
+	PublicHost string
+	// A path prefix like "/letters", if the site isn't served at the root of
+	// PublicHost; see proxy.go.
+	BasePath string
+	// A filename like "google4f9d0c78202b2454.html"; see FileConfig.
+	SiteVerification string
+	// If nil, use the built-in templates and static files.
+	Theme *Theme
+	// Maps URL paths like "/privacy" to Markdown files, which are rendered
+	// in the site layout. These take precedence over built-in pages.
+	Pages map[string]string
+	// Origins like "https://example.org" that may show /embed/<id> in an
+	// iframe; see embed.go.
+	EmbedOrigins []string
+	// Serve Prometheus metrics at /metrics; see metrics.go.
+	Metrics bool
+	// If nil, anyone with a Google account may sign in; see signin.go.
+	SignIn *SignInPolicy
+	// If non-nil, visitors can sign in with a Microsoft account instead of
+	// a Google one; see microsoft.go.
+	Microsoft *MicrosoftAuthenticator
+}
+
+func NewServeMux(authenticator *google.Authenticator, mailer *Mailer, site *Site) http.Handler {
+	if site == nil {
+		site = new(Site)
+	}
+	if site.Theme == nil {
+		site.Theme = defaultTheme
+	}
 	staticServer := &static{
 		modTime: time.Now().UTC(),
+		theme:   site.Theme,
 	}
 	if mailer == nil {
-		mailer = &Mailer{
-			secretKey: NewRandomKey(),
-		}
+		mailer = new(Mailer)
+	}
+	if mailer.secrets == nil {
+		mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	}
+	if mailer.jobs == nil {
+		mailer.jobs = newJobStore()
+	}
+	if mailer.aliases == nil {
+		mailer.aliases = newAliasCache()
+	}
+	if mailer.inflight == nil {
+		mailer.inflight = newSendTracker()
+	}
+	if mailer.Logger == nil {
+		mailer.Logger = logger
 	}
 
 	renderRecipients := func(w http.ResponseWriter, r *http.Request) {
 		match := recipientsRx.FindStringSubmatch(r.URL.Path)
 		group, ok := mailer.Groups[match[1]]
-		if !ok {
+		if !ok || !group.VisibleTo(signedInEmail(r, mailer.secrets)) {
 			rest.NotFound(w, r)
 			return
 		}
-		data, err := yaml.Marshal(group.Recipients)
-		if err != nil {
-			rest.ServerError(w, r, err)
-			return
-		}
-		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-		w.Write(data)
+		serveRecipients(w, r, group, match[2])
 	}
 
-	renderHomepage := func(w http.ResponseWriter, r *http.Request, email *mail.Address, authURL string) {
+	renderHomepage := func(w http.ResponseWriter, r *http.Request, email *mail.Address, aliases []*mail.Address, authURL string) {
 		push(w, "/static/bootstrap.min.css", "style")
 		push(w, "/static/style.css", "style")
 		vals := r.URL.Query()
 		if vals.Get("subject") != "" || vals.Get("body") != "" {
-			setCookie(w, vals.Get("subject"), "subject", mailer.secretKey)
-			setCookie(w, vals.Get("body"), "body", mailer.secretKey)
+			setCookie(w, vals.Get("subject"), "subject", mailer.secrets.Draft)
+			setCookie(w, vals.Get("body"), "body", mailer.secrets.Draft)
 			http.Redirect(w, r, r.URL.Path, http.StatusFound)
 			return
 		}
-		subjCookie := getCookie(w, r, "subject", mailer.secretKey, email != nil)
-		bodyCookie := getCookie(w, r, "body", mailer.secretKey, email != nil)
+		subjCookie := getCookie(w, r, "subject", mailer.secrets.Draft, email != nil)
+		bodyCookie := getCookie(w, r, "body", mailer.secrets.Draft, email != nil)
+		// A letter saved while the user signed in again; see draft.go.
+		var groupID, from string
+		if d := loadDraft(w, r, mailer.secrets.Draft, false); d != nil {
+			if email != nil {
+				// Signing in may have brought the user back somewhere
+				// other than the page they wrote the letter on.
+				if d.Page != r.URL.Path && homeRx.MatchString(d.Page) {
+					http.Redirect(w, r, d.Page, http.StatusFound)
+					return
+				}
+				clearDraft(w, r)
+			}
+			subjCookie, bodyCookie, groupID, from = d.Subject, d.Body, d.GroupID, d.From
+		}
 		match := homeRx.FindStringSubmatch(r.URL.Path)
-		var groups map[string]*Group
+		var groups []*Group
+		var groupLocale string
 		if match == nil || match[1] == "" {
-			groups = mailer.Groups
+			groups = listedGroups(mailer.Groups, email)
 		} else {
-			if group, ok := mailer.Groups[match[1]]; !ok {
+			group, ok := mailer.Groups[match[1]]
+			if !ok || (email != nil && !group.VisibleTo(email)) {
 				rest.NotFound(w, r)
 				return
-			} else {
-				groups = make(map[string]*Group)
-				groups[match[1]] = group
+			}
+			// If the group is restricted and the visitor isn't signed in,
+			// show the sign in page without it.
+			if group.VisibleTo(email) {
+				groups = []*Group{group}
+				groupLocale = group.Locale
 			}
 		}
+		saveLocale(w, r)
+		locale := requestLocale(r, groupLocale)
 		var openingLine string
-		if len(groups) == 1 {
-			for k := range groups {
-				if len(groups[k].Recipients) == 1 {
-					openingLine = groups[k].Recipients[0].OpeningLine
-				}
-				break
-			}
+		if len(groups) == 1 && len(groups[0].Recipients) == 1 {
+			openingLine = groups[0].Recipients[0].OpeningLine
+		}
+		var countdown *Group
+		if len(groups) == 1 && (groups[0].Upcoming() || (!groups[0].ClosesAt.IsZero() && !groups[0].Closed())) {
+			countdown = groups[0]
+		}
+		groups, archived := splitArchived(groups, time.Now())
+		var token, microsoftURL string
+		if email != nil {
+			token = csrfToken(w, r, mailer.secrets.CSRF)
+		} else if authURL != "" && site.Microsoft != nil {
+			microsoftURL = site.Microsoft.URL(r)
 		}
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		render(w, r, homepageTpl, "homepage", &homepageData{
-			Title:       title,
+		render(w, r, site.Theme.templates, "index.html", &homepageData{
+			layoutData:  newLayoutData(r, site, locale),
 			Email:       email,
+			Aliases:     aliases,
 			Groups:      groups,
-			Error:       GetFlashError(w, r, mailer.secretKey),
-			Success:     GetFlashSuccess(w, r, mailer.secretKey),
-			Version:     goVersion,
-			PublicHost:  publicHost,
+			Categories:  categorize(groups),
+			Archived:    archived,
+			Countdown:   countdown,
+			Error:       GetFlashError(w, r, mailer.secrets.Flash),
+			Success:     GetFlashSuccess(w, r, mailer.secrets.Flash),
+			PublicHost:  site.PublicHost,
+			ShareURL:    strings.TrimSuffix(site.PublicHost, "/") + r.URL.Path,
 			Subject:     subjCookie,
 			Body:        bodyCookie,
+			GroupID:     groupID,
+			From:        from,
+			Identity:    mailer.identityInputs(savedIdentity(r, mailer.secrets.Identity)),
 			IsHomepage:  r.URL.Path == "/",
 			OpeningLine: openingLine,
 			AuthURL:     authURL,
+			CSRFToken:   token,
+			LabelsMail:  mailer.labelsMail(),
+
+			MicrosoftAuthURL: microsoftURL,
+		})
+	}
+
+	// handle is like authenticator.Handle, but also accepts users signed in
+	// with Microsoft, and turns away accounts the site's sign in policy
+	// doesn't allow.
+	handle := func(f func(http.ResponseWriter, *http.Request, *Account)) http.Handler {
+		allowed := func(w http.ResponseWriter, r *http.Request, auth *Account) {
+			ok, err := site.SignIn.Allowed(w, r, auth, mailer.secrets.HostedDomain)
+			if err != nil {
+				rest.ServerError(w, r, err)
+				return
+			}
+			if !ok {
+				renderNotAuthorized(w, r, site, auth.Email, mailer.secrets.CSRF)
+				return
+			}
+			if mailer.delegation != nil {
+				if err := mailer.delegation.delegate(w, r, auth, site.SignIn, mailer.secrets.HostedDomain); err != nil {
+					rest.ServerError(w, r, err)
+					return
+				}
+				if mailer.delegation.Required && auth.Provider == providerGoogle && !auth.delegated {
+					renderNotAuthorized(w, r, site, auth.Email, mailer.secrets.CSRF)
+					return
+				}
+			}
+			f(w, r, auth)
+		}
+		withGoogle := authenticator.Handle(func(w http.ResponseWriter, r *http.Request, auth *google.Auth) {
+			allowed(w, r, googleAccount(auth, mailer.googleOAuth))
+		})
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth, ok := site.Microsoft.account(r); ok {
+				allowed(w, r, auth)
+				return
+			}
+			withGoogle.ServeHTTP(w, r)
 		})
 	}
 
 	r := new(handlers.Regexp)
 
-	r.Handle(regexp.MustCompile(`(^/static|^/favicon.ico$|^/privacy$|^/terms-of-service$)`), []string{"GET"}, handlers.GZip(staticServer))
-	if siteVerification != "" {
-		r.HandleFunc(regexp.MustCompile("/"+regexp.QuoteMeta(siteVerification)), []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
+	for path, filename := range site.Pages {
+		filename := filename
+		r.HandleFunc(regexp.MustCompile("^"+regexp.QuoteMeta(path)+"$"), []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
+			servePage(w, r, site, filename)
+		})
+	}
+	r.Handle(regexp.MustCompile(`(^/static|^/favicon.ico$|^/privacy$|^/terms-of-service$|^/v1/openapi.json$)`), []string{"GET"}, handlers.GZip(staticServer))
+	if site.SiteVerification != "" {
+		r.HandleFunc(regexp.MustCompile("/"+regexp.QuoteMeta(site.SiteVerification)), []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "text/html; charset=utf-8")
-			io.WriteString(w, "google-site-verification: "+siteVerification)
+			io.WriteString(w, "google-site-verification: "+site.SiteVerification)
 		})
 	}
 	r.HandleFunc(recipientsRx, []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
 		renderRecipients(w, r)
 	})
-	if withGoogle {
-		r.HandleFunc(regexp.MustCompile(`^/logout$`), []string{"POST"}, logout(authenticator))
+	if site.Metrics {
+		r.HandleFunc(regexp.MustCompile(`^/metrics$`), []string{"GET"}, serveMetrics)
+	}
+	r.HandleFunc(regexp.MustCompile(`^/v1/groups$`), []string{"GET"}, mailer.apiListGroups)
+	r.HandleFunc(apiGroupRx, []string{"GET"}, mailer.apiGetGroup)
+	if site.WithGoogle {
+		r.Handle(regexp.MustCompile(`^/logout$`), []string{"POST"}, csrfProtect(logout(authenticator, site.Microsoft), mailer.secrets.CSRF))
 		authenticator.SetLogin(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isAPIRequest(r) {
+				apiUnauthorized(w, r)
+				return
+			}
+			if r.Method == "POST" && r.URL.Path == "/v1/send" {
+				locale, next := mailer.formPage(r)
+				mailer.reauthenticate(w, r, locale, next)
+				return
+			}
+			if embedRx.MatchString(r.URL.Path) {
+				renderEmbed(w, r, mailer, site, nil, nil, popupAuthURL(authenticator.URL, r))
+				return
+			}
+			if r.URL.Path == signedInPath {
+				http.Redirect(w, r, authenticator.URL(r), http.StatusFound)
+				return
+			}
 			vals := r.URL.Query()
 			if vals.Get("subject") != "" || vals.Get("body") != "" {
-				setCookie(w, vals.Get("subject"), "subject", mailer.secretKey)
-				setCookie(w, vals.Get("body"), "body", mailer.secretKey)
+				setCookie(w, vals.Get("subject"), "subject", mailer.secrets.Draft)
+				setCookie(w, vals.Get("body"), "body", mailer.secrets.Draft)
 				http.Redirect(w, r, r.URL.Path, http.StatusFound)
 				return
 			}
 			u := authenticator.URL(r)
-			renderHomepage(w, r, nil, u)
+			renderHomepage(w, r, nil, nil, u)
 		}))
-		r.Handle(homeRx, []string{"GET"}, authenticator.Handle(func(w http.ResponseWriter, r *http.Request, auth *google.Auth) {
-			renderHomepage(w, r, auth.Email, "")
+		r.Handle(homeRx, []string{"GET"}, handle(func(w http.ResponseWriter, r *http.Request, auth *Account) {
+			renderHomepage(w, r, auth.Email, mailer.aliasChoices(r, auth), "")
 		}))
-		r.Handle(regexp.MustCompile(`^/auth/callback$`), []string{"GET"}, authenticator.Handle(func(w http.ResponseWriter, r *http.Request, _ *google.Auth) {
+		r.Handle(regexp.MustCompile(`^/auth/callback$`), []string{"GET"}, countLogins(authenticator.Handle(func(w http.ResponseWriter, r *http.Request, _ *google.Auth) {
 			http.Redirect(w, r, "/", http.StatusFound)
+		})))
+		if site.Microsoft != nil {
+			r.Handle(regexp.MustCompile("^"+microsoftCallbackPath+"$"), []string{"GET"}, countLogins(http.HandlerFunc(site.Microsoft.Callback)))
+		}
+		r.Handle(embedRx, []string{"GET"}, handle(func(w http.ResponseWriter, r *http.Request, auth *Account) {
+			renderEmbed(w, r, mailer, site, auth.Email, mailer.aliasChoices(r, auth), "")
 		}))
-		r.Handle(regexp.MustCompile(`^/v1/send$`), []string{"POST"}, authenticator.Handle(mailer.sendMail))
+		r.Handle(regexp.MustCompile("^"+signedInPath+"$"), []string{"GET"}, handle(func(w http.ResponseWriter, r *http.Request, _ *Account) {
+			renderSignedIn(w, r, site)
+		}))
+		r.Handle(regexp.MustCompile(`^/v1/send$`), []string{"POST"}, mailer.protectSend(handle(mailer.sendMail)))
+		r.Handle(regexp.MustCompile(`^/v1/preview$`), []string{"POST"}, handle(mailer.apiPreview))
+		r.Handle(regexp.MustCompile(`^/v1/messages$`), []string{"POST"}, handle(mailer.apiSend))
+		r.Handle(apiJobRx, []string{"GET"}, handle(mailer.apiGetJob))
 	} else {
 		// For testing; no authentication.
 		testEmail, _ := mail.ParseAddress("Test Email <test@example.org>")
 		r.HandleFunc(homeRx, []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
-			renderHomepage(w, r, testEmail, "")
+			renderHomepage(w, r, testEmail, nil, "")
+		})
+		r.HandleFunc(embedRx, []string{"GET"}, func(w http.ResponseWriter, r *http.Request) {
+			renderEmbed(w, r, mailer, site, testEmail, nil, "")
 		})
 	}
 	// for Google App Engine
@@ -248,6 +492,42 @@ type ConfigGroup struct {
 	ID         string             `yaml:"id"`
 	Name       string             `yaml:"name"`
 	Recipients []*ConfigRecipient `yaml:"recipients"`
+
+	// Markdown text displayed beneath the group name.
+	Description string `yaml:"description"`
+	// Groups with the same category are listed together under a heading.
+	Category string `yaml:"category"`
+	// Groups are listed in ascending order, then by ID.
+	Order int `yaml:"order"`
+	// Unlisted groups can be reached at /<id> but are hidden from the
+	// homepage.
+	Unlisted bool `yaml:"unlisted"`
+
+	// If set, letters can only be sent between these times. Use RFC 3339
+	// ("2018-04-03T17:00:00-07:00") or "2018-04-03 17:00"; times without an
+	// offset are interpreted in TimeZone.
+	OpensAt  string `yaml:"opens_at"`
+	ClosesAt string `yaml:"closes_at"`
+	// An IANA time zone name, like "America/Los_Angeles". Defaults to UTC.
+	TimeZone string `yaml:"time_zone"`
+	// Markdown text displayed once the group has closed, e.g. the result of
+	// the vote, or what to do next.
+	ClosedMessage string `yaml:"closed_message"`
+	// Who can see and send to the group: email addresses, domains, or roles
+	// defined in the top level "roles" setting. If empty, anyone can.
+	Senders []string `yaml:"senders"`
+	// A Workspace mailbox, like "campaigns@union.example.org", to send letters
+	// to the group from, instead of the sender's own address. Requires
+	// service_account_file and senders.
+	SendAs string `yaml:"send_as"`
+	// Overrides the site-wide gmail_label setting for this group.
+	GmailLabel string `yaml:"gmail_label"`
+	// Overrides the site-wide recipient_addresses setting for this group.
+	RecipientAddresses string `yaml:"recipient_addresses"`
+	// The language the group's page is shown in, unless the visitor has
+	// picked another one, e.g. "es". Defaults to the visitor's browser
+	// language.
+	Locale string `yaml:"locale"`
 }
 
 type ConfigRecipient struct {
@@ -257,7 +537,7 @@ type ConfigRecipient struct {
 }
 
 type FileConfig struct {
-	SecretKey      string         `yaml:"secret_key"`
+	SecretKey      secretKeyList  `yaml:"secret_key"`
 	PublicHost     string         `yaml:"public_host"`
 	HTTPOnly       bool           `yaml:"http_only"`
 	GoogleClientID string         `yaml:"google_client_id"`
@@ -269,9 +549,111 @@ type FileConfig struct {
 	// For development; ignore Google authentication.
 	NoGoogleAuth bool `yaml:"no_google_auth"`
 
-	// For TLS configuration.
+	// If set, visitors can also sign in with a Microsoft account, and send
+	// through Microsoft Graph. See MicrosoftConfig.
+	MicrosoftClientID string `yaml:"microsoft_client_id"`
+	MicrosoftSecret   string `yaml:"microsoft_secret"`
+	MicrosoftTenant   string `yaml:"microsoft_tenant"`
+
+	// A service account key with domain-wide delegation, used to send as
+	// users in delegated_domains without asking for their permission; see
+	// workspace.go.
+	ServiceAccountFile string   `yaml:"service_account_file"`
+	DelegatedDomains   []string `yaml:"delegated_domains"`
+	// Only ask Google users for their address when they sign in, not for
+	// permission to send. Everyone who signs in with Google must then be in
+	// delegated_domains.
+	EmailScopeOnly bool `yaml:"email_scope_only"`
+
+	// Let Google users pick which of their Gmail send-as addresses letters
+	// come from. Asks for permission to read their Gmail settings.
+	SendAsAliases bool `yaml:"send_as_aliases"`
+
+	// Details senders must give, which are added to the end of their
+	// letters: any of "name", "address", "zip" and "phone".
+	SenderFields []string `yaml:"sender_fields"`
+
+	// Named lists of email addresses and domains, like "staff", which groups
+	// can use in their "senders" setting.
+	Roles map[string][]string `yaml:"roles"`
+
+	// Limit who can sign in; see SignInPolicy. Blocked addresses and domains
+	// take precedence over allowed ones.
+	AllowedEmails  []string `yaml:"allowed_emails"`
+	AllowedDomains []string `yaml:"allowed_domains"`
+	BlockedEmails  []string `yaml:"blocked_emails"`
+	BlockedDomains []string `yaml:"blocked_domains"`
+	// Google Workspace domains users must belong to.
+	HostedDomains []string `yaml:"hosted_domains"`
+
+	// Where to listen, if not on port: a TCP address like "127.0.0.1:8048", a
+	// Unix socket like "unix:/run/multi-emailer.sock", or "systemd" (or
+	// "systemd:<name>") for a socket passed by systemd. See Listen.
+	Listen string `yaml:"listen"`
+	// If set, also listen for plain HTTP here, and redirect it to HTTPS.
+	HTTPRedirectAddr string `yaml:"http_redirect_addr"`
+	// IP addresses or CIDRs like "10.0.0.0/8" of reverse proxies whose
+	// X-Forwarded-For, X-Forwarded-Proto and X-Forwarded-Host headers we
+	// believe. If set, those headers are ignored on requests from anywhere
+	// else. If not, they're passed on as they are, since platforms like App
+	// Engine and Heroku set X-Forwarded-Proto from addresses we can't list.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+
+	// For TLS configuration. The files are loaded again when they change.
 	CertFile string `yaml:"cert_file"`
 	KeyFile  string `yaml:"key_file"`
+	// The oldest TLS version to accept, e.g. "1.2" (the default).
+	TLSMinVersion string `yaml:"tls_min_version"`
+	// If set, only these cipher suites are offered for TLS 1.2 and older, e.g.
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256".
+	TLSCipherSuites []string `yaml:"tls_cipher_suites"`
+
+	// Server timeouts and limits, e.g. "30s"; see server.go for the defaults.
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes"`
+	// The largest request body to accept, in bytes, for form posts and API
+	// requests.
+	MaxBodyBytes int64 `yaml:"max_body_bytes"`
+
+	// A directory of templates and static files that override the built-in
+	// ones; see theme.go.
+	ThemeDir string `yaml:"theme_dir"`
+
+	// Maps URL paths to Markdown files, e.g. "/privacy: privacy.md".
+	Pages map[string]string `yaml:"pages"`
+
+	// Origins of partner sites that may embed the form for a group in an
+	// iframe, e.g. "https://example.org". Requires an https public_host.
+	EmbedOrigins []string `yaml:"embed_origins"`
+
+	// Overrides DefaultContentSecurityPolicy; "{nonce}" is replaced with a
+	// random value for each request, which inline scripts must carry.
+	ContentSecurityPolicy string `yaml:"content_security_policy"`
+	// Overrides DefaultReferrerPolicy.
+	ReferrerPolicy string `yaml:"referrer_policy"`
+
+	// Serve Prometheus metrics at /metrics.
+	Metrics bool `yaml:"metrics"`
+	// If set, serve /metrics on this address (e.g. "127.0.0.1:9090") instead
+	// of on the main site.
+	MetricsAddr string `yaml:"metrics_addr"`
+
+	// How long to wait for letters that are being sent to finish after the
+	// server gets SIGINT or SIGTERM, e.g. "1m". Defaults to
+	// DefaultShutdownGracePeriod.
+	ShutdownGracePeriod time.Duration `yaml:"shutdown_grace_period"`
+
+	// How recipient email addresses appear on public pages like
+	// /<id>/recipients: "show" (the default), "obfuscate" or "hide".
+	RecipientAddresses string `yaml:"recipient_addresses"`
+
+	// A Gmail label, like "Letters to City Hall", to add to letters users
+	// send, so they're easy to find. Created in their mailbox when needed.
+	// Asks Google users for permission to modify their mail.
+	GmailLabel string `yaml:"gmail_label"`
 
 	// Should be a string like "google4f9d0c78202b2454.html". If non-empty and
 	// not starting with "google", it will be prepended. If it does not end with
@@ -312,6 +694,14 @@ func getSecretKey(hexKey string) (*[32]byte, error) {
 	return secretKey, nil
 }
 
+// renderMarkdown converts trusted Markdown from the config file to HTML.
+func renderMarkdown(md string) template.HTML {
+	if strings.TrimSpace(md) == "" {
+		return ""
+	}
+	return template.HTML(blackfriday.MarkdownCommon([]byte(md)))
+}
+
 func validID(id string) bool {
 	return validIDRx.MatchString(id)
 }
@@ -328,12 +718,17 @@ func loadConfig(filename string) (*FileConfig, error) {
 	return c, nil
 }
 
-func commonMain() (*FileConfig, http.Handler) {
+func commonMain() (*FileConfig, *Mailer, http.Handler) {
 	flag.Parse()
 	if flag.NArg() > 2 {
 		os.Stderr.WriteString("too many arguments")
 		os.Exit(2)
 	}
+	if flag.Arg(0) == "genkey" {
+		// Print a new key to add to the front of secret_key.
+		fmt.Println(hex.EncodeToString(NewRandomKey()[:]))
+		os.Exit(0)
+	}
 	c, err := loadConfig(*cfg)
 	if err != nil {
 		logger.Error("Error loading/parsing config file", "err", err)
@@ -342,12 +737,39 @@ func commonMain() (*FileConfig, http.Handler) {
 	if *check {
 		os.Exit(0)
 	}
-	key, err := getSecretKey(c.SecretKey)
+	keys, err := getSecretKeys(c.SecretKey)
 	if err != nil {
 		logger.Error("Error getting secret key", "err", err)
 		os.Exit(2)
 	}
-	m := &Mailer{Groups: make(map[string]*Group), Logger: logger, secretKey: key}
+	m := &Mailer{Groups: make(map[string]*Group), Logger: logger, secrets: NewSecrets(keys), sendAsAliases: c.SendAsAliases}
+	if c.ServiceAccountFile != "" {
+		keyJSON, err := ioutil.ReadFile(c.ServiceAccountFile)
+		if err != nil {
+			logger.Error("Could not read service account key", "err", err)
+			os.Exit(2)
+		}
+		m.delegation, err = NewDelegation(keyJSON, c.DelegatedDomains)
+		if err != nil {
+			logger.Error("Invalid service account settings", "err", err)
+			os.Exit(2)
+		}
+		m.delegation.Required = c.EmailScopeOnly
+	} else if c.EmailScopeOnly || len(c.DelegatedDomains) > 0 {
+		logger.Error("email_scope_only and delegated_domains require service_account_file")
+		os.Exit(2)
+	}
+	for i := range c.SenderFields {
+		c.SenderFields[i] = strings.ToLower(strings.TrimSpace(c.SenderFields[i]))
+	}
+	if err := validIdentityFields(c.SenderFields); err != nil {
+		logger.Error("Invalid sender_fields setting", "err", err)
+		os.Exit(2)
+	}
+	m.identityFields = c.SenderFields
+	// Whether any group has a Gmail label, so we need to ask for permission
+	// to apply it.
+	labels := false
 	for _, group := range c.Groups {
 		if group.ID == "" {
 			logger.Error("Please provide a group ID")
@@ -360,6 +782,53 @@ func commonMain() (*FileConfig, http.Handler) {
 		if group.Name == "" {
 			group.Name = group.ID
 		}
+		label := group.GmailLabel
+		if label == "" {
+			label = c.GmailLabel
+		}
+		if label != "" {
+			labels = true
+		}
+		visibility := group.RecipientAddresses
+		if visibility == "" {
+			visibility = c.RecipientAddresses
+		}
+		if visibility == "" {
+			visibility = addressesShow
+		}
+		if !validAddressVisibility(visibility) {
+			logger.Error("Invalid recipient_addresses setting, use show, obfuscate or hide", "id", group.ID, "value", visibility)
+			os.Exit(2)
+		}
+		loc := time.UTC
+		if group.TimeZone != "" {
+			loc, err = time.LoadLocation(group.TimeZone)
+			if err != nil {
+				logger.Error("Invalid time zone", "err", err, "id", group.ID, "time_zone", group.TimeZone)
+				os.Exit(2)
+			}
+		}
+		opensAt, err := parseGroupTime(group.OpensAt, loc)
+		if err != nil {
+			logger.Error("Invalid opens_at time", "err", err, "id", group.ID)
+			os.Exit(2)
+		}
+		closesAt, err := parseGroupTime(group.ClosesAt, loc)
+		if err != nil {
+			logger.Error("Invalid closes_at time", "err", err, "id", group.ID)
+			os.Exit(2)
+		}
+		if !opensAt.IsZero() && !closesAt.IsZero() && !closesAt.After(opensAt) {
+			logger.Error("Group closes before it opens", "id", group.ID, "opens_at", group.OpensAt, "closes_at", group.ClosesAt)
+			os.Exit(2)
+		}
+		locale := group.Locale
+		if locale != "" {
+			if _, ok := catalogs[locale]; !ok {
+				logger.Error("Unsupported locale", "id", group.ID, "locale", group.Locale)
+				os.Exit(2)
+			}
+		}
 		recs := make([]*Recipient, len(group.Recipients))
 		for i, recipient := range group.Recipients {
 			addr, err := mail.ParseAddress(recipient.Email)
@@ -368,7 +837,7 @@ func commonMain() (*FileConfig, http.Handler) {
 				os.Exit(2)
 			}
 			if recipient.OpeningLine == "" {
-				recipient.OpeningLine = "To whom it may concern"
+				recipient.OpeningLine = translate(locale, "To whom it may concern")
 			}
 			ccs := make([]mail.Address, len(recipient.CC))
 			for i := range recipient.CC {
@@ -385,10 +854,46 @@ func commonMain() (*FileConfig, http.Handler) {
 				OpeningLine: recipient.OpeningLine,
 			}
 		}
+		senders, err := ParseSenders(group.Senders, c.Roles)
+		if err != nil {
+			logger.Error("Invalid senders", "err", err, "group", group.ID)
+			os.Exit(2)
+		}
+		var sendAs *mail.Address
+		if group.SendAs != "" {
+			sendAs, err = mail.ParseAddress(group.SendAs)
+			if err != nil {
+				logger.Error("Could not parse send_as address", "err", err, "group", group.ID)
+				os.Exit(2)
+			}
+			if !m.delegation.covers(sendAs.Address) {
+				logger.Error("send_as address isn't in delegated_domains", "group", group.ID, "send_as", group.SendAs)
+				os.Exit(2)
+			}
+			// Otherwise anyone could send as the organization.
+			if senders == nil {
+				logger.Error("Groups with send_as must limit who can send with senders", "group", group.ID)
+				os.Exit(2)
+			}
+		}
 		m.Groups[group.ID] = &Group{
-			ID:         group.ID,
-			Name:       group.Name,
-			Recipients: recs,
+			ID:          group.ID,
+			Name:        group.Name,
+			Recipients:  recs,
+			Description: renderMarkdown(group.Description),
+			Category:    group.Category,
+			Order:       group.Order,
+			Unlisted:    group.Unlisted,
+
+			OpensAt:       opensAt,
+			ClosesAt:      closesAt,
+			ClosedMessage: renderMarkdown(group.ClosedMessage),
+
+			AddressVisibility: visibility,
+			Locale:            locale,
+			Senders:           senders,
+			SendAs:            sendAs,
+			GmailLabel:        label,
 		}
 	}
 	if c.Port == nil {
@@ -415,12 +920,12 @@ func commonMain() (*FileConfig, http.Handler) {
 		if u.Scheme == "" {
 			u.Scheme = "http"
 		}
-		host = u.String()
+		host = strings.TrimSuffix(u.String(), "/")
 	} else {
 		host = "http://localhost:" + strconv.Itoa(*c.Port)
 	}
 	gcfg := google.Config{
-		SecretKey:               key,
+		SecretKey:               m.secrets.Auth[0],
 		BaseURL:                 host,
 		AllowUnencryptedTraffic: true,
 		ClientID:                c.GoogleClientID,
@@ -430,6 +935,17 @@ func commonMain() (*FileConfig, http.Handler) {
 			gmail.GmailSendScope,
 		},
 	}
+	if c.EmailScopeOnly {
+		gcfg.Scopes = []string{"email"}
+	}
+	if c.SendAsAliases {
+		gcfg.Scopes = append(gcfg.Scopes, gmail.GmailSettingsBasicScope)
+	}
+	// Users only send through their own mailbox if they're asked for
+	// permission to send.
+	if labels && !c.EmailScopeOnly {
+		gcfg.Scopes = append(gcfg.Scopes, gmail.GmailModifyScope)
+	}
 	if c.GoogleSiteVerification != "" {
 		if !strings.HasPrefix(c.GoogleSiteVerification, "google") {
 			c.GoogleSiteVerification = "google" + c.GoogleSiteVerification
@@ -439,7 +955,118 @@ func commonMain() (*FileConfig, http.Handler) {
 		}
 	}
 	authenticator := google.NewAuthenticator(gcfg)
-	mux := NewServeMux(authenticator, m, c.Title, !c.NoGoogleAuth, c.PublicHost, c.GoogleSiteVerification)
+	m.googleOAuth = &oauth2.Config{
+		ClientID:     c.GoogleClientID,
+		ClientSecret: c.GoogleSecret,
+		Endpoint:     googleoauth.Endpoint,
+		Scopes:       gcfg.Scopes,
+	}
+	theme, err := LoadTheme(c.ThemeDir)
+	if err != nil {
+		logger.Error("Error loading theme", "err", err, "theme_dir", c.ThemeDir)
+		os.Exit(2)
+	}
+	for path, filename := range c.Pages {
+		if err := validPagePath(path, m.Groups); err != nil {
+			logger.Error("Invalid page", "err", err)
+			os.Exit(2)
+		}
+		if _, err := os.Stat(filename); err != nil {
+			logger.Error("Could not find page", "err", err, "path", path)
+			os.Exit(2)
+		}
+	}
+	if c.MaxBodyBytes < 0 {
+		logger.Error("max_body_bytes can't be negative", "max_body_bytes", c.MaxBodyBytes)
+		os.Exit(2)
+	}
+	if c.MaxBodyBytes == 0 {
+		c.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if c.ShutdownGracePeriod < 0 {
+		logger.Error("shutdown_grace_period can't be negative", "shutdown_grace_period", c.ShutdownGracePeriod)
+		os.Exit(2)
+	}
+	if c.ShutdownGracePeriod == 0 {
+		c.ShutdownGracePeriod = DefaultShutdownGracePeriod
+	}
+	for _, origin := range c.EmbedOrigins {
+		if err := validEmbedOrigin(origin); err != nil {
+			logger.Error("Invalid embed origin", "err", err)
+			os.Exit(2)
+		}
+	}
+	if len(c.EmbedOrigins) > 0 && !strings.HasPrefix(c.PublicHost, "https://") {
+		logger.Error("Embedding requires an https:// public_host, since browsers only send cookies to cross-site iframes over HTTPS", "public_host", c.PublicHost)
+		os.Exit(2)
+	}
+	trustedProxies, err := ParseTrustedProxies(c.TrustedProxies)
+	if err != nil {
+		logger.Error("Invalid trusted_proxies", "err", err)
+		os.Exit(2)
+	}
+	basePath := BasePath(c.PublicHost)
+	var signIn *SignInPolicy
+	if len(c.AllowedEmails) > 0 || len(c.AllowedDomains) > 0 || len(c.BlockedEmails) > 0 || len(c.BlockedDomains) > 0 || len(c.HostedDomains) > 0 {
+		signIn = &SignInPolicy{
+			AllowEmails:   c.AllowedEmails,
+			AllowDomains:  c.AllowedDomains,
+			BlockEmails:   c.BlockedEmails,
+			BlockDomains:  c.BlockedDomains,
+			HostedDomains: c.HostedDomains,
+		}
+		if err := signIn.Validate(); err != nil {
+			logger.Error("Invalid sign in settings", "err", err)
+			os.Exit(2)
+		}
+	}
+	var microsoft *MicrosoftAuthenticator
+	if c.MicrosoftClientID != "" {
+		if c.MicrosoftSecret == "" {
+			logger.Error("microsoft_client_id is set, but microsoft_secret isn't")
+			os.Exit(2)
+		}
+		microsoft = NewMicrosoftAuthenticator(MicrosoftConfig{
+			ClientID:                c.MicrosoftClientID,
+			Secret:                  c.MicrosoftSecret,
+			Tenant:                  c.MicrosoftTenant,
+			BaseURL:                 host,
+			AllowUnencryptedTraffic: true,
+		}, m.secrets)
+		// Only trust addresses Microsoft vouches for if they decide who can
+		// sign in, or who can see and send to a group.
+		microsoft.RequireVerified = signIn != nil
+		for _, group := range m.Groups {
+			if group.Senders != nil {
+				microsoft.RequireVerified = true
+			}
+		}
+	}
+	mux := NewServeMux(authenticator, m, &Site{
+		Title:            c.Title,
+		WithGoogle:       !c.NoGoogleAuth,
+		PublicHost:       c.PublicHost,
+		BasePath:         basePath,
+		SiteVerification: c.GoogleSiteVerification,
+		Theme:            theme,
+		Pages:            c.Pages,
+		EmbedOrigins:     c.EmbedOrigins,
+		Metrics:          c.Metrics && c.MetricsAddr == "",
+		SignIn:           signIn,
+		Microsoft:        microsoft,
+	})
+	sameSite := http.SameSiteLaxMode
+	if len(c.EmbedOrigins) > 0 {
+		sameSite = http.SameSiteNoneMode
+	}
+	mux = ResealAuth(mux, m.secrets.resealKeys())
+	mux = PathPrefix(mux, basePath)
+	mux = LimitBody(mux, c.MaxBodyBytes, m.secrets.Flash, basePath+"/")
+	mux = SameSite(mux, sameSite)
+	mux = SecurityHeaders(mux, SecurityPolicy{
+		ContentSecurityPolicy: c.ContentSecurityPolicy,
+		ReferrerPolicy:        c.ReferrerPolicy,
+	})
 	mux = handlers.UUID(mux)
 	if strings.HasPrefix(c.PublicHost, "https://") {
 		mux = handlers.RedirectProto(mux)
@@ -449,5 +1076,11 @@ func commonMain() (*FileConfig, http.Handler) {
 	mux = handlers.Debug(mux)
 	mux = handlers.Log(mux)
 	mux = handlers.Duration(mux)
-	return c, mux
+	if c.Metrics || c.MetricsAddr != "" {
+		mux = Metrics(mux)
+	}
+	if len(trustedProxies) > 0 {
+		mux = TrustProxies(mux, trustedProxies)
+	}
+	return c, m, mux
 }
@@ -19,7 +19,7 @@ func init() {
 		w.WriteHeader(500)
 		w.Write([]byte("<html><body>Server Error</body></html>"))
 	}))
-	_, mux := commonMain()
+	_, _, mux := commonMain()
 	http.Handle("/", mux)
 }
 
@@ -3,23 +3,47 @@
 package main
 
 import (
+	"context"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 )
 
 func main() {
-	c, mux := commonMain()
-	addr := ":" + strconv.Itoa(*c.Port)
-	if c.HTTPOnly {
-		ln, err := net.Listen("tcp", addr)
+	c, mailer, mux := commonMain()
+	addr := c.Listen
+	if addr == "" {
+		addr = ":" + strconv.Itoa(*c.Port)
+	}
+	srv, err := NewServer(c, addr, mux)
+	if err != nil {
+		logger.Error("Invalid server configuration", "err", err)
+		os.Exit(2)
+	}
+	servers := []*http.Server{srv}
+	serveErr := make(chan error, 3)
+	if c.MetricsAddr != "" {
+		metricsSrv, err := NewServer(c, c.MetricsAddr, MetricsHandler())
 		if err != nil {
-			logger.Error("Error listening", "addr", addr, "err", err)
+			logger.Error("Invalid server configuration", "err", err)
+			os.Exit(2)
+		}
+		servers = append(servers, metricsSrv)
+		metricsLn := listen(c.MetricsAddr)
+		logger.Info("Started metrics server", "addr", metricsLn.Addr().String())
+		go func() { serveErr <- metricsSrv.Serve(metricsLn) }()
+	}
+	if c.HTTPOnly {
+		if c.HTTPRedirectAddr != "" {
+			logger.Error("http_redirect_addr can't be used with http_only", "http_redirect_addr", c.HTTPRedirectAddr)
 			os.Exit(2)
 		}
-		logger.Info("Started server", "protocol", "http", "port", *c.Port)
-		http.Serve(ln, mux)
+		ln := listen(addr)
+		logger.Info("Started server", "protocol", "http", "addr", ln.Addr().String())
+		go func() { serveErr <- srv.Serve(ln) }()
 	} else {
 		if c.CertFile == "" {
 			c.CertFile = "leaf.pem"
@@ -35,8 +59,66 @@ func main() {
 			logger.Error("Could not find a key file; generate using 'make generate_cert'", "file", c.KeyFile)
 			os.Exit(2)
 		}
-		logger.Info("Starting server", "protocol", "https", "port", *c.Port)
-		listenErr := http.ListenAndServeTLS(addr, c.CertFile, c.KeyFile, mux)
-		logger.Error("server shut down", "err", listenErr)
+		certs, err := newCertReloader(c.CertFile, c.KeyFile)
+		if err != nil {
+			logger.Error("Could not load TLS certificate", "file", c.CertFile, "err", err)
+			os.Exit(2)
+		}
+		srv.TLSConfig.GetCertificate = certs.GetCertificate
+		ln := listen(addr)
+		logger.Info("Started server", "protocol", "https", "addr", ln.Addr().String())
+		go func() { serveErr <- srv.ServeTLS(ln, "", "") }()
+
+		if c.HTTPRedirectAddr != "" {
+			httpsPort := *c.Port
+			if _, port, err := net.SplitHostPort(addr); err == nil {
+				if n, err := strconv.Atoi(port); err == nil {
+					httpsPort = n
+				}
+			}
+			redirectSrv, err := NewServer(c, c.HTTPRedirectAddr, RedirectToHTTPS(c.PublicHost, httpsPort))
+			if err != nil {
+				logger.Error("Invalid server configuration", "err", err)
+				os.Exit(2)
+			}
+			servers = append(servers, redirectSrv)
+			redirectLn := listen(c.HTTPRedirectAddr)
+			logger.Info("Started server", "protocol", "http", "addr", redirectLn.Addr().String(), "redirect", "https")
+			go func() { serveErr <- redirectSrv.Serve(redirectLn) }()
+		}
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		logger.Error("server shut down", "err", err)
+		os.Exit(2)
+	case sig := <-sigs:
+		logger.Info("Shutting down", "signal", sig.String(), "grace_period", c.ShutdownGracePeriod.String())
+	}
+	// Stop accepting connections, then give letters that are being sent -
+	// including ones sent in the background after their request finished -
+	// until the end of the grace period to finish.
+	ctx, cancel := context.WithTimeout(context.Background(), c.ShutdownGracePeriod)
+	defer cancel()
+	for _, srv := range servers {
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Error("Error shutting down server", "addr", srv.Addr, "err", err)
+		}
+	}
+	if !mailer.Drain(ctx) {
+		os.Exit(1)
+	}
+	logger.Info("Server stopped")
+}
+
+// listen calls Listen, exiting if it fails.
+func listen(addr string) net.Listener {
+	ln, err := Listen(addr)
+	if err != nil {
+		logger.Error("Error listening", "addr", addr, "err", err)
+		os.Exit(2)
 	}
+	return ln
 }
@@ -1,19 +1,23 @@
 package main
 
 import (
+	"encoding/json"
+	"net/http"
 	"net/http/httptest"
 	"net/mail"
 	"strings"
 	"testing"
+	"time"
 
 	google "github.com/kevinburke/google-oauth-handler"
+	"golang.org/x/oauth2"
 )
 
 func TestServerReturns200(t *testing.T) {
 	t.Parallel()
 	mux := NewServeMux(google.NewAuthenticator(google.Config{
 		SecretKey: NewRandomKey(),
-	}), nil, "", false, "", "")
+	}), nil, nil)
 	req := httptest.NewRequest("GET", "/", nil)
 	w := httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
@@ -26,7 +30,7 @@ func TestSiteVerification(t *testing.T) {
 	t.Parallel()
 	mux := NewServeMux(google.NewAuthenticator(google.Config{
 		SecretKey: NewRandomKey(),
-	}), nil, "", false, "", "google4f9d0c78202b2454.html")
+	}), nil, &Site{SiteVerification: "google4f9d0c78202b2454.html"})
 	req := httptest.NewRequest("GET", "/google4f9d0c78202b2454.html", nil)
 	w := httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
@@ -43,7 +47,7 @@ func TestPrivacyPolicy(t *testing.T) {
 	t.Parallel()
 	mux := NewServeMux(google.NewAuthenticator(google.Config{
 		SecretKey: NewRandomKey(),
-	}), nil, "", false, "", "")
+	}), nil, nil)
 	req := httptest.NewRequest("GET", "/privacy", nil)
 	w := httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
@@ -73,7 +77,7 @@ func TestRecipients(t *testing.T) {
 	}}
 	mux := NewServeMux(google.NewAuthenticator(google.Config{
 		SecretKey: NewRandomKey(),
-	}), mailer, "", false, "", "")
+	}), mailer, nil)
 	req := httptest.NewRequest("GET", "/test-group-slug/recipients", nil)
 	w := httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
@@ -113,3 +117,137 @@ func TestValidID(t *testing.T) {
 		}
 	}
 }
+
+func TestHomepageGroupListing(t *testing.T) {
+	t.Parallel()
+	addr, _ := mail.ParseAddress("Recipient <recipient@example.com>")
+	recipients := []*Recipient{{Address: *addr, OpeningLine: "Dear Test"}}
+	mailer := &Mailer{Groups: map[string]*Group{
+		"alpha":  {ID: "alpha", Name: "Alpha Group", Recipients: recipients, Order: 2, Category: "Board"},
+		"beta":   {ID: "beta", Name: "Beta Group", Recipients: recipients, Order: 1, Category: "Board", Description: "<p>Hearing on Tuesday</p>"},
+		"hidden": {ID: "hidden", Name: "Hidden Group", Recipients: recipients, Unlisted: true},
+		"closed": {ID: "closed", Name: "Closed Group", Recipients: recipients, ClosesAt: time.Now().Add(-time.Hour), ClosedMessage: "<p>The vote passed</p>"},
+	}}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), mailer, nil)
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	b := w.Body.String()
+	if strings.Contains(b, "Hidden Group") {
+		t.Errorf("GET /: unlisted group should not appear on the homepage")
+	}
+	if !strings.Contains(b, "Board") || !strings.Contains(b, "Hearing on Tuesday") {
+		t.Errorf("GET /: should see category and description, got %s", b)
+	}
+	if !strings.Contains(b, "Archived") || !strings.Contains(b, "The vote passed") {
+		t.Errorf("GET /: closed group should be archived, got %s", b)
+	}
+	if strings.Contains(b, `value="closed"`) {
+		t.Errorf("GET /: closed group should not be selectable")
+	}
+	if alpha, beta := strings.Index(b, "Alpha Group"), strings.Index(b, "Beta Group"); alpha < beta {
+		t.Errorf("GET /: groups should be listed by order, got alpha at %d, beta at %d", alpha, beta)
+	}
+
+	req = httptest.NewRequest("GET", "/hidden", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Errorf("GET /hidden: got code %d, want 200", w.Code)
+	}
+	if b := w.Body.String(); !strings.Contains(b, "Hidden Group") {
+		t.Errorf("GET /hidden: should see unlisted group, got %s", b)
+	}
+}
+
+// authCookie returns a cookie that the Google authenticator will accept as a
+// logged in session for addr, without contacting Google.
+func authCookie(t *testing.T, key *[32]byte, addr string) *http.Cookie {
+	t.Helper()
+	email, err := mail.ParseAddress(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiry := time.Now().Add(time.Hour)
+	b, err := json.Marshal(map[string]interface{}{
+		"Email":  email,
+		"Token":  &oauth2.Token{AccessToken: "test-access-token", Expiry: expiry},
+		"Expiry": expiry,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Cookie{Name: "google-oauth-token", Value: opaqueByte(b, key)}
+}
+
+var recipientFormatTests = []struct {
+	path   string
+	accept string
+	ctype  string
+	want   []string
+}{
+	{"/test-group-slug/recipients.json", "", "application/json", []string{`"email": "recipient@example.com"`, `"opening_line": "Dear Test Group"`}},
+	{"/test-group-slug/recipients", "application/json", "application/json", []string{`"email": "recipient@example.com"`}},
+	{"/test-group-slug/recipients.csv", "", "text/csv", []string{"name,email,cc,opening_line\n", "Recipient,recipient@example.com,CC <cc@example.com>,Dear Test Group\n"}},
+	{"/test-group-slug/recipients", "text/vcard", "text/vcard", []string{"BEGIN:VCARD\r\n", "FN:Recipient\r\n", "EMAIL;TYPE=INTERNET:recipient@example.com\r\n"}},
+	{"/test-group-slug/recipients", "text/html,*/*", "text/plain", []string{"address: recipient@example.com"}},
+}
+
+func TestRecipientFormats(t *testing.T) {
+	t.Parallel()
+	mailer := &Mailer{Groups: map[string]*Group{
+		"test-group-slug": group,
+	}}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), mailer, nil)
+	for _, tt := range recipientFormatTests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		if tt.accept != "" {
+			req.Header.Set("Accept", tt.accept)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != 200 {
+			t.Errorf("GET %s: got code %d, want 200", tt.path, w.Code)
+			continue
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.ctype) {
+			t.Errorf("GET %s (Accept %q): got Content-Type %q, want %q", tt.path, tt.accept, ct, tt.ctype)
+		}
+		for _, want := range tt.want {
+			if b := w.Body.String(); !strings.Contains(b, want) {
+				t.Errorf("GET %s (Accept %q): should contain %q, got %q", tt.path, tt.accept, want, b)
+			}
+		}
+	}
+}
+
+func TestRecipientsHideAddresses(t *testing.T) {
+	t.Parallel()
+	for _, visibility := range []string{addressesObfuscate, addressesHide} {
+		g := *group
+		g.AddressVisibility = visibility
+		mailer := &Mailer{Groups: map[string]*Group{"test-group-slug": &g}}
+		mux := NewServeMux(google.NewAuthenticator(google.Config{
+			SecretKey: NewRandomKey(),
+		}), mailer, nil)
+		for _, path := range []string{"/test-group-slug/recipients", "/test-group-slug/recipients.json", "/test-group-slug/recipients.csv", "/test-group-slug/recipients.vcf", "/v1/groups"} {
+			req := httptest.NewRequest("GET", path, nil)
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+			b := w.Body.String()
+			if strings.Contains(b, "recipient@example.com") || strings.Contains(b, "cc@example.com") {
+				t.Errorf("GET %s with %s addresses: should not contain raw address, got %s", path, visibility, b)
+			}
+			if !strings.Contains(b, "Recipient") {
+				t.Errorf("GET %s with %s addresses: should still contain name, got %s", path, visibility, b)
+			}
+			if visibility == addressesObfuscate && path != "/test-group-slug/recipients.vcf" && !strings.Contains(b, "r***@example.com") {
+				t.Errorf("GET %s: should contain obfuscated address, got %s", path, b)
+			}
+		}
+	}
+}
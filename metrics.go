@@ -0,0 +1,295 @@
+package main
+
+// Prometheus metrics, served at /metrics in the text exposition format. We only
+// need counters and histograms, so they're implemented here rather than
+// pulling in the Prometheus client library.
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	lettersTotal = newCounterVec("multi_emailer_letters_total",
+		"Letters sent to a single recipient, by group and outcome (sent or failed). Unlisted and restricted groups are counted as \"private\".", "group", "outcome")
+	gmailRetriesTotal = newCounterVec("multi_emailer_gmail_retries_total",
+		"Retried Gmail API calls, by HTTP status code.", "code")
+	graphRetriesTotal = newCounterVec("multi_emailer_graph_retries_total",
+		"Retried Microsoft Graph API calls, by HTTP status code.", "code")
+	semaphoreWaitSeconds = newHistogramVec("multi_emailer_semaphore_wait_seconds",
+		"Time spent waiting for a slot to call the Gmail or Microsoft Graph API.", defaultBuckets)
+	sendDurationSeconds = newHistogramVec("multi_emailer_send_duration_seconds",
+		"Time to send a letter to a single recipient, including retries, by outcome.", defaultBuckets, "outcome")
+	oauthLoginsTotal = newCounterVec("multi_emailer_oauth_logins_total",
+		"Google and Microsoft sign in attempts, by result (success or failure).", "result")
+	httpRequestsTotal = newCounterVec("multi_emailer_http_requests_total",
+		"HTTP requests, by method and status code.", "method", "code")
+	httpRequestDurationSeconds = newHistogramVec("multi_emailer_http_request_duration_seconds",
+		"Time to serve HTTP requests, by method.", defaultBuckets, "method")
+)
+
+// privateGroupLabel is the group label for unlisted and restricted groups,
+// whose IDs would otherwise be listed for anyone who can read /metrics.
+const privateGroupLabel = "private"
+
+// groupLabel returns the group label for letters to g.
+func groupLabel(g *Group) string {
+	if g.Unlisted || g.Senders != nil {
+		return privateGroupLabel
+	}
+	return g.ID
+}
+
+// defaultBuckets are the upper bounds, in seconds, of histogram buckets.
+var defaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+type metric interface {
+	write(buf *bytes.Buffer)
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []metric
+)
+
+func register(m metric) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry = append(registry, m)
+}
+
+// labelSep joins label values into a map key; it can't appear in UTF-8 text.
+const labelSep = "\xff"
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels returns names and values as `{name="value",...}`, with extra
+// appended, or the empty string if there are no labels.
+func formatLabels(names []string, key string, extra ...string) string {
+	var pairs []string
+	if len(names) > 0 {
+		values := strings.Split(key, labelSep)
+		for i, name := range names {
+			pairs = append(pairs, name+`="`+labelEscaper.Replace(values[i])+`"`)
+		}
+	}
+	for i := 0; i+1 < len(extra); i += 2 {
+		pairs = append(pairs, extra[i]+`="`+extra[i+1]+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func writeHeader(buf *bytes.Buffer, name, help, typ string) {
+	buf.WriteString("# HELP " + name + " " + help + "\n")
+	buf.WriteString("# TYPE " + name + " " + typ + "\n")
+}
+
+type counterVec struct {
+	name   string
+	help   string
+	labels []string
+
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+func newCounterVec(name, help string, labels ...string) *counterVec {
+	c := &counterVec{name: name, help: help, labels: labels, values: make(map[string]float64)}
+	register(c)
+	return c
+}
+
+// inc adds one to the counter with the given label values.
+func (c *counterVec) inc(values ...string) {
+	c.mu.Lock()
+	c.values[strings.Join(values, labelSep)]++
+	c.mu.Unlock()
+}
+
+func (c *counterVec) get(values ...string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[strings.Join(values, labelSep)]
+}
+
+func (c *counterVec) write(buf *bytes.Buffer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	writeHeader(buf, c.name, c.help, "counter")
+	keys := make([]string, 0, len(c.values))
+	for key := range c.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		buf.WriteString(c.name + formatLabels(c.labels, key) + " " + formatFloat(c.values[key]) + "\n")
+	}
+}
+
+type histogram struct {
+	counts []uint64 // one per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+type histogramVec struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*histogram
+}
+
+func newHistogramVec(name, help string, buckets []float64, labels ...string) *histogramVec {
+	h := &histogramVec{name: name, help: help, labels: labels, buckets: buckets, series: make(map[string]*histogram)}
+	register(h)
+	return h
+}
+
+func (h *histogramVec) observe(v float64, values ...string) {
+	key := strings.Join(values, labelSep)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogram{counts: make([]uint64, len(h.buckets))}
+		h.series[key] = s
+	}
+	for i, le := range h.buckets {
+		if v <= le {
+			s.counts[i]++
+			break
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+// observeSince records the time since start, in seconds.
+func (h *histogramVec) observeSince(start time.Time, values ...string) {
+	h.observe(time.Since(start).Seconds(), values...)
+}
+
+func (h *histogramVec) write(buf *bytes.Buffer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	writeHeader(buf, h.name, h.help, "histogram")
+	keys := make([]string, 0, len(h.series))
+	for key := range h.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := h.series[key]
+		var cumulative uint64
+		for i, le := range h.buckets {
+			cumulative += s.counts[i]
+			buf.WriteString(h.name + "_bucket" + formatLabels(h.labels, key, "le", formatFloat(le)) + " " + strconv.FormatUint(cumulative, 10) + "\n")
+		}
+		buf.WriteString(h.name + "_bucket" + formatLabels(h.labels, key, "le", "+Inf") + " " + strconv.FormatUint(s.count, 10) + "\n")
+		buf.WriteString(h.name + "_sum" + formatLabels(h.labels, key) + " " + formatFloat(s.sum) + "\n")
+		buf.WriteString(h.name + "_count" + formatLabels(h.labels, key) + " " + strconv.FormatUint(s.count, 10) + "\n")
+	}
+}
+
+// serveMetrics writes every registered metric in the Prometheus text format.
+func serveMetrics(w http.ResponseWriter, r *http.Request) {
+	buf := new(bytes.Buffer)
+	registryMu.Lock()
+	for _, m := range registry {
+		m.write(buf)
+	}
+	registryMu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(buf.Bytes())
+}
+
+// MetricsHandler serves only /metrics, for a server on metrics_addr that can
+// be kept off the public internet.
+func MetricsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", serveMetrics)
+	return mux
+}
+
+// knownMethods bounds the number of distinct method labels.
+var knownMethods = map[string]bool{
+	"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "OPTIONS": true,
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (s *statusWriter) WriteHeader(code int) {
+	if s.code == 0 {
+		s.code = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusWriter) Write(b []byte) (int, error) {
+	if s.code == 0 {
+		s.code = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusWriter) Push(target string, opts *http.PushOptions) error {
+	if pusher, ok := s.ResponseWriter.(http.Pusher); ok {
+		return pusher.Push(target, opts)
+	}
+	return http.ErrNotSupported
+}
+
+// Metrics counts requests to h, and how long they take.
+func Metrics(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+		h.ServeHTTP(sw, r)
+		method := r.Method
+		if !knownMethods[method] {
+			method = "other"
+		}
+		code := sw.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+		httpRequestsTotal.inc(method, strconv.Itoa(code))
+		httpRequestDurationSeconds.observeSince(start, method)
+	})
+}
+
+// authCookieName is the name of the cookie the Google authenticator sets.
+const authCookieName = "google-oauth-token"
+
+// countLogins records whether Google or Microsoft sign in callbacks to h
+// succeed, which we can tell by whether h sets an authentication cookie.
+func countLogins(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r)
+		for _, cookie := range w.Header()["Set-Cookie"] {
+			if strings.HasPrefix(cookie, authCookieName+"=") || strings.HasPrefix(cookie, microsoftCookieName+"=") {
+				oauthLoginsTotal.inc("success")
+				return
+			}
+		}
+		oauthLoginsTotal.inc("failure")
+	})
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func TestMetricsFormat(t *testing.T) {
+	t.Parallel()
+	c := &counterVec{name: "test_total", help: "A test.", labels: []string{"group"}, values: make(map[string]float64)}
+	c.inc(`say "hi"`)
+	c.inc(`say "hi"`)
+	c.inc("board")
+	h := &histogramVec{name: "test_seconds", help: "A test.", buckets: []float64{0.1, 1}, series: make(map[string]*histogram)}
+	h.observe(0.05)
+	h.observe(0.5)
+	h.observe(5)
+	buf := new(bytes.Buffer)
+	c.write(buf)
+	h.write(buf)
+	want := `# HELP test_total A test.
+# TYPE test_total counter
+test_total{group="board"} 1
+test_total{group="say \"hi\""} 2
+# HELP test_seconds A test.
+# TYPE test_seconds histogram
+test_seconds_bucket{le="0.1"} 1
+test_seconds_bucket{le="1"} 2
+test_seconds_bucket{le="+Inf"} 3
+test_seconds_sum 5.55
+test_seconds_count 3
+`
+	if got := buf.String(); got != want {
+		t.Errorf("metrics output:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
+	mux := Metrics(NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), nil, &Site{Metrics: true}))
+	before := httpRequestsTotal.get("GET", "404")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/static/missing.css", nil))
+	if got := httpRequestsTotal.get("GET", "404"); got != before+1 {
+		t.Errorf("want one more 404 request counted, got %v before and %v after", before, got)
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Code != 200 {
+		t.Fatalf("GET /metrics: got code %d, want 200", w.Code)
+	}
+	b := w.Body.String()
+	for _, want := range []string{"# TYPE multi_emailer_letters_total counter", "# TYPE multi_emailer_send_duration_seconds histogram", `multi_emailer_http_requests_total{method="GET",code="404"}`} {
+		if !strings.Contains(b, want) {
+			t.Errorf("GET /metrics: should see %q, got %s", want, b)
+		}
+	}
+
+	// Not served unless enabled.
+	mux = NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), nil, nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Code == 200 {
+		t.Errorf("GET /metrics: should not be served by default")
+	}
+}
+
+func TestGroupLabel(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		group *Group
+		want  string
+	}{
+		{&Group{ID: "board"}, "board"},
+		{&Group{ID: "secret-board", Unlisted: true}, privateGroupLabel},
+		{&Group{ID: "staff", Senders: &SenderList{}}, privateGroupLabel},
+	} {
+		if got := groupLabel(tt.group); got != tt.want {
+			t.Errorf("groupLabel(%q): got %q, want %q", tt.group.ID, got, tt.want)
+		}
+	}
+}
+
+func TestCountLogins(t *testing.T) {
+	t.Parallel()
+	success := oauthLoginsTotal.get("success")
+	failure := oauthLoginsTotal.get("failure")
+	countLogins(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: authCookieName, Value: "token"})
+		http.Redirect(w, r, "/", http.StatusFound)
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/auth/callback", nil))
+	countLogins(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/", http.StatusFound)
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/auth/callback", nil))
+	if got := oauthLoginsTotal.get("success"); got != success+1 {
+		t.Errorf("successful logins: got %v, want %v", got, success+1)
+	}
+	if got := oauthLoginsTotal.get("failure"); got != failure+1 {
+		t.Errorf("failed logins: got %v, want %v", got, failure+1)
+	}
+}
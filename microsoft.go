@@ -0,0 +1,388 @@
+package main
+
+// Signing in with a Microsoft account - Outlook.com or Microsoft 365 - and
+// sending through Microsoft Graph instead of Gmail. This follows the Google
+// authenticator: the access token is kept in an encrypted cookie, and the
+// OAuth state parameter carries the page to return to.
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/jpoehls/gophermail"
+	"github.com/kevinburke/rest"
+	"golang.org/x/oauth2"
+)
+
+const (
+	microsoftCookieName   = "microsoft-oauth-token"
+	microsoftCallbackPath = "/auth/microsoft/callback"
+
+	microsoftLoginURL = "https://login.microsoftonline.com"
+	microsoftGraphURL = "https://graph.microsoft.com/v1.0"
+)
+
+// We only ask to send mail, and to read the user's profile to find their
+// address. Without offline_access there's no refresh token, so users sign in
+// again when the access token expires, after about an hour; a refresh token
+// wouldn't fit in the cookie alongside it.
+var microsoftScopes = []string{"openid", "email", "User.Read", "Mail.Send"}
+
+type MicrosoftConfig struct {
+	ClientID string
+	Secret   string
+	// A directory (tenant) ID or domain, to only allow accounts in one
+	// organization. Defaults to "common", which allows any work, school or
+	// personal account.
+	Tenant string
+	// The scheme and host users reach the site at; the callback is
+	// BaseURL + "/auth/microsoft/callback".
+	BaseURL string
+	// If false, the cookie is marked Secure.
+	AllowUnencryptedTraffic bool
+	// Default to Microsoft's endpoints. Tests point them at a stand-in.
+	LoginURL string
+	GraphURL string
+}
+
+// A MicrosoftAuthenticator signs users in with Microsoft. A nil
+// *MicrosoftAuthenticator never has a signed in user.
+type MicrosoftAuthenticator struct {
+	// If true, only accounts whose address Microsoft has verified can sign
+	// in. Set it if the site or a group decides who can use it by address;
+	// see me.
+	RequireVerified bool
+
+	conf     *oauth2.Config
+	graphURL string
+	secure   bool
+	cookie   *Sealer
+	state    *Sealer
+	flash    *Sealer
+}
+
+func NewMicrosoftAuthenticator(c MicrosoftConfig, secrets *Secrets) *MicrosoftAuthenticator {
+	if c.Tenant == "" {
+		c.Tenant = "common"
+	}
+	if c.LoginURL == "" {
+		c.LoginURL = microsoftLoginURL
+	}
+	if c.GraphURL == "" {
+		c.GraphURL = microsoftGraphURL
+	}
+	base := strings.TrimSuffix(c.LoginURL, "/") + "/" + c.Tenant + "/oauth2/v2.0"
+	return &MicrosoftAuthenticator{
+		conf: &oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.Secret,
+			RedirectURL:  c.BaseURL + microsoftCallbackPath,
+			Scopes:       microsoftScopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  base + "/authorize",
+				TokenURL: base + "/token",
+			},
+		},
+		graphURL: strings.TrimSuffix(c.GraphURL, "/"),
+		secure:   !c.AllowUnencryptedTraffic,
+		cookie:   secrets.MicrosoftAuth,
+		state:    secrets.MicrosoftState,
+		flash:    secrets.Flash,
+	}
+}
+
+// URL returns a link to the Microsoft sign in page, which returns to the page
+// in r.
+func (a *MicrosoftAuthenticator) URL(r *http.Request) string {
+	state := a.state.seal([]byte(r.URL.RequestURI()))
+	return a.conf.AuthCodeURL(state, oauth2.SetAuthURLParam("prompt", "select_account"))
+}
+
+// microsoftToken is stored in the sign in cookie. It has the same shape as
+// the Google authenticator's, so signedInEmail can read either.
+type microsoftToken struct {
+	Email  *mail.Address
+	Token  *oauth2.Token
+	Expiry time.Time
+	// Whether Microsoft vouches for Email; see me.
+	Verified bool
+}
+
+// Callback finishes signing in, and sends the user back to the page they
+// started from.
+func (a *MicrosoftAuthenticator) Callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	current, err := a.state.open(query.Get("state"))
+	if err != nil || query.Get("code") == "" {
+		if desc := query.Get("error_description"); desc != "" {
+			logger.Warn("Microsoft sign in failed", "error", query.Get("error"), "description", desc)
+		}
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+	tok, err := a.conf.Exchange(ctx, query.Get("code"))
+	if err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	idToken, _ := tok.Extra("id_token").(string)
+	email, verified, err := a.me(ctx, a.conf.Client(ctx, tok), idToken)
+	if err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	if a.RequireVerified && !verified {
+		logger.Warn("Refused Microsoft sign in with unverified address", "email", email.Address)
+		FlashError(w, translate(requestLocale(r, ""), "Microsoft couldn't confirm that %s belongs to your account, so you can't use it to sign in here.", email.Address), a.flash)
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+	b, err := json.Marshal(&microsoftToken{
+		Email:    email,
+		Token:    &oauth2.Token{AccessToken: tok.AccessToken, TokenType: tok.TokenType, Expiry: tok.Expiry},
+		Expiry:   tok.Expiry,
+		Verified: verified,
+	})
+	if err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     microsoftCookieName,
+		Value:    a.cookie.seal(b),
+		Path:     "/",
+		Expires:  tok.Expiry,
+		Secure:   a.secure,
+		HttpOnly: true,
+	})
+	http.Redirect(w, r, string(current), http.StatusFound)
+}
+
+// me looks up the signed in user's address, and whether Microsoft vouches for
+// it. Personal accounts may not have a mail address, only a user principal
+// name, which is the address they sign in with.
+//
+// A tenant's admin can set anyone's mail address to anything, and anyone can
+// create a tenant, so mail alone proves nothing. The user principal name has
+// to be in a domain the tenant has verified it owns, so we trust that, and
+// mail if it's the same address, or if the ID token's xms_edov claim says the
+// domain owner verified it (the app has to ask for that optional claim).
+// Guest accounts' principal names look like "user_example.com#EXT#@..." and
+// don't count.
+func (a *MicrosoftAuthenticator) me(ctx context.Context, client *http.Client, idToken string) (*mail.Address, bool, error) {
+	req, err := http.NewRequest("GET", a.graphURL+"/me", nil)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, newGraphError(resp)
+	}
+	var user struct {
+		DisplayName       string `json:"displayName"`
+		Mail              string `json:"mail"`
+		UserPrincipalName string `json:"userPrincipalName"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, false, err
+	}
+	addr := user.Mail
+	if addr == "" {
+		addr = user.UserPrincipalName
+	}
+	if _, err := mail.ParseAddress(addr); err != nil {
+		return nil, false, fmt.Errorf("looking up Microsoft account: invalid address %q", addr)
+	}
+	verified := strings.EqualFold(addr, user.UserPrincipalName) && !strings.Contains(strings.ToUpper(addr), "#EXT#")
+	if !verified {
+		claims := idTokenClaims(idToken)
+		verified = claims.EmailDomainOwnerVerified() && strings.EqualFold(claims.Email, addr)
+	}
+	return &mail.Address{Name: user.DisplayName, Address: addr}, verified, nil
+}
+
+// microsoftClaims are the parts of a Microsoft ID token we look at.
+type microsoftClaims struct {
+	Email string `json:"email"`
+	// true, or "1" in some tokens.
+	EDOV interface{} `json:"xms_edov"`
+}
+
+// EmailDomainOwnerVerified reports whether the owner of the domain in Email
+// verified it.
+func (c *microsoftClaims) EmailDomainOwnerVerified() bool {
+	switch v := c.EDOV.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "1" || strings.EqualFold(v, "true")
+	}
+	return false
+}
+
+// idTokenClaims decodes the claims in an ID token. We got it straight from
+// the token endpoint over TLS, so its signature doesn't need checking. An
+// invalid token has no claims.
+func idTokenClaims(idToken string) *microsoftClaims {
+	claims := new(microsoftClaims)
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return claims
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims
+	}
+	json.Unmarshal(b, claims)
+	return claims
+}
+
+// account returns the user signed in with Microsoft on r, if there is one.
+func (a *MicrosoftAuthenticator) account(r *http.Request) (*Account, bool) {
+	if a == nil {
+		return nil, false
+	}
+	cookie, err := r.Cookie(microsoftCookieName)
+	if err != nil {
+		return nil, false
+	}
+	b, err := a.cookie.open(cookie.Value)
+	if err != nil {
+		return nil, false
+	}
+	t := new(microsoftToken)
+	if err := json.Unmarshal(b, t); err != nil || t.Email == nil || t.Token == nil {
+		return nil, false
+	}
+	if a.RequireVerified && !t.Verified {
+		return nil, false
+	}
+	if t.Expiry.Before(time.Now()) {
+		return nil, false
+	}
+	return &Account{
+		Email:    t.Email,
+		Client:   a.conf.Client(r.Context(), t.Token),
+		Provider: providerMicrosoft,
+		graphURL: a.graphURL,
+		newClient: func(ctx context.Context) *http.Client {
+			return a.conf.Client(ctx, t.Token)
+		},
+	}, true
+}
+
+// Logout removes the Microsoft sign in cookie.
+func (a *MicrosoftAuthenticator) Logout(w http.ResponseWriter) {
+	if a == nil {
+		return
+	}
+	clearCookie(w, microsoftCookieName)
+}
+
+// graphError is an error response from Microsoft Graph.
+type graphError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *graphError) Error() string {
+	return fmt.Sprintf("microsoft graph: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func newGraphError(resp *http.Response) error {
+	gerr := &graphError{StatusCode: resp.StatusCode}
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if json.Unmarshal(data, &body) == nil {
+		gerr.Code, gerr.Message = body.Error.Code, body.Error.Message
+	}
+	return gerr
+}
+
+type graphAddress struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address"`
+}
+
+type graphRecipient struct {
+	EmailAddress graphAddress `json:"emailAddress"`
+}
+
+func graphRecipients(addrs []mail.Address) []graphRecipient {
+	recipients := make([]graphRecipient, len(addrs))
+	for i := range addrs {
+		recipients[i] = graphRecipient{EmailAddress: graphAddress{Name: addrs[i].Name, Address: addrs[i].Address}}
+	}
+	return recipients
+}
+
+type graphItemBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type graphMessage struct {
+	Subject      string           `json:"subject"`
+	Body         graphItemBody    `json:"body"`
+	ToRecipients []graphRecipient `json:"toRecipients"`
+	CcRecipients []graphRecipient `json:"ccRecipients,omitempty"`
+}
+
+// graphSender sends mail as the signed in user with Microsoft Graph.
+type graphSender struct {
+	client *http.Client
+	url    string
+}
+
+func (g *graphSender) sendMessage(ctx context.Context, msg *gophermail.Message) error {
+	b, err := json.Marshal(map[string]interface{}{
+		"message": &graphMessage{
+			Subject:      msg.Subject,
+			Body:         graphItemBody{ContentType: "HTML", Content: msg.HTMLBody},
+			ToRecipients: graphRecipients(msg.To),
+			CcRecipients: graphRecipients(msg.Cc),
+		},
+		"saveToSentItems": true,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", g.url+"/me/sendMail", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := g.client.Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return newGraphError(resp)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+// graphStandIn serves the parts of the Microsoft identity platform and Graph
+// that we use. Messages sent to recipients at reject.example.com fail.
+type graphStandIn struct {
+	// If set, replace the signed in user's profile and the ID token.
+	me      string
+	idToken string
+
+	mu   sync.Mutex
+	sent []map[string]interface{}
+}
+
+func (g *graphStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/common/oauth2/v2.0/token" {
+		if r.PostFormValue("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error":"invalid_grant"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "graph-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     g.idToken,
+		})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer graph-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"error":{"code":"InvalidAuthenticationToken","message":"Access token is empty."}}`)
+		return
+	}
+	switch r.URL.Path {
+	case "/v1.0/me":
+		if g.me != "" {
+			io.WriteString(w, g.me)
+			return
+		}
+		io.WriteString(w, `{"displayName":"Outlook Volunteer","mail":null,"userPrincipalName":"volunteer@outlook.com"}`)
+	case "/v1.0/me/sendMail":
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(payloadString(payload), "reject.example.com") {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error":{"code":"ErrorInvalidRecipients","message":"Invalid recipient."}}`)
+			return
+		}
+		g.mu.Lock()
+		g.sent = append(g.sent, payload)
+		g.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func payloadString(payload map[string]interface{}) string {
+	b, _ := json.Marshal(payload)
+	return string(b)
+}
+
+func TestMicrosoftSignIn(t *testing.T) {
+	t.Parallel()
+	standIn := httptest.NewServer(new(graphStandIn))
+	defer standIn.Close()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	microsoft := NewMicrosoftAuthenticator(MicrosoftConfig{
+		ClientID:                "client-id",
+		Secret:                  "client-secret",
+		BaseURL:                 "http://localhost:8048",
+		AllowUnencryptedTraffic: true,
+		LoginURL:                standIn.URL,
+		GraphURL:                standIn.URL + "/v1.0",
+	}, mailer.secrets)
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: mailer.secrets.Auth[0],
+	}), mailer, &Site{WithGoogle: true, Microsoft: microsoft})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/board", nil))
+	if w.Code != 200 || !strings.Contains(w.Body.String(), "Sign in with Microsoft") {
+		t.Fatalf("GET /board: got %d, want 200 and a Microsoft sign in link", w.Code)
+	}
+
+	u, err := url.Parse(microsoft.URL(httptest.NewRequest("GET", "/board", nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(u.String(), standIn.URL+"/common/oauth2/v2.0/authorize?") || !strings.Contains(u.Query().Get("scope"), "Mail.Send") {
+		t.Errorf("got sign in URL %q", u)
+	}
+	state := u.Query().Get("state")
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/auth/microsoft/callback?code=valid-code&state="+url.QueryEscape(state), nil))
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/board" {
+		t.Fatalf("callback: got %d to %q, want 302 to /board", w.Code, w.Header().Get("Location"))
+	}
+	var cookie *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == microsoftCookieName {
+			cookie = c
+		}
+	}
+	if cookie == nil {
+		t.Fatal("callback: want sign in cookie")
+	}
+
+	req := httptest.NewRequest("GET", "/board", nil)
+	req.AddCookie(cookie)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 || !strings.Contains(w.Body.String(), "volunteer@outlook.com") {
+		t.Errorf("GET /board signed in with Microsoft: got %d, want 200 and the user's address", w.Code)
+	}
+
+	// A forged or stale state is sent home without signing in.
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/auth/microsoft/callback?code=valid-code&state=forged", nil))
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/" || len(w.Result().Cookies()) != 0 {
+		t.Errorf("callback with bad state: got %d to %q with %d cookies", w.Code, w.Header().Get("Location"), len(w.Result().Cookies()))
+	}
+}
+
+// fakeIDToken returns an unsigned ID token with claims.
+func fakeIDToken(claims map[string]interface{}) string {
+	b, _ := json.Marshal(claims)
+	return "e30." + base64.RawURLEncoding.EncodeToString(b) + ".sig"
+}
+
+func TestMicrosoftUnverifiedMail(t *testing.T) {
+	t.Parallel()
+	// A tenant admin set their own account's mail to someone else's address.
+	forged := `{"displayName":"Attacker","mail":"victim@allowed.example.org","userPrincipalName":"attacker@evil.onmicrosoft.com"}`
+	for _, tt := range []struct {
+		name            string
+		idToken         string
+		requireVerified bool
+		wantCookie      bool
+		wantEmail       string
+	}{
+		{"refused", "", true, false, ""},
+		{"not required", "", false, true, ""},
+		{"domain owner verified", fakeIDToken(map[string]interface{}{"email": "victim@allowed.example.org", "xms_edov": true}), true, true, "victim@allowed.example.org"},
+		{"verified claim for another address", fakeIDToken(map[string]interface{}{"email": "attacker@evil.example.com", "xms_edov": true}), true, false, ""},
+	} {
+		standIn := httptest.NewServer(&graphStandIn{me: forged, idToken: tt.idToken})
+		secrets := NewSecrets(Keys{NewRandomKey()})
+		microsoft := NewMicrosoftAuthenticator(MicrosoftConfig{
+			ClientID:                "client-id",
+			Secret:                  "client-secret",
+			BaseURL:                 "http://localhost:8048",
+			AllowUnencryptedTraffic: true,
+			LoginURL:                standIn.URL,
+			GraphURL:                standIn.URL + "/v1.0",
+		}, secrets)
+		microsoft.RequireVerified = tt.requireVerified
+		state := microsoft.state.seal([]byte("/board"))
+		w := httptest.NewRecorder()
+		microsoft.Callback(w, httptest.NewRequest("GET", "/auth/microsoft/callback?code=valid-code&state="+url.QueryEscape(state), nil))
+		standIn.Close()
+		var cookie *http.Cookie
+		for _, c := range w.Result().Cookies() {
+			if c.Name == microsoftCookieName {
+				cookie = c
+			}
+		}
+		if (cookie != nil) != tt.wantCookie {
+			t.Errorf("%s: got sign in cookie %t, want %t", tt.name, cookie != nil, tt.wantCookie)
+			continue
+		}
+		if cookie == nil {
+			continue
+		}
+		req := httptest.NewRequest("GET", "/", nil)
+		req.AddCookie(cookie)
+		var got string
+		if addr := signedInEmail(req, secrets); addr != nil {
+			got = addr.Address
+		}
+		if got != tt.wantEmail {
+			t.Errorf("%s: signedInEmail got %q, want %q", tt.name, got, tt.wantEmail)
+		}
+	}
+}
+
+func TestGraphSend(t *testing.T) {
+	t.Parallel()
+	graph := new(graphStandIn)
+	standIn := httptest.NewServer(graph)
+	defer standIn.Close()
+	m, _ := drainMailer()
+	group := &Group{ID: "board", Recipients: []*Recipient{
+		{Address: mail.Address{Name: "Supervisor", Address: "recipient@example.com"}, OpeningLine: "Dear Supervisor",
+			CC: []mail.Address{{Address: "clerk@example.com"}}},
+		{Address: mail.Address{Address: "nobody@reject.example.com"}, OpeningLine: "Dear Nobody"},
+	}}
+	client := &http.Client{Transport: headerTransport{"Authorization", "Bearer graph-token"}}
+	results := m.send(context.Background(), &Account{
+		Email:    &mail.Address{Address: "volunteer@outlook.com"},
+		Client:   client,
+		Provider: providerMicrosoft,
+		graphURL: standIn.URL + "/v1.0",
+	}, group, "Vote no", "Please vote no.")
+	if results[0].Err != nil {
+		t.Errorf("send to recipient@example.com: %v", results[0].Err)
+	}
+	if gerr, ok := results[1].Err.(*graphError); !ok || gerr.Code != "ErrorInvalidRecipients" {
+		t.Errorf("send to rejected address: got %#v, want ErrorInvalidRecipients", results[1].Err)
+	}
+	if len(graph.sent) != 1 {
+		t.Fatalf("got %d messages, want 1", len(graph.sent))
+	}
+	got := payloadString(graph.sent[0])
+	for _, want := range []string{`"subject":"Vote no"`, `"address":"recipient@example.com"`, `"ccRecipients":[{"emailAddress":{"address":"clerk@example.com"}}]`, "Dear Supervisor,", `"saveToSentItems":true`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("sendMail payload: want %q in %s", want, got)
+		}
+	}
+}
@@ -42,19 +42,3 @@ func unopaqueByte(compressed string, secretKey *[32]byte) ([]byte, error) {
 	}
 	return decrypted, nil
 }
-
-// Opaque encrypts s with secretKey and returns the encrypted string encoded
-// with base64, or an error.
-func opaque(s string, secretKey *[32]byte) string {
-	return opaqueByte([]byte(s), secretKey)
-}
-
-// Unopaque decodes compressed using base64, then decrypts the decoded byte
-// array using the secretKey.
-func unopaque(compressed string, secretKey *[32]byte) (string, error) {
-	b, err := unopaqueByte(compressed, secretKey)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
-}
@@ -0,0 +1,72 @@
+package main
+
+// Content pages, like a privacy policy or an "about" page, written in Markdown
+// and configured with the "pages" setting. They're read from disk on every
+// request, so they can be edited without restarting the server.
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/kevinburke/rest"
+	"github.com/russross/blackfriday"
+)
+
+// reservedPagePaths can't be used for content pages, because the site needs
+// them. So can't a group's page, or its list of recipients.
+var reservedPagePaths = regexp.MustCompile(`^/($|static/|v1/|auth/|embed/|_ah/|logout$|metrics$|favicon.ico$)`)
+
+// validPagePath reports whether a content page can be served at path, on a
+// site with the given groups.
+func validPagePath(path string, groups map[string]*Group) error {
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("page path %q should start with a slash", path)
+	}
+	if reservedPagePaths.MatchString(path) || recipientsRx.MatchString(path) {
+		return fmt.Errorf("page path %q is reserved", path)
+	}
+	if _, ok := groups[strings.TrimPrefix(path, "/")]; ok {
+		return fmt.Errorf("page path %q is already used by a group", path)
+	}
+	return nil
+}
+
+type pageData struct {
+	layoutData
+	// The text of the first heading, if there is one.
+	PageTitle string
+	Content   template.HTML
+}
+
+// pageTitle returns the text of the first ATX heading in md, e.g. "Privacy
+// Policy" for "# Privacy Policy".
+func pageTitle(md []byte) string {
+	for _, line := range bytes.Split(md, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if bytes.HasPrefix(line, []byte("#")) {
+			return strings.TrimSpace(strings.Trim(string(line), "#"))
+		}
+	}
+	return ""
+}
+
+// servePage renders the Markdown file at filename inside the site layout.
+func servePage(w http.ResponseWriter, r *http.Request, site *Site, filename string) {
+	md, err := ioutil.ReadFile(filename)
+	if err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	saveLocale(w, r)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	render(w, r, site.Theme.templates, "page.html", &pageData{
+		layoutData: newLayoutData(r, site, requestLocale(r, "")),
+		PageTitle:  pageTitle(md),
+		Content:    template.HTML(blackfriday.MarkdownCommon(md)),
+	})
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func TestContentPages(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "multi-emailer-pages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	privacy := filepath.Join(dir, "privacy.md")
+	about := filepath.Join(dir, "about.md")
+	writeTestFile(t, dir, "privacy.md", "# Our Privacy Policy\n\nWe don't keep *anything*.\n")
+	writeTestFile(t, dir, "about.md", "Run by the Neighborhood Association.\n")
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), nil, &Site{Pages: map[string]string{"/privacy": privacy, "/about": about}})
+
+	req := httptest.NewRequest("GET", "/privacy", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Errorf("GET /privacy: got code %d, want 200", w.Code)
+	}
+	b := w.Body.String()
+	if !strings.Contains(b, "<title>Our Privacy Policy - Multi Emailer</title>") || !strings.Contains(b, "<em>anything</em>") {
+		t.Errorf("GET /privacy: want configured privacy policy, got %s", b)
+	}
+	if !strings.Contains(b, "View the source code") {
+		t.Errorf("GET /privacy: want page inside the site layout, got %s", b)
+	}
+
+	// Edits show up without a restart.
+	writeTestFile(t, dir, "about.md", "Run by the Tenants Union.\n")
+	req = httptest.NewRequest("GET", "/about", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if b := w.Body.String(); !strings.Contains(b, "Run by the Tenants Union.") {
+		t.Errorf("GET /about: want edited page, got %s", b)
+	}
+
+	// Built-in pages that aren't overridden are still served.
+	req = httptest.NewRequest("GET", "/terms-of-service", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Errorf("GET /terms-of-service: got code %d, want 200", w.Code)
+	}
+}
+
+func TestValidPagePath(t *testing.T) {
+	t.Parallel()
+	groups := embedMailer().Groups
+	for _, path := range []string{"/privacy", "/about", "/faq/voting", "/board/about"} {
+		if err := validPagePath(path, groups); err != nil {
+			t.Errorf("validPagePath(%q): %v", path, err)
+		}
+	}
+	for _, path := range []string{"", "about", "/", "/static/style.css", "/v1/send", "/logout", "/auth/callback", "/auth/signed-in", "/embed/board", "/metrics", "/board", "/board/recipients", "/board/recipients.csv", "/unknown/recipients"} {
+		if err := validPagePath(path, groups); err == nil {
+			t.Errorf("validPagePath(%q): expected error, got nil", path)
+		}
+	}
+}
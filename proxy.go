@@ -0,0 +1,164 @@
+package main
+
+// Running behind a reverse proxy or load balancer. Once trusted_proxies is
+// set, X-Forwarded-* headers are only believed when they come from a proxy
+// listed there, and the site can be mounted under a path prefix, like
+// https://example.org/letters.
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ParseTrustedProxies parses a list of CIDRs like "10.0.0.0/8", or single IP
+// addresses.
+func ParseTrustedProxies(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q, should be an IP address or CIDR", cidr)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets[i] = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %v", cidr, err)
+		}
+		nets[i] = ipnet
+	}
+	return nets, nil
+}
+
+func trusted(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// lastValue returns the last value in a comma-separated header, which is the
+// one added by the nearest proxy.
+func lastValue(h string) string {
+	parts := strings.Split(h, ",")
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
+var forwardedHeaders = []string{"X-Forwarded-For", "X-Forwarded-Proto", "X-Forwarded-Host"}
+
+// TrustProxies applies X-Forwarded-For, X-Forwarded-Proto and
+// X-Forwarded-Host to requests from the given networks, and removes them from
+// all other requests so nothing downstream can be fooled by them.
+//
+// For requests from a trusted proxy, r.RemoteAddr becomes the address of the
+// first untrusted hop in X-Forwarded-For - the real client, if the proxies
+// are all trusted - and r.Host becomes the host the client asked for.
+func TrustProxies(h http.Handler, nets []*net.IPNet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		peer := net.ParseIP(host)
+		if peer == nil || !trusted(nets, peer) {
+			for _, hdr := range forwardedHeaders {
+				r.Header.Del(hdr)
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.Header = r.Header.Clone()
+		if fwd := r.Header["X-Forwarded-For"]; len(fwd) > 0 {
+			hops := strings.Split(strings.Join(fwd, ","), ",")
+			client := peer
+			for i := len(hops) - 1; i >= 0; i-- {
+				ip := net.ParseIP(strings.TrimSpace(hops[i]))
+				if ip == nil {
+					break
+				}
+				client = ip
+				if !trusted(nets, ip) {
+					break
+				}
+			}
+			r2.RemoteAddr = net.JoinHostPort(client.String(), "0")
+			// handlers.Log reports the first address in this header.
+			r2.Header.Set("X-Forwarded-For", client.String())
+		}
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			r2.Header.Set("X-Forwarded-Proto", strings.ToLower(lastValue(proto)))
+		}
+		if fwdHost := r.Header.Get("X-Forwarded-Host"); fwdHost != "" {
+			r2.Host = lastValue(fwdHost)
+		}
+		h.ServeHTTP(w, r2)
+	})
+}
+
+// BasePath returns the path prefix in publicHost, like "/letters" for
+// "https://example.org/letters/", or "" if the site is at the root.
+func BasePath(publicHost string) string {
+	u, err := url.Parse(publicHost)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+// prefixWriter adds a path prefix to redirects to paths on this site.
+type prefixWriter struct {
+	http.ResponseWriter
+	prefix string
+}
+
+func (p *prefixWriter) WriteHeader(code int) {
+	loc := p.Header().Get("Location")
+	if strings.HasPrefix(loc, "/") && !strings.HasPrefix(loc, "//") {
+		p.Header().Set("Location", p.prefix+loc)
+	}
+	p.ResponseWriter.WriteHeader(code)
+}
+
+func (p *prefixWriter) Push(target string, opts *http.PushOptions) error {
+	if pusher, ok := p.ResponseWriter.(http.Pusher); ok {
+		return pusher.Push(p.prefix+target, opts)
+	}
+	return http.ErrNotSupported
+}
+
+// PathPrefix serves the site under prefix. Requests for paths under prefix
+// have it removed, so the proxy in front may pass them on with or without
+// it, and redirects to paths on the site have it added back.
+func PathPrefix(h http.Handler, prefix string) http.Handler {
+	if prefix == "" {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == prefix || strings.HasPrefix(r.URL.Path, prefix+"/") {
+			r2 := new(http.Request)
+			*r2 = *r
+			r2.URL = new(url.URL)
+			*r2.URL = *r.URL
+			r2.URL.Path = strings.TrimPrefix(r.URL.Path, prefix)
+			if r2.URL.Path == "" {
+				r2.URL.Path = "/"
+			}
+			r2.URL.RawPath = ""
+			r = r2
+		}
+		h.ServeHTTP(&prefixWriter{ResponseWriter: w, prefix: prefix}, r)
+	})
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	t.Parallel()
+	nets, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nets) != 3 || nets[1].String() != "192.168.1.1/32" || nets[2].String() != "::1/128" {
+		t.Errorf("ParseTrustedProxies: got %v", nets)
+	}
+	if _, err := ParseTrustedProxies([]string{"example.com"}); err == nil {
+		t.Errorf("ParseTrustedProxies: want error for hostname")
+	}
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Errorf("ParseTrustedProxies: want error for invalid CIDR")
+	}
+}
+
+var proxyTests = []struct {
+	remoteAddr string
+	fwdFor     string
+	wantAddr   string
+	wantHost   string
+	wantProto  string
+}{
+	// Not from a proxy; the headers are ignored.
+	{"203.0.113.9:4000", "198.51.100.1", "203.0.113.9:4000", "internal:8048", ""},
+	// From a proxy; the client is the last untrusted address.
+	{"10.0.0.1:4000", "198.51.100.1", "198.51.100.1:0", "letters.example.org", "https"},
+	{"10.0.0.1:4000", "6.6.6.6, 198.51.100.1, 10.0.0.2", "198.51.100.1:0", "letters.example.org", "https"},
+}
+
+func TestTrustProxies(t *testing.T) {
+	t.Parallel()
+	nets, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range proxyTests {
+		var got *http.Request
+		h := TrustProxies(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+		}), nets)
+		req := httptest.NewRequest("GET", "http://internal:8048/", nil)
+		req.RemoteAddr = tt.remoteAddr
+		req.Header.Set("X-Forwarded-For", tt.fwdFor)
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "letters.example.org")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		if got.RemoteAddr != tt.wantAddr {
+			t.Errorf("from %s, X-Forwarded-For %q: got RemoteAddr %q, want %q", tt.remoteAddr, tt.fwdFor, got.RemoteAddr, tt.wantAddr)
+		}
+		if got.Host != tt.wantHost {
+			t.Errorf("from %s: got Host %q, want %q", tt.remoteAddr, got.Host, tt.wantHost)
+		}
+		if proto := got.Header.Get("X-Forwarded-Proto"); proto != tt.wantProto {
+			t.Errorf("from %s: got X-Forwarded-Proto %q, want %q", tt.remoteAddr, proto, tt.wantProto)
+		}
+	}
+}
+
+func TestPathPrefix(t *testing.T) {
+	t.Parallel()
+	mux := PathPrefix(NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), embedMailer(), &Site{PublicHost: "https://example.org/letters", BasePath: "/letters"}), "/letters")
+	for _, path := range []string{"/letters/board", "/board"} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != 200 {
+			t.Fatalf("GET %s: got code %d, want 200", path, w.Code)
+		}
+		b := w.Body.String()
+		for _, want := range []string{`href="/letters/static/style.css"`, `href="/letters/privacy"`, `"https:\/\/example.org\/letters\/board"`} {
+			if !strings.Contains(b, want) {
+				t.Errorf("GET %s: should see %q in body", path, want)
+			}
+		}
+	}
+
+	redirect := PathPrefix(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/board", http.StatusFound)
+	}), "/letters")
+	w := httptest.NewRecorder()
+	redirect.ServeHTTP(w, httptest.NewRequest("GET", "/letters/", nil))
+	if loc := w.Header().Get("Location"); loc != "/letters/board" {
+		t.Errorf("redirect: got Location %q, want /letters/board", loc)
+	}
+}
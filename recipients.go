@@ -0,0 +1,194 @@
+package main
+
+// The public /<id>/recipients endpoint, which lists a group's recipients as
+// YAML, JSON, CSV or vCard.
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/kevinburke/rest"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Values for the recipient_addresses config setting, which controls how
+// recipient email addresses appear on public pages.
+const (
+	addressesShow      = "show"
+	addressesObfuscate = "obfuscate"
+	addressesHide      = "hide"
+)
+
+func validAddressVisibility(v string) bool {
+	return v == addressesShow || v == addressesObfuscate || v == addressesHide
+}
+
+// obfuscateEmail masks the local part of an email address, so
+// "kevin@example.com" becomes "k***@example.com".
+func obfuscateEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return "***"
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+func publicAddress(addr mail.Address, visibility string) mail.Address {
+	switch visibility {
+	case addressesHide:
+		addr.Address = ""
+	case addressesObfuscate:
+		addr.Address = obfuscateEmail(addr.Address)
+	}
+	return addr
+}
+
+// PublicRecipients returns the group's recipients, with their addresses
+// hidden or obfuscated according to the group's AddressVisibility.
+func (g *Group) PublicRecipients() []*Recipient {
+	recs := make([]*Recipient, len(g.Recipients))
+	for i, r := range g.Recipients {
+		rec := &Recipient{
+			Address:     publicAddress(r.Address, g.AddressVisibility),
+			CC:          make([]mail.Address, len(r.CC)),
+			OpeningLine: r.OpeningLine,
+		}
+		for j := range r.CC {
+			rec.CC[j] = publicAddress(r.CC[j], g.AddressVisibility)
+		}
+		recs[i] = rec
+	}
+	return recs
+}
+
+// Formats for the recipients endpoint, keyed by file extension.
+var recipientFormats = map[string]string{
+	".yml":  "text/plain; charset=utf-8",
+	".yaml": "text/plain; charset=utf-8",
+	".json": "application/json; charset=utf-8",
+	".csv":  "text/csv; charset=utf-8",
+	".vcf":  "text/vcard; charset=utf-8",
+}
+
+// Media types we recognize in an Accept header, mapped to an extension in
+// recipientFormats.
+var acceptFormats = map[string]string{
+	"application/json": ".json",
+	"text/csv":         ".csv",
+	"text/vcard":       ".vcf",
+	"text/x-vcard":     ".vcf",
+	"text/directory":   ".vcf",
+	"application/yaml": ".yml",
+	"text/yaml":        ".yml",
+	"text/plain":       ".yml",
+}
+
+// negotiateRecipientFormat picks a format for the recipients endpoint. An
+// explicit extension in the URL wins; otherwise we use the first media type
+// we recognize in the Accept header, falling back to YAML.
+func negotiateRecipientFormat(ext string, accept string) string {
+	if ext != "" {
+		return ext
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if f, ok := acceptFormats[mediaType]; ok {
+			return f
+		}
+	}
+	return ".yml"
+}
+
+// vcardEscape escapes a vCard text value per RFC 6350 section 3.4.
+var vcardEscape = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\n", `\n`)
+
+func writeVCard(buf *bytes.Buffer, addr mail.Address, note string, withEmail bool) {
+	name := addr.Name
+	if name == "" {
+		name = addr.Address
+	}
+	if name == "" {
+		name = note
+	}
+	buf.WriteString("BEGIN:VCARD\r\nVERSION:3.0\r\n")
+	buf.WriteString("FN:" + vcardEscape.Replace(name) + "\r\n")
+	if withEmail && addr.Address != "" {
+		buf.WriteString("EMAIL;TYPE=INTERNET:" + vcardEscape.Replace(addr.Address) + "\r\n")
+	}
+	if note != "" {
+		buf.WriteString("NOTE:" + vcardEscape.Replace(note) + "\r\n")
+	}
+	buf.WriteString("END:VCARD\r\n")
+}
+
+func marshalRecipients(format string, group *Group) ([]byte, error) {
+	recipients := group.PublicRecipients()
+	switch format {
+	case ".json":
+		return json.MarshalIndent(newAPIRecipients(recipients), "", "  ")
+	case ".csv":
+		buf := new(bytes.Buffer)
+		cw := csv.NewWriter(buf)
+		cw.Write([]string{"name", "email", "cc", "opening_line"})
+		for _, r := range recipients {
+			ccs := make([]string, len(r.CC))
+			for i := range r.CC {
+				ccs[i] = formatAddress(r.CC[i])
+			}
+			cw.Write([]string{r.Address.Name, r.Address.Address, strings.Join(ccs, "; "), r.OpeningLine})
+		}
+		cw.Flush()
+		return buf.Bytes(), cw.Error()
+	case ".vcf":
+		// Obfuscated addresses aren't valid email addresses, so only
+		// include addresses if they're shown in full.
+		withEmail := group.AddressVisibility == "" || group.AddressVisibility == addressesShow
+		buf := new(bytes.Buffer)
+		for _, r := range recipients {
+			writeVCard(buf, r.Address, "Opening line: "+r.OpeningLine, withEmail)
+			for _, cc := range r.CC {
+				writeVCard(buf, cc, "CC for "+formatAddress(r.Address), withEmail)
+			}
+		}
+		return buf.Bytes(), nil
+	default:
+		return yaml.Marshal(recipients)
+	}
+}
+
+// formatAddress is like mail.Address.String, but handles addresses that have
+// been hidden.
+func formatAddress(addr mail.Address) string {
+	if addr.Address == "" {
+		return addr.Name
+	}
+	if addr.Name == "" {
+		return addr.Address
+	}
+	return addr.Name + " <" + addr.Address + ">"
+}
+
+func serveRecipients(w http.ResponseWriter, r *http.Request, group *Group, ext string) {
+	format := negotiateRecipientFormat(ext, r.Header.Get("Accept"))
+	data, err := marshalRecipients(format, group)
+	if err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	if ext == "" {
+		w.Header().Add("Vary", "Accept")
+	}
+	w.Header().Set("Content-Type", recipientFormats[format])
+	if format == ".vcf" || format == ".csv" {
+		w.Header().Set("Content-Disposition", `inline; filename="`+group.ID+format+`"`)
+	}
+	w.Write(data)
+}
@@ -0,0 +1,85 @@
+package main
+
+// Security headers for every response, including a Content-Security-Policy.
+// Inline scripts in the templates are allowed by a random nonce generated for
+// each request; templates get it from layoutData.CSPNonce.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// nonceToken is replaced with the request's nonce in a Content-Security-Policy.
+const nonceToken = "{nonce}"
+
+// DefaultContentSecurityPolicy only allows scripts, styles and images served by
+// this site, plus inline scripts carrying the request's nonce.
+const DefaultContentSecurityPolicy = "default-src 'self'; script-src 'self' 'nonce-" + nonceToken + "'; " +
+	"style-src 'self'; img-src 'self' data:; object-src 'none'; base-uri 'self'; " +
+	"form-action 'self'; frame-ancestors 'none'"
+
+const DefaultReferrerPolicy = "strict-origin-when-cross-origin"
+
+// SecurityPolicy configures the headers set by SecurityHeaders.
+type SecurityPolicy struct {
+	// A Content-Security-Policy; "{nonce}" is replaced with a random value
+	// for each request. If empty, DefaultContentSecurityPolicy is used.
+	ContentSecurityPolicy string
+	// If empty, DefaultReferrerPolicy is used.
+	ReferrerPolicy string
+}
+
+type nonceKey struct{}
+
+func newCSPNonce() string {
+	return randomHex(16)
+}
+
+// cspNonce returns the nonce for inline scripts in the response to r, or the
+// empty string if SecurityHeaders isn't in use.
+func cspNonce(r *http.Request) string {
+	nonce, _ := r.Context().Value(nonceKey{}).(string)
+	return nonce
+}
+
+// SecurityHeaders sets a Content-Security-Policy, and headers that stop
+// browsers from sniffing content types, leaking URLs in the Referer header or
+// framing the site, on every response from h.
+func SecurityHeaders(h http.Handler, policy SecurityPolicy) http.Handler {
+	csp := policy.ContentSecurityPolicy
+	if csp == "" {
+		csp = DefaultContentSecurityPolicy
+	}
+	referrer := policy.ReferrerPolicy
+	if referrer == "" {
+		referrer = DefaultReferrerPolicy
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce := newCSPNonce()
+		hdr := w.Header()
+		hdr.Set("Content-Security-Policy", strings.Replace(csp, nonceToken, nonce, -1))
+		hdr.Set("X-Content-Type-Options", "nosniff")
+		hdr.Set("Referrer-Policy", referrer)
+		hdr.Set("X-Frame-Options", "DENY")
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), nonceKey{}, nonce)))
+	})
+}
+
+// allowFraming replaces the frame-ancestors directive in the response's
+// Content-Security-Policy, and removes X-Frame-Options, so the page can be
+// shown in an iframe on the given origins.
+func allowFraming(w http.ResponseWriter, origins []string) {
+	hdr := w.Header()
+	hdr.Del("X-Frame-Options")
+	var directives []string
+	for _, directive := range strings.Split(hdr.Get("Content-Security-Policy"), ";") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" || strings.HasPrefix(strings.ToLower(directive), "frame-ancestors") {
+			continue
+		}
+		directives = append(directives, directive)
+	}
+	directives = append(directives, frameAncestors(origins))
+	hdr.Set("Content-Security-Policy", strings.Join(directives, "; "))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+var nonceRx = regexp.MustCompile(`'nonce-([0-9a-f]+)'`)
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+	mux := SecurityHeaders(NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), embedMailer(), &Site{EmbedOrigins: []string{"https://partner.example.org"}}), SecurityPolicy{})
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	hdr := w.Header()
+	if hdr.Get("X-Content-Type-Options") != "nosniff" || hdr.Get("X-Frame-Options") != "DENY" || hdr.Get("Referrer-Policy") != DefaultReferrerPolicy {
+		t.Errorf("GET /: missing security headers, got %v", hdr)
+	}
+	csp := hdr.Get("Content-Security-Policy")
+	match := nonceRx.FindStringSubmatch(csp)
+	if match == nil {
+		t.Fatalf("GET /: want nonce in Content-Security-Policy, got %q", csp)
+	}
+	b := w.Body.String()
+	if scripts, withNonce := strings.Count(b, "<script"), strings.Count(b, `<script nonce="`+match[1]+`"`); scripts == 0 || scripts != withNonce {
+		t.Errorf("GET /: want nonce on all %d inline scripts, found it on %d", scripts, withNonce)
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if other := nonceRx.FindStringSubmatch(w.Header().Get("Content-Security-Policy")); other == nil || other[1] == match[1] {
+		t.Errorf("GET /: want a different nonce for each request")
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/embed/board", nil))
+	csp = w.Header().Get("Content-Security-Policy")
+	if w.Header().Get("X-Frame-Options") != "" || strings.Count(csp, "frame-ancestors") != 1 ||
+		!strings.HasSuffix(csp, "frame-ancestors 'self' https://partner.example.org") || !nonceRx.MatchString(csp) {
+		t.Errorf("GET /embed/board: want partner site allowed to frame the page, got %q", csp)
+	}
+}
+
+func TestCustomSecurityPolicy(t *testing.T) {
+	t.Parallel()
+	mux := SecurityHeaders(NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), nil, nil), SecurityPolicy{
+		ContentSecurityPolicy: "script-src 'nonce-{nonce}' https://analytics.example.com",
+		ReferrerPolicy:        "no-referrer",
+	})
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if csp := w.Header().Get("Content-Security-Policy"); !nonceRx.MatchString(csp) || !strings.HasSuffix(csp, " https://analytics.example.com") {
+		t.Errorf("GET /: got Content-Security-Policy %q", csp)
+	}
+	if rp := w.Header().Get("Referrer-Policy"); rp != "no-referrer" {
+		t.Errorf("GET /: got Referrer-Policy %q, want no-referrer", rp)
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+// Limits on how long, and how much, clients can send to the server, so slow or
+// misbehaving clients can't tie up connections or memory.
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/rest"
+)
+
+const (
+	// DefaultReadHeaderTimeout is how long clients have to send request
+	// headers.
+	DefaultReadHeaderTimeout = 10 * time.Second
+	// DefaultReadTimeout is how long clients have to send an entire request,
+	// including the body.
+	DefaultReadTimeout = 30 * time.Second
+	// DefaultWriteTimeout is how long the server has to write a response. It's
+	// longer than a send to every recipient in a group can take.
+	DefaultWriteTimeout = time.Minute
+	// DefaultIdleTimeout is how long keep-alive connections are kept open
+	// between requests.
+	DefaultIdleTimeout = 2 * time.Minute
+	// DefaultMaxHeaderBytes is the largest request header we accept.
+	DefaultMaxHeaderBytes = 64 << 10
+	// DefaultMaxBodyBytes is the largest request body we accept - far more
+	// than any letter needs.
+	DefaultMaxBodyBytes = 1 << 20
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// DefaultTLSMinVersion is the oldest version of TLS we accept.
+const DefaultTLSMinVersion = "1.2"
+
+// cipherSuites are the secure cipher suites for TLS 1.2 and older, by name.
+// The ChaCha20 suites are listed under both the old and the new (Go 1.16) name.
+var cipherSuites = map[string]uint16{
+	"TLS_RSA_WITH_AES_128_CBC_SHA":                  tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_RSA_WITH_AES_256_CBC_SHA":                  tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_RSA_WITH_AES_128_GCM_SHA256":               tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_RSA_WITH_AES_256_GCM_SHA384":               tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":          tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":          tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":            tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":            tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256":       tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":         tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384":       tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":         tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":        tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":          tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+}
+
+// tls13Suites can't be configured; Go always offers all of them for TLS 1.3.
+var tls13Suites = map[string]bool{
+	"TLS_AES_128_GCM_SHA256":       true,
+	"TLS_AES_256_GCM_SHA384":       true,
+	"TLS_CHACHA20_POLY1305_SHA256": true,
+}
+
+// newTLSConfig returns a TLS configuration that accepts minVersion (e.g.
+// "1.2") or later, and only the named cipher suites, if any are given. Cipher
+// suites don't apply to TLS 1.3.
+func newTLSConfig(minVersion string, suites []string) (*tls.Config, error) {
+	if minVersion == "" {
+		minVersion = DefaultTLSMinVersion
+	}
+	version, ok := tlsVersions[minVersion]
+	if !ok {
+		return nil, fmt.Errorf("unknown TLS version %q, should be one of 1.0, 1.1, 1.2 or 1.3", minVersion)
+	}
+	cfg := &tls.Config{MinVersion: version}
+	if len(suites) == 0 {
+		return cfg, nil
+	}
+	http2OK := false
+	for _, name := range suites {
+		if tls13Suites[name] {
+			return nil, fmt.Errorf("cipher suite %q is for TLS 1.3, which can't be configured", name)
+		}
+		id, ok := cipherSuites[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown or insecure cipher suite %q", name)
+		}
+		if id == tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 || id == tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 {
+			http2OK = true
+		}
+		cfg.CipherSuites = append(cfg.CipherSuites, id)
+	}
+	// TLS 1.3 clients get the TLS 1.3 suites, which HTTP/2 accepts.
+	if !http2OK && version < tls.VersionTLS13 {
+		return nil, errors.New("cipher suites must include TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 or TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, which HTTP/2 requires")
+	}
+	return cfg, nil
+}
+
+// orDefault returns d if it's set, and def otherwise.
+func orDefault(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
+
+// NewServer returns a server for h with the timeouts, limits and TLS settings
+// in c, falling back to the defaults above.
+func NewServer(c *FileConfig, addr string, h http.Handler) (*http.Server, error) {
+	for name, d := range map[string]time.Duration{
+		"read_header_timeout": c.ReadHeaderTimeout,
+		"read_timeout":        c.ReadTimeout,
+		"write_timeout":       c.WriteTimeout,
+		"idle_timeout":        c.IdleTimeout,
+	} {
+		if d < 0 {
+			return nil, fmt.Errorf("%s can't be negative, got %v", name, d)
+		}
+	}
+	if c.MaxHeaderBytes < 0 {
+		return nil, fmt.Errorf("max_header_bytes can't be negative, got %d", c.MaxHeaderBytes)
+	}
+	maxHeaderBytes := c.MaxHeaderBytes
+	if maxHeaderBytes == 0 {
+		maxHeaderBytes = DefaultMaxHeaderBytes
+	}
+	tlsConfig, err := newTLSConfig(c.TLSMinVersion, c.TLSCipherSuites)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Server{
+		Addr:              addr,
+		Handler:           h,
+		ReadHeaderTimeout: orDefault(c.ReadHeaderTimeout, DefaultReadHeaderTimeout),
+		ReadTimeout:       orDefault(c.ReadTimeout, DefaultReadTimeout),
+		WriteTimeout:      orDefault(c.WriteTimeout, DefaultWriteTimeout),
+		IdleTimeout:       orDefault(c.IdleTimeout, DefaultIdleTimeout),
+		MaxHeaderBytes:    maxHeaderBytes,
+		TLSConfig:         tlsConfig,
+	}, nil
+}
+
+func writeBodyTooLarge(w http.ResponseWriter) {
+	writeAPIError(w, http.StatusRequestEntityTooLarge, &rest.Error{
+		Title: "The request body is too large",
+		ID:    "request_too_large",
+	})
+}
+
+var errBodyTooLarge = errors.New("request body is too large")
+
+// bodyTooLarge reports whether err came from reading past the end of a body
+// limited by LimitBody.
+func bodyTooLarge(err error) bool {
+	return errors.Is(err, errBodyTooLarge)
+}
+
+// A limitedBody is like http.MaxBytesReader, but returns errBodyTooLarge
+// once more than n bytes are read, so we can tell that apart from other
+// errors on versions of Go without http.MaxBytesError.
+type limitedBody struct {
+	io.ReadCloser
+	n int64 // bytes left
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.n < 0 {
+		return 0, errBodyTooLarge
+	}
+	// Read one byte past the limit, to tell whether there's more.
+	if int64(len(p)) > b.n+1 {
+		p = p[:b.n+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) <= b.n {
+		b.n -= int64(n)
+		return n, err
+	}
+	n = int(b.n)
+	b.n = -1
+	return n, errBodyTooLarge
+}
+
+// isFormPost reports whether r was submitted by one of our HTML forms, rather
+// than the API.
+func isFormPost(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+}
+
+// refererPath returns the page on this site that r was submitted from, or
+// fallback if it didn't come from one.
+func refererPath(r *http.Request, fallback string) string {
+	u, err := url.Parse(r.Referer())
+	if err != nil || u.Host != r.Host || u.Path == "" {
+		return fallback
+	}
+	return u.RequestURI()
+}
+
+// LimitBody rejects requests to h with bodies longer than n bytes. Form posts
+// are parsed here, so handlers don't mistake a truncated form for a missing
+// field. A form that's too large sends the user back to the page they
+// submitted it from, or home, with an error sealed with flash.
+func LimitBody(h http.Handler, n int64, flash *Sealer, home string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tooLarge := func() {
+			if !isFormPost(r) {
+				writeBodyTooLarge(w)
+				return
+			}
+			FlashError(w, translate(requestLocale(r, ""), "Your letter is too long to send. Please shorten it and try again"), flash)
+			http.Redirect(w, r, refererPath(r, home), http.StatusFound)
+		}
+		if r.ContentLength > n {
+			tooLarge()
+			return
+		}
+		r.Body = &limitedBody{ReadCloser: r.Body, n: n}
+		if isFormPost(r) {
+			if err := r.ParseForm(); err != nil {
+				if bodyTooLarge(err) {
+					tooLarge()
+				} else {
+					writeAPIError(w, http.StatusBadRequest, &rest.Error{Title: "Could not parse form", ID: "invalid_form", Detail: err.Error()})
+				}
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+	srv, err := NewServer(&FileConfig{WriteTimeout: 5 * time.Minute}, ":8048", http.NotFoundHandler())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.ReadHeaderTimeout != DefaultReadHeaderTimeout || srv.IdleTimeout != DefaultIdleTimeout || srv.MaxHeaderBytes != DefaultMaxHeaderBytes {
+		t.Errorf("want default timeouts and limits, got %#v", srv)
+	}
+	if srv.WriteTimeout != 5*time.Minute {
+		t.Errorf("want configured write timeout, got %v", srv.WriteTimeout)
+	}
+	if srv.TLSConfig.MinVersion != tls.VersionTLS12 {
+		t.Errorf("want TLS 1.2 minimum by default, got %x", srv.TLSConfig.MinVersion)
+	}
+	if _, err := NewServer(&FileConfig{ReadTimeout: -time.Second}, ":8048", http.NotFoundHandler()); err == nil {
+		t.Errorf("want error for negative read timeout")
+	}
+}
+
+var tlsConfigTests = []struct {
+	minVersion string
+	suites     []string
+	err        string
+}{
+	{"1.3", nil, ""},
+	{"1.2", []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"}, ""},
+	{"1.4", nil, "unknown TLS version"},
+	{"1.2", []string{"TLS_RSA_WITH_RC4_128_SHA"}, "unknown or insecure cipher suite"},
+	{"1.2", []string{"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"}, "HTTP/2 requires"},
+	{"1.2", []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_AES_128_GCM_SHA256"}, "is for TLS 1.3"},
+	{"1.3", []string{"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"}, ""},
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	t.Parallel()
+	for _, tt := range tlsConfigTests {
+		cfg, err := newTLSConfig(tt.minVersion, tt.suites)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("newTLSConfig(%q, %v): %v", tt.minVersion, tt.suites, err)
+			} else if len(cfg.CipherSuites) != len(tt.suites) {
+				t.Errorf("newTLSConfig(%q, %v): got %d cipher suites", tt.minVersion, tt.suites, len(cfg.CipherSuites))
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("newTLSConfig(%q, %v): want error containing %q, got %v", tt.minVersion, tt.suites, tt.err, err)
+		}
+	}
+}
+
+func TestLimitBody(t *testing.T) {
+	t.Parallel()
+	flash := NewSecrets(Keys{NewRandomKey()}).Flash
+	h := LimitBody(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			if bodyTooLarge(err) {
+				writeBodyTooLarge(w)
+				return
+			}
+			t.Fatal(err)
+		}
+		io.WriteString(w, r.PostFormValue("subject"))
+	}), 64, flash, "/")
+
+	form := url.Values{"subject": {"Hello"}}
+	req := httptest.NewRequest("POST", "/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 || w.Body.String() != "Hello" {
+		t.Errorf("POST /: got %d %q, want 200 Hello", w.Code, w.Body.String())
+	}
+
+	form.Set("body", strings.Repeat("a", 100))
+	req = httptest.NewRequest("POST", "/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", "http://example.com/board?embed=1")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/board?embed=1" {
+		t.Errorf("POST /: got %d %q for too large form, want redirect to the form", w.Code, w.Header().Get("Location"))
+	}
+	req = httptest.NewRequest("GET", "/board", nil)
+	for _, c := range w.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	if msg := GetFlashError(httptest.NewRecorder(), req, flash); !strings.Contains(msg, "too long") {
+		t.Errorf("POST /: got flash error %q for too large form", msg)
+	}
+
+	// A form without a Content-Length, from another site.
+	req = httptest.NewRequest("POST", "/", ioutil.NopCloser(strings.NewReader(form.Encode())))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", "https://evil.example.com/")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 302 || w.Header().Get("Location") != "/" {
+		t.Errorf("POST /: got %d %q for too large form, want redirect home", w.Code, w.Header().Get("Location"))
+	}
+
+	// A body of exactly the limit is fine.
+	req = httptest.NewRequest("POST", "/v1/messages", ioutil.NopCloser(strings.NewReader(strings.Repeat("a", 64))))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Errorf("POST /v1/messages: got %d for a body at the limit, want 200", w.Code)
+	}
+
+	// Without a Content-Length the body is cut off while it's read.
+	req = httptest.NewRequest("POST", "/v1/send", ioutil.NopCloser(strings.NewReader(strings.Repeat("a", 100))))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusRequestEntityTooLarge || !strings.Contains(w.Body.String(), "request_too_large") {
+		t.Errorf("POST /v1/send: got %d %q for too large body, want 413", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+// Tracking of letters that are being sent, so the server can wait for them to
+// finish before it shuts down, and say exactly which recipients didn't get a
+// letter if they don't.
+
+import (
+	"context"
+	"net/mail"
+	"sync"
+	"time"
+)
+
+// DefaultShutdownGracePeriod is how long the server waits for in-flight sends
+// to finish after it's asked to shut down.
+const DefaultShutdownGracePeriod = 30 * time.Second
+
+// An inflightSend is a letter being sent to every recipient in a group.
+type inflightSend struct {
+	from    *mail.Address
+	group   *Group
+	started time.Time
+	cancel  context.CancelFunc
+
+	// Guarded by sendTracker.mu. nil until the recipient's send finishes.
+	results []*SendResult
+}
+
+type sendTracker struct {
+	mu    sync.Mutex
+	sends map[*inflightSend]struct{}
+	// Closed, and set back to nil, when the last send finishes.
+	idle chan struct{}
+}
+
+func newSendTracker() *sendTracker {
+	return &sendTracker{sends: make(map[*inflightSend]struct{})}
+}
+
+// start records a send, returning a context that's canceled if the send is
+// interrupted by a shutdown.
+func (t *sendTracker) start(ctx context.Context, from *mail.Address, group *Group) (context.Context, *inflightSend) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &inflightSend{
+		from:    from,
+		group:   group,
+		started: time.Now(),
+		cancel:  cancel,
+		results: make([]*SendResult, len(group.Recipients)),
+	}
+	t.mu.Lock()
+	t.sends[s] = struct{}{}
+	t.mu.Unlock()
+	return ctx, s
+}
+
+// record saves the result of sending to the i'th recipient of s.
+func (t *sendTracker) record(s *inflightSend, i int, result *SendResult) {
+	t.mu.Lock()
+	s.results[i] = result
+	t.mu.Unlock()
+}
+
+func (t *sendTracker) finish(s *inflightSend) {
+	t.mu.Lock()
+	delete(t.sends, s)
+	if len(t.sends) == 0 && t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+	t.mu.Unlock()
+	s.cancel()
+}
+
+// wait returns a channel that's closed once every send has finished.
+func (t *sendTracker) wait() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.sends) == 0 {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	return t.idle
+}
+
+// Drain waits for letters that are being sent to finish. If ctx is done first,
+// the remaining sends are canceled, and every recipient who may not have
+// gotten a letter is logged. Drain reports whether every send finished.
+func (m *Mailer) Drain(ctx context.Context) bool {
+	done := m.inflight.wait()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+	}
+	m.inflight.mu.Lock()
+	for s := range m.inflight.sends {
+		var sent, unsent []string
+		for i, recipient := range s.group.Recipients {
+			if result := s.results[i]; result != nil && result.Err == nil {
+				sent = append(sent, recipient.Address.String())
+			} else {
+				unsent = append(unsent, recipient.Address.String())
+			}
+		}
+		m.Logger.Error("Send interrupted by shutdown", "from", s.from.String(), "group", s.group.ID,
+			"started", s.started.Format(time.RFC3339), "sent", sent, "not_sent", unsent)
+		s.cancel()
+	}
+	m.inflight.mu.Unlock()
+	// Canceled sends return quickly; give them a moment so their callers can
+	// report the failure.
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/inconshreveable/log15"
+)
+
+// syncBuffer is a bytes.Buffer that's safe to log to from many goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func drainMailer() (*Mailer, *syncBuffer) {
+	buf := new(syncBuffer)
+	l := log.New()
+	l.SetHandler(log.StreamHandler(buf, log.LogfmtFormat()))
+	return &Mailer{Logger: l, inflight: newSendTracker()}, buf
+}
+
+func TestDrainWaitsForSends(t *testing.T) {
+	t.Parallel()
+	m, _ := drainMailer()
+	group := embedMailer().Groups["board"]
+	_, s := m.inflight.start(context.Background(), &mail.Address{Address: "sender@example.com"}, group)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		m.inflight.finish(s)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if !m.Drain(ctx) {
+		t.Errorf("Drain: want every send to finish before the grace period")
+	}
+}
+
+func TestDrainLogsInterruptedSends(t *testing.T) {
+	t.Parallel()
+	m, buf := drainMailer()
+	group := &Group{ID: "council", Recipients: []*Recipient{
+		{Address: mail.Address{Address: "first@example.com"}},
+		{Address: mail.Address{Address: "second@example.com"}},
+	}}
+	ctx, s := m.inflight.start(context.Background(), &mail.Address{Address: "sender@example.com"}, group)
+	m.inflight.record(s, 0, &SendResult{To: group.Recipients[0].Address})
+	interrupted := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		m.inflight.record(s, 1, &SendResult{To: group.Recipients[1].Address, Err: ctx.Err()})
+		m.inflight.finish(s)
+		interrupted <- ctx.Err()
+	}()
+	drainCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if m.Drain(drainCtx) {
+		t.Errorf("Drain: want unfinished send to be reported")
+	}
+	if err := <-interrupted; !errors.Is(err, context.Canceled) {
+		t.Errorf("Drain: want send to be canceled, got %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Send interrupted by shutdown", "group=council", "first@example.com", "not_sent=[<second@example.com>]"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Drain: want %q in log output, got %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+// Restricting which Google accounts may use the site. Google signs in anyone
+// with an account; these checks run on every authenticated request, and
+// accounts that don't pass see a "not authorized" page instead of the form.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/rest"
+)
+
+// A SignInPolicy decides which Google accounts may use the site. Addresses
+// and domains are compared case-insensitively.
+type SignInPolicy struct {
+	// If either is non-empty, only these addresses, and addresses in these
+	// domains, may sign in.
+	AllowEmails  []string
+	AllowDomains []string
+	// These addresses, and addresses in these domains, may never sign in.
+	BlockEmails  []string
+	BlockDomains []string
+	// If non-empty, only accounts in these Google Workspace domains (the "hd"
+	// claim Google returns for Workspace accounts) may sign in. Unlike
+	// AllowDomains, this can't be satisfied by a personal Google account
+	// that uses an address in the domain.
+	HostedDomains []string
+
+	// Where to look up the hosted domain; defaults to googleUserInfoURL.
+	userInfoURL string
+}
+
+// googleUserInfoURL returns information about the signed in user, including
+// their hosted domain.
+const googleUserInfoURL = "https://www.googleapis.com/oauth2/v3/userinfo"
+
+// hdCookieName caches the hosted domain of the signed in user, so we only
+// have to ask Google once.
+const hdCookieName = "hd"
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(strings.TrimPrefix(item, "@"), s) {
+			return true
+		}
+	}
+	return false
+}
+
+func emailDomain(address string) string {
+	return address[strings.LastIndex(address, "@")+1:]
+}
+
+// allowedAddress reports whether the address passes the allow and block
+// lists; it doesn't check the hosted domain.
+func (p *SignInPolicy) allowedAddress(address string) bool {
+	domain := emailDomain(address)
+	if containsFold(p.BlockEmails, address) || containsFold(p.BlockDomains, domain) {
+		return false
+	}
+	if len(p.AllowEmails) == 0 && len(p.AllowDomains) == 0 {
+		return true
+	}
+	return containsFold(p.AllowEmails, address) || containsFold(p.AllowDomains, domain)
+}
+
+// Validate returns an error if an entry in p isn't an email address or a
+// domain.
+func (p *SignInPolicy) Validate() error {
+	for _, list := range [][]string{p.AllowEmails, p.BlockEmails} {
+		for _, address := range list {
+			if _, err := mail.ParseAddress(address); err != nil || strings.ContainsAny(address, "<> ") {
+				return fmt.Errorf("invalid email address %q, should look like \"name@example.com\"", address)
+			}
+		}
+	}
+	for _, list := range [][]string{p.AllowDomains, p.BlockDomains, p.HostedDomains} {
+		for _, domain := range list {
+			if domain == "" || strings.ContainsAny(strings.TrimPrefix(domain, "@"), "@/: ") {
+				return fmt.Errorf("invalid domain %q, should look like \"example.com\"", domain)
+			}
+		}
+	}
+	return nil
+}
+
+// hostedDomain returns the Google Workspace domain of the signed in user, or
+// the empty string if they have a personal account.
+func (p *SignInPolicy) hostedDomain(w http.ResponseWriter, r *http.Request, auth *Account, s *Sealer) (string, error) {
+	prefix := auth.Email.Address + "|"
+	if cached := getCookie(w, r, hdCookieName, s, false); strings.HasPrefix(cached, prefix) {
+		return strings.TrimPrefix(cached, prefix), nil
+	}
+	u := googleUserInfoURL
+	if p != nil && p.userInfoURL != "" {
+		u = p.userInfoURL
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := auth.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("looking up Google account: got status %d", resp.StatusCode)
+	}
+	var info struct {
+		Email string `json:"email"`
+		HD    string `json:"hd"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(info.Email, auth.Email.Address) {
+		return "", fmt.Errorf("looking up Google account: got %q, want %q", info.Email, auth.Email.Address)
+	}
+	setCookie(w, prefix+info.HD, hdCookieName, s)
+	return info.HD, nil
+}
+
+// Allowed reports whether the signed in user may use the site. Only Google
+// accounts can be in a hosted domain, so if HostedDomains is set, Microsoft
+// accounts aren't allowed.
+func (p *SignInPolicy) Allowed(w http.ResponseWriter, r *http.Request, auth *Account, s *Sealer) (bool, error) {
+	if p == nil {
+		return true, nil
+	}
+	if !p.allowedAddress(auth.Email.Address) {
+		return false, nil
+	}
+	if len(p.HostedDomains) == 0 {
+		return true, nil
+	}
+	if auth.Provider != providerGoogle {
+		return false, nil
+	}
+	hd, err := p.hostedDomain(w, r, auth, s)
+	if err != nil {
+		return false, err
+	}
+	return hd != "" && containsFold(p.HostedDomains, hd), nil
+}
+
+type notAuthorizedData struct {
+	layoutData
+	Email     *mail.Address
+	CSRFToken string
+}
+
+// renderNotAuthorized tells a signed in user that their account can't use
+// the site, and lets them sign out to try another one.
+func renderNotAuthorized(w http.ResponseWriter, r *http.Request, site *Site, email *mail.Address, csrf *Sealer) {
+	if isAPIRequest(r) {
+		writeAPIError(w, http.StatusForbidden, &rest.Error{
+			Title: fmt.Sprintf("%s is not allowed to use this site", email.Address),
+			ID:    "not_authorized",
+		})
+		return
+	}
+	logger.Info("Rejected sign in", "email", email.Address, "path", r.URL.Path)
+	if embedRx.MatchString(r.URL.Path) {
+		allowFraming(w, site.EmbedOrigins)
+	}
+	buf := new(bytes.Buffer)
+	if err := site.Theme.templates.ExecuteTemplate(buf, "not-authorized.html", &notAuthorizedData{
+		layoutData: newLayoutData(r, site, requestLocale(r, "")),
+		Email:      email,
+		CSRFToken:  csrfToken(w, r, csrf),
+	}); err != nil {
+		rest.ServerError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusForbidden)
+	w.Write(buf.Bytes())
+}
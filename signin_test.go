@@ -0,0 +1,133 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+var signInPolicyTests = []struct {
+	policy  SignInPolicy
+	address string
+	want    bool
+}{
+	{SignInPolicy{}, "anyone@gmail.com", true},
+	{SignInPolicy{AllowDomains: []string{"union.example.org"}}, "member@Union.Example.org", true},
+	{SignInPolicy{AllowDomains: []string{"@union.example.org"}}, "member@union.example.org", true},
+	{SignInPolicy{AllowDomains: []string{"union.example.org"}}, "member@example.org", false},
+	{SignInPolicy{AllowDomains: []string{"union.example.org"}, AllowEmails: []string{"friend@gmail.com"}}, "friend@gmail.com", true},
+	{SignInPolicy{AllowDomains: []string{"union.example.org"}, BlockEmails: []string{"boss@union.example.org"}}, "boss@union.example.org", false},
+	{SignInPolicy{BlockDomains: []string{"spam.example.com"}}, "bot@spam.example.com", false},
+	{SignInPolicy{BlockDomains: []string{"spam.example.com"}}, "person@gmail.com", true},
+}
+
+func TestSignInPolicy(t *testing.T) {
+	t.Parallel()
+	for _, tt := range signInPolicyTests {
+		if got := tt.policy.allowedAddress(tt.address); got != tt.want {
+			t.Errorf("%+v: allowedAddress(%q) = %t, want %t", tt.policy, tt.address, got, tt.want)
+		}
+	}
+	if err := (&SignInPolicy{AllowEmails: []string{"Name <name@example.com>"}}).Validate(); err == nil {
+		t.Errorf("Validate: want error for address with a name")
+	}
+	if err := (&SignInPolicy{AllowDomains: []string{"https://example.com"}}).Validate(); err == nil {
+		t.Errorf("Validate: want error for URL in place of a domain")
+	}
+}
+
+func TestNotAuthorized(t *testing.T) {
+	t.Parallel()
+	key := NewRandomKey()
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), embedMailer(), &Site{WithGoogle: true, SignIn: &SignInPolicy{AllowDomains: []string{"union.example.org"}}})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "outsider@gmail.com"))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("GET /: got code %d for outsider, want 403", w.Code)
+	}
+	if b := w.Body.String(); !strings.Contains(b, "outsider@gmail.com, but that account") || !strings.Contains(b, `action="/logout"`) {
+		t.Errorf("GET /: want not authorized page with a way to sign out, got %s", b)
+	}
+
+	req = httptest.NewRequest("GET", "/v1/jobs/abc", nil)
+	req.AddCookie(authCookie(t, key, "outsider@gmail.com"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), `"not_authorized"`) {
+		t.Errorf("GET /v1/jobs/abc: got %d %s, want 403 not_authorized", w.Code, w.Body.String())
+	}
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "member@union.example.org"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Errorf("GET /: got code %d for member, want 200", w.Code)
+	}
+}
+
+func TestHostedDomain(t *testing.T) {
+	t.Parallel()
+	var lookups int32
+	userInfo := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&lookups, 1)
+		if r.Header.Get("Authorization") != "Bearer test-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		io.WriteString(w, `{"email": "member@union.example.org", "hd": "union.example.org"}`)
+	}))
+	defer userInfo.Close()
+	mailer := embedMailer()
+	mailer.secrets = NewSecrets(Keys{NewRandomKey()})
+	key := mailer.secrets.Auth[0]
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: key,
+	}), mailer, &Site{WithGoogle: true, SignIn: &SignInPolicy{HostedDomains: []string{"union.example.org"}, userInfoURL: userInfo.URL}})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "member@union.example.org"))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 {
+		t.Fatalf("GET /: got code %d for Workspace member, want 200", w.Code)
+	}
+	var hd *http.Cookie
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == hdCookieName {
+			hd = cookie
+		}
+	}
+	if hd == nil {
+		t.Fatalf("GET /: want hosted domain cookie to be set")
+	}
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "member@union.example.org"))
+	req.AddCookie(hd)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 || atomic.LoadInt32(&lookups) != 1 {
+		t.Errorf("GET /: got code %d after %d lookups, want 200 with the hosted domain looked up once", w.Code, atomic.LoadInt32(&lookups))
+	}
+
+	// The cached domain belongs to another account, so it isn't used.
+	req = httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(authCookie(t, key, "member@gmail.com"))
+	req.AddCookie(hd)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code == 200 {
+		t.Errorf("GET /: personal account shouldn't be allowed in with another account's hosted domain")
+	}
+}
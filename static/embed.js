@@ -0,0 +1,47 @@
+// Embeds the multi-emailer form for a group on another site. Add this where
+// the form should appear:
+//
+//   <script src="https://example.com/static/embed.js" data-group="my-group" async></script>
+//
+// Optional attributes: data-subject and data-body prefill the letter,
+// data-lang picks a language, and data-height sets the initial height in
+// pixels. Your site's origin must be listed in embed_origins in the
+// multi-emailer config.
+(function() {
+  var script = document.currentScript;
+  if (!script || !script.getAttribute('data-group')) {
+    return;
+  }
+  var link = document.createElement('a');
+  link.href = script.src;
+  var origin = link.protocol + '//' + link.host;
+  // The site may be mounted under a path, like https://example.com/letters.
+  var base = origin + link.pathname.replace(/\/static\/embed\.js$/, '');
+
+  var params = [];
+  var attrs = {subject: 'data-subject', body: 'data-body', lang: 'data-lang'};
+  for (var key in attrs) {
+    var val = script.getAttribute(attrs[key]);
+    if (val) {
+      params.push(key + '=' + encodeURIComponent(val));
+    }
+  }
+  var iframe = document.createElement('iframe');
+  iframe.src = base + '/embed/' + encodeURIComponent(script.getAttribute('data-group')) +
+    (params.length > 0 ? '?' + params.join('&') : '');
+  iframe.title = script.getAttribute('data-title') || 'Send a letter';
+  iframe.style.width = '100%';
+  iframe.style.border = '0';
+  iframe.style.height = (parseInt(script.getAttribute('data-height'), 10) || 600) + 'px';
+  script.parentNode.insertBefore(iframe, script.nextSibling);
+
+  window.addEventListener('message', function(ev) {
+    if (ev.origin !== origin || ev.source !== iframe.contentWindow) {
+      return;
+    }
+    if (!ev.data || ev.data.type !== 'multi-emailer:resize') {
+      return;
+    }
+    iframe.style.height = Math.ceil(ev.data.height) + 'px';
+  });
+})();
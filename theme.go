@@ -0,0 +1,150 @@
+package main
+
+// Themes let a deployment change the look of the site without forking it. A
+// theme is a directory laid out like this repository:
+//
+//	theme/
+//	    templates/index.html   replace the built-in template with the same
+//	    templates/layout.html  name: the homepage, the pieces shared by every
+//	    templates/page.html    page, the layout for content pages, the
+//	    templates/embed.html   embeddable form, and pages for signing in
+//	    templates/signed-in.html
+//	    templates/not-authorized.html
+//	    templates/*.html       other files can redefine the "head", "header",
+//	                           "footer" and "languages" templates in
+//	                           layout.html, or the "intro" block in index.html
+//	    static/...             served in place of, or in addition to, the
+//	                           files in static/
+//
+// Anything the theme doesn't provide comes from the files compiled into the
+// binary.
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/multi-emailer/assets"
+)
+
+// templateNames are the built-in templates, in the order they're parsed.
+var templateNames = []string{"layout.html", "index.html", "page.html", "embed.html", "signed-in.html", "not-authorized.html"}
+
+type Theme struct {
+	// Empty for the built-in theme.
+	dir       string
+	templates *template.Template
+}
+
+// defaultTheme uses the templates and static files compiled into the binary.
+var defaultTheme *Theme
+
+func init() {
+	var err error
+	defaultTheme, err = LoadTheme("")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// LoadTheme parses the templates in dir, layered over the built-in ones. If
+// dir is empty, the built-in theme is returned. Templates are read once, so
+// the server must be restarted to pick up changes to them; static files are
+// read from disk on every request.
+func LoadTheme(dir string) (*Theme, error) {
+	t := &Theme{dir: dir}
+	if dir != "" {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, fmt.Errorf("theme_dir %s is not a directory", dir)
+		}
+	}
+	tpl := template.New("")
+	for _, name := range templateNames {
+		data, _, err := t.asset("templates/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tpl.New(name).Parse(string(data)); err != nil {
+			return nil, err
+		}
+	}
+	if dir != "" {
+		files, err := filepath.Glob(filepath.Join(dir, "templates", "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			if builtinTemplate(filepath.Base(file)) {
+				continue
+			}
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := tpl.New(filepath.Base(file)).Parse(string(data)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	t.templates = tpl
+	return t, nil
+}
+
+func builtinTemplate(name string) bool {
+	for _, builtin := range templateNames {
+		if name == builtin {
+			return true
+		}
+	}
+	return false
+}
+
+// asset returns the contents of the file at name, a slash-separated path like
+// "static/style.css", from the theme directory if it's there, or from the
+// built-in assets otherwise. The returned time is the file's modification time,
+// or the zero time for built-in assets.
+func (t *Theme) asset(name string) ([]byte, time.Time, error) {
+	// Clean the path as if it were rooted, so "../" can't escape the theme
+	// directory.
+	name = path.Clean("/" + name)[1:]
+	if t.dir != "" {
+		f, err := os.Open(filepath.Join(t.dir, filepath.FromSlash(name)))
+		if err == nil {
+			defer f.Close()
+			fi, err := f.Stat()
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+			if !fi.IsDir() {
+				data, err := ioutil.ReadAll(f)
+				return data, fi.ModTime(), err
+			}
+		} else if !os.IsNotExist(err) {
+			return nil, time.Time{}, err
+		}
+	}
+	data, err := assets.Asset(name)
+	return data, time.Time{}, err
+}
+
+// staticAsset is like asset, for a file served under /static/. Names that
+// clean to somewhere else, like "static/../templates/index.html", aren't
+// found.
+func (t *Theme) staticAsset(name string) ([]byte, time.Time, error) {
+	name = path.Clean("/" + name)[1:]
+	if !strings.HasPrefix(name, "static/") {
+		return nil, time.Time{}, os.ErrNotExist
+	}
+	return t.asset(name)
+}
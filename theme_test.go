@@ -0,0 +1,99 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	google "github.com/kevinburke/google-oauth-handler"
+)
+
+func writeTestFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	file := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(file, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTheme(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "multi-emailer-theme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestFile(t, dir, "templates/blocks.html", `{{ define "intro" }}<p>Tell them which neighborhood you live in.</p>{{ end }}
+{{ define "head" }}<link rel="stylesheet" href="/static/theme.css">{{ end }}`)
+	writeTestFile(t, dir, "static/style.css", "body { color: purple; }")
+	writeTestFile(t, dir, "static/theme.css", "h1 { color: green; }")
+	theme, err := LoadTheme(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := NewServeMux(google.NewAuthenticator(google.Config{
+		SecretKey: NewRandomKey(),
+	}), nil, &Site{Theme: theme})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	b := w.Body.String()
+	if !strings.Contains(b, "Tell them which neighborhood you live in.") || strings.Contains(b, "Start your letter") {
+		t.Errorf("GET /: want intro from theme, got %s", b)
+	}
+	if !strings.Contains(b, `href="/static/theme.css"`) {
+		t.Errorf("GET /: want theme stylesheet, got %s", b)
+	}
+	if !strings.Contains(b, "View the source code") {
+		t.Errorf("GET /: blocks the theme doesn't define should be unchanged, got %s", b)
+	}
+
+	for path, want := range map[string]string{
+		"/static/style.css": "body { color: purple; }",
+		"/static/theme.css": "h1 { color: green; }",
+	} {
+		req = httptest.NewRequest("GET", path, nil)
+		w = httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != 200 || w.Body.String() != want {
+			t.Errorf("GET %s: got %d %q, want %q", path, w.Code, w.Body.String(), want)
+		}
+	}
+	req = httptest.NewRequest("GET", "/static/bootstrap.min.css", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != 200 || w.Body.Len() == 0 {
+		t.Errorf("GET /static/bootstrap.min.css: want built-in file, got %d", w.Code)
+	}
+	if _, _, err := theme.asset("static/../../../etc/passwd"); err == nil {
+		t.Errorf("asset: should not read files outside the theme directory")
+	}
+	writeTestFile(t, dir, "templates/secret.html", "theme source")
+	for _, name := range []string{"static/../templates/index.html", "static/../templates/secret.html", "static/../config.yml", "templates/index.html"} {
+		if _, _, err := theme.staticAsset(name); err == nil {
+			t.Errorf("staticAsset(%q): should only serve files under static/", name)
+		}
+	}
+	for _, path := range []string{"/static/../templates/index.html", "/static/%2e%2e/templates/secret.html"} {
+		req = httptest.NewRequest("GET", path, nil)
+		w = httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != 404 {
+			t.Errorf("GET %s: got %d, want 404", path, w.Code)
+		}
+	}
+}
+
+func TestLoadThemeMissingDir(t *testing.T) {
+	t.Parallel()
+	if _, err := LoadTheme("/nonexistent/theme"); err == nil {
+		t.Errorf("LoadTheme: expected error for a missing directory, got nil")
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+// Sending from Google Workspace mailboxes with a service account that has
+// domain-wide delegation, so staff don't each have to give the site
+// permission to send. The service account impersonates the signed in user,
+// or a group's send_as address, when it calls the Gmail API.
+//
+// A Workspace admin has to authorize the service account's client ID for the
+// https://www.googleapis.com/auth/gmail.send scope, under Security > API
+// controls > Domain-wide delegation.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	googleoauth "golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+// A Delegation impersonates users in Domains. A nil *Delegation doesn't cover
+// any address.
+type Delegation struct {
+	// Workspace domains whose users we can send as.
+	Domains []string
+	// If true, every user signed in with Google must be in one of Domains,
+	// since they weren't asked for permission to send themselves.
+	Required bool
+
+	config *jwt.Config
+
+	mu      sync.Mutex
+	sources map[string]oauth2.TokenSource
+}
+
+// NewDelegation reads a service account key, in the JSON format Google Cloud
+// downloads.
+func NewDelegation(keyJSON []byte, domains []string) (*Delegation, error) {
+	if len(domains) == 0 {
+		return nil, errors.New("delegated_domains can't be empty")
+	}
+	for _, domain := range domains {
+		if domain == "" || strings.Contains(domain, "@") {
+			return nil, fmt.Errorf("invalid delegated domain %q, should look like example.org", domain)
+		}
+	}
+	config, err := googleoauth.JWTConfigFromJSON(keyJSON, gmail.GmailSendScope)
+	if err != nil {
+		return nil, err
+	}
+	return &Delegation{
+		Domains: domains,
+		config:  config,
+		sources: make(map[string]oauth2.TokenSource),
+	}, nil
+}
+
+// covers reports whether addr is in one of the delegated domains.
+func (d *Delegation) covers(addr string) bool {
+	return d != nil && containsFold(d.Domains, emailDomain(addr))
+}
+
+// client returns a client that calls Google APIs as addr. Tokens are reused
+// until they expire.
+func (d *Delegation) client(addr string) *http.Client {
+	key := strings.ToLower(addr)
+	d.mu.Lock()
+	src, ok := d.sources[key]
+	if !ok {
+		config := *d.config
+		config.Subject = addr
+		src = oauth2.ReuseTokenSource(nil, config.TokenSource(context.Background()))
+		d.sources[key] = src
+	}
+	d.mu.Unlock()
+	return oauth2.NewClient(context.Background(), src)
+}
+
+// delegate marks auth as one the service account sends for, if it's a
+// Google account managed by one of the delegated domains. Someone can sign
+// up for a personal Google account with an address in the domain, so the
+// address alone isn't enough.
+func (d *Delegation) delegate(w http.ResponseWriter, r *http.Request, auth *Account, p *SignInPolicy, s *Sealer) error {
+	if auth.Provider != providerGoogle || !d.covers(auth.Email.Address) {
+		return nil
+	}
+	hd, err := p.hostedDomain(w, r, auth, s)
+	if err != nil {
+		return err
+	}
+	auth.delegated = strings.EqualFold(hd, emailDomain(auth.Email.Address))
+	return nil
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// serviceAccountKey returns a service account key in the format Google Cloud
+// downloads, which gets tokens from tokenURL.
+func serviceAccountKey(t *testing.T, tokenURL string) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "sender@project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		"token_uri":    tokenURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// delegationStandIn issues a token for whoever the service account asks to
+// impersonate, and echoes the token sent to /api.
+func delegationStandIn(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api" {
+			io.WriteString(w, r.Header.Get("Authorization"))
+			return
+		}
+		parts := strings.Split(r.PostFormValue("assertion"), ".")
+		if len(parts) != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var c struct {
+			Sub string `json:"sub"`
+		}
+		json.Unmarshal(claims, &c)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"as-%s","token_type":"Bearer","expires_in":3600}`, c.Sub)
+	}))
+}
+
+func TestDelegationClient(t *testing.T) {
+	t.Parallel()
+	standIn := delegationStandIn(t)
+	defer standIn.Close()
+	d, err := NewDelegation(serviceAccountKey(t, standIn.URL+"/token"), []string{"union.example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.covers("Staff@UNION.example.org") || d.covers("staff@example.com") || (*Delegation)(nil).covers("staff@union.example.org") {
+		t.Errorf("covers: got wrong answer for delegated or outside domain")
+	}
+	resp, err := d.client("staff@union.example.org").Get(standIn.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, _ := ioutil.ReadAll(resp.Body)
+	if string(b) != "Bearer as-staff@union.example.org" {
+		t.Errorf("delegated request: got Authorization %q, want a token for staff@union.example.org", b)
+	}
+
+	if _, err := NewDelegation(serviceAccountKey(t, standIn.URL+"/token"), nil); err == nil {
+		t.Errorf("NewDelegation: want error without domains")
+	}
+	if _, err := NewDelegation([]byte(`{"type":"authorized_user"}`), []string{"union.example.org"}); err == nil {
+		t.Errorf("NewDelegation: want error for a key that isn't a service account")
+	}
+}
+
+func TestDelegate(t *testing.T) {
+	t.Parallel()
+	hd := map[string]string{
+		"staff@union.example.org":    "union.example.org",
+		"personal@union.example.org": "",
+	}
+	userInfo := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email := r.Header.Get("X-Test-Email")
+		json.NewEncoder(w).Encode(map[string]string{"email": email, "hd": hd[email]})
+	}))
+	defer userInfo.Close()
+	d := &Delegation{Domains: []string{"union.example.org"}}
+	policy := &SignInPolicy{userInfoURL: userInfo.URL}
+	secrets := NewSecrets(Keys{NewRandomKey()})
+	for _, tt := range []struct {
+		addr, provider string
+		want           bool
+	}{
+		{"staff@union.example.org", providerGoogle, true},
+		// A personal Google account using an address in the domain.
+		{"personal@union.example.org", providerGoogle, false},
+		{"staff@union.example.org", providerMicrosoft, false},
+		{"volunteer@example.com", providerGoogle, false},
+	} {
+		auth := &Account{
+			Email:    &mail.Address{Address: tt.addr},
+			Client:   &http.Client{Transport: headerTransport{"X-Test-Email", tt.addr}},
+			Provider: tt.provider,
+		}
+		if err := d.delegate(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), auth, policy, secrets.HostedDomain); err != nil {
+			t.Fatal(err)
+		}
+		if auth.delegated != tt.want {
+			t.Errorf("delegate(%s, %s): got %t, want %t", tt.addr, tt.provider, auth.delegated, tt.want)
+		}
+	}
+}
+
+func TestSendAs(t *testing.T) {
+	t.Parallel()
+	standIn := delegationStandIn(t)
+	defer standIn.Close()
+	d, err := NewDelegation(serviceAccountKey(t, standIn.URL+"/token"), []string{"union.example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := &Account{Email: &mail.Address{Address: "member@union.example.org"}, Provider: providerMicrosoft}
+	group := &Group{ID: "press", SendAs: &mail.Address{Address: "campaigns@union.example.org"}}
+	if from := sendFrom(auth, group); from.Address != "campaigns@union.example.org" {
+		t.Errorf("sendFrom: got %s, want the group's send_as address", from)
+	}
+	m := &Mailer{delegation: d}
+	srv, err := m.newSender(auth, group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, ok := srv.(*gmailSender); !ok || g.userID != "campaigns@union.example.org" {
+		t.Errorf("newSender: got %#v, want Gmail sender for the send_as address", srv)
+	}
+	m.delegation = nil
+	if _, err := m.newSender(auth, group); err == nil {
+		t.Errorf("newSender: want error for send_as without a service account")
+	}
+}
+
+type headerTransport struct {
+	name, value string
+}
+
+func (h headerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r2 := r.Clone(r.Context())
+	r2.Header.Set(h.name, h.value)
+	return http.DefaultTransport.RoundTrip(r2)
+}